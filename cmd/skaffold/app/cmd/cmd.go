@@ -192,6 +192,9 @@ func NewSkaffoldCommand(out, err io.Writer) *cobra.Command {
 	rootCmd.AddCommand(NewCmdCredits())
 	rootCmd.AddCommand(NewCmdSchema())
 	rootCmd.AddCommand(NewCmdFilter())
+	rootCmd.AddCommand(NewCmdDependencies())
+	rootCmd.AddCommand(NewCmdInspect())
+	rootCmd.AddCommand(NewCmdGC())
 
 	rootCmd.AddCommand(NewCmdGeneratePipeline())
 	rootCmd.AddCommand(NewCmdSurvey())
@@ -21,9 +21,6 @@ import (
 	"io"
 
 	"github.com/spf13/cobra"
-
-	debugging "github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 )
 
 // for tests
@@ -46,7 +43,6 @@ func NewCmdDebug() *cobra.Command {
 
 func runDebug(ctx context.Context, out io.Writer) error {
 	opts.PortForward.ForwardPods = true
-	manifest.AddTransform(debugging.ApplyDebuggingTransforms)
 
 	return doDev(ctx, out)
 }
@@ -0,0 +1,157 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/git"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/remoteconfig"
+)
+
+var dependenciesOutput string
+
+// for testing
+var (
+	cachedRepos = git.CachedRepos
+	cachedDeps  = remoteconfig.List
+)
+
+// NewCmdDependencies describes the CLI command to manage remote config dependencies.
+func NewCmdDependencies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "dependencies",
+		Aliases: []string{"deps"},
+		Short:   "Manage the cache of remote config dependencies",
+	}
+
+	cmd.AddCommand(NewCmdDependenciesList())
+	cmd.AddCommand(NewCmdDependenciesRefresh())
+	cmd.AddCommand(NewCmdDependenciesLock())
+	return cmd
+}
+
+func NewCmdDependenciesList() *cobra.Command {
+	return NewCmd("list").
+		WithDescription("List the remote config dependencies in the cache").
+		WithExample("List all cached git repositories, OCI artifacts and archives", "dependencies list").
+		WithExample("List the cached dependencies, in json format", "dependencies list -o json").
+		WithCommonFlags().
+		WithFlags([]*Flag{
+			{Value: &dependenciesOutput, Name: "output", Shorthand: "o", DefValue: "plain", Usage: "Type of output: `plain` or `json`."}}).
+		NoArgs(listDependencies)
+}
+
+func NewCmdDependenciesRefresh() *cobra.Command {
+	return NewCmd("refresh").
+		WithDescription("Fetch the latest version of all the remote config dependencies of the current project").
+		WithLongDescription("Fetches the latest commit or digest of every remote config dependency, ignoring the versions pinned in the lock file. The lock file isn't updated; use `skaffold dependencies lock` to pin the refreshed versions.").
+		WithExample("Sync all remote dependencies of the skaffold.yaml in the current directory", "dependencies refresh").
+		WithCommonFlags().
+		NoArgs(refreshDependencies)
+}
+
+func NewCmdDependenciesLock() *cobra.Command {
+	return NewCmd("lock").
+		WithDescription("Resolve the remote config dependencies of the current project and pin their versions in a lock file").
+		WithLongDescription("Resolves every remote config dependency to its latest commit or digest and records it, together with the profiles it was activated with, in a lock file. Subsequent runs use the pinned versions until the lock file is updated again.").
+		WithExample("Create or update the skaffold.lock file next to skaffold.yaml", "dependencies lock").
+		WithExample("Lock the dependencies resolved with a given profile", "dependencies lock -p ci").
+		WithCommonFlags().
+		NoArgs(lockDependencies)
+}
+
+func lockDependencies(ctx context.Context, out io.Writer) error {
+	file := remoteconfig.LockFile(opts)
+	if file == "" {
+		return fmt.Errorf("unable to choose a lock file location for the remote skaffold config %s; use the `--lock-file` flag", opts.ConfigurationFile)
+	}
+	_, lock, err := resolveAllConfigs(ctx, opts, nil, true)
+	if err != nil {
+		return err
+	}
+	if err := remoteconfig.WriteLock(file, lock); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Locked %d remote config dependencies in %s\n", len(lock.Dependencies), file)
+	return nil
+}
+
+func refreshDependencies(ctx context.Context, out io.Writer) error {
+	o := opts
+	o.RemoteCacheOnly = false
+	// The lock file pins the versions, so it's ignored to fetch the latest ones
+	if _, _, err := resolveAllConfigs(ctx, o, nil, false); err != nil {
+		return err
+	}
+	return listDependencies(ctx, out)
+}
+
+// cachedDependency is a single row of `skaffold dependencies list`.
+type cachedDependency struct {
+	Type    string `json:"type"`
+	Source  string `json:"source"`
+	Version string `json:"version"`
+	Dir     string `json:"dir"`
+}
+
+func listDependencies(_ context.Context, out io.Writer) error {
+	if dependenciesOutput != "plain" && dependenciesOutput != "json" {
+		return fmt.Errorf(`invalid output type: %q. Must be "plain" or "json"`, dependenciesOutput)
+	}
+	deps, err := collectDependencies(opts)
+	if err != nil {
+		return err
+	}
+
+	if dependenciesOutput == "json" {
+		return json.NewEncoder(out).Encode(deps)
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TYPE\tSOURCE\tVERSION\tDIRECTORY")
+	for _, d := range deps {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", d.Type, d.Source, d.Version, d.Dir)
+	}
+	return w.Flush()
+}
+
+func collectDependencies(opts config.SkaffoldOptions) ([]cachedDependency, error) {
+	repos, err := cachedRepos(opts)
+	if err != nil {
+		return nil, fmt.Errorf("listing cached git repositories: %w", err)
+	}
+	others, err := cachedDeps(opts)
+	if err != nil {
+		return nil, fmt.Errorf("listing cached dependencies: %w", err)
+	}
+
+	deps := []cachedDependency{}
+	for _, r := range repos {
+		deps = append(deps, cachedDependency{Type: "git", Source: r.Repo, Version: r.Commit, Dir: r.Dir})
+	}
+	for _, d := range others {
+		deps = append(deps, cachedDependency{Type: d.Type, Source: d.Source, Version: d.Resolved, Dir: d.Dir})
+	}
+	return deps, nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/git"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/remoteconfig"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestListDependencies(t *testing.T) {
+	tests := []struct {
+		description string
+		output      string
+		shouldErr   bool
+		expected    string
+	}{
+		{
+			description: "plain",
+			output:      "plain",
+			expected: `TYPE     SOURCE                           VERSION       DIRECTORY
+git      https://github.com/foo.git       8be3f718c015  /cache/abc
+oci      gcr.io/foo/modules:v1            sha256:123    /cache/oci/def
+archive  https://example.com/modules.tgz  sha256:456    /cache/archives/ghi
+`,
+		},
+		{
+			description: "json",
+			output:      "json",
+			expected: `[{"type":"git","source":"https://github.com/foo.git","version":"8be3f718c015","dir":"/cache/abc"},` +
+				`{"type":"oci","source":"gcr.io/foo/modules:v1","version":"sha256:123","dir":"/cache/oci/def"},` +
+				`{"type":"archive","source":"https://example.com/modules.tgz","version":"sha256:456","dir":"/cache/archives/ghi"}]` + "\n",
+		},
+		{
+			description: "invalid output",
+			output:      "yaml",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&dependenciesOutput, test.output)
+			t.Override(&cachedRepos, func(config.SkaffoldOptions) ([]git.CachedRepo, error) {
+				return []git.CachedRepo{{Repo: "https://github.com/foo.git", Commit: "8be3f718c015", Dir: "/cache/abc"}}, nil
+			})
+			t.Override(&cachedDeps, func(config.SkaffoldOptions) ([]remoteconfig.CachedDependency, error) {
+				return []remoteconfig.CachedDependency{
+					{Type: remoteconfig.TypeOCI, Source: "gcr.io/foo/modules:v1", Resolved: "sha256:123", Dir: "/cache/oci/def"},
+					{Type: remoteconfig.TypeArchive, Source: "https://example.com/modules.tgz", Resolved: "sha256:456", Dir: "/cache/archives/ghi"},
+				}, nil
+			})
+
+			var out bytes.Buffer
+			err := listDependencies(context.Background(), &out)
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, out.String())
+		})
+	}
+}
+
+func TestRefreshDependenciesIgnoresLockFile(t *testing.T) {
+	requires := `
+requires:
+  - git:
+      repo: doc1
+      ref: main
+`
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir()
+		tmpDir.Write("skaffold.yaml", fmt.Sprintf(template, latest.Version, "cfg00", requires, "00", "00", "00")).
+			Write("doc1/skaffold.yaml", fmt.Sprintf(template, latest.Version, "cfg10", "", "10", "10", "10")).
+			Chdir()
+		lock := &remoteconfig.Lock{Dependencies: []remoteconfig.LockedDependency{
+			{Type: "git", Source: "doc1", Ref: "main", Resolved: "def456"},
+		}}
+		t.CheckNoError(remoteconfig.WriteLock(tmpDir.Path("skaffold.lock"), lock))
+
+		var syncedRef, syncedCommit string
+		t.Override(&opts, config.SkaffoldOptions{Command: "dependencies", ConfigurationFile: "skaffold.yaml"})
+		t.Override(&dependenciesOutput, "plain")
+		t.Override(&git.SyncRepo, func(g latest.GitInfo, _ config.SkaffoldOptions) (string, error) {
+			syncedRef, syncedCommit = g.Ref, g.Commit
+			return g.Repo, nil
+		})
+		t.Override(&cachedRepos, func(config.SkaffoldOptions) ([]git.CachedRepo, error) { return nil, nil })
+		t.Override(&cachedDeps, func(config.SkaffoldOptions) ([]remoteconfig.CachedDependency, error) { return nil, nil })
+
+		err := refreshDependencies(context.Background(), &bytes.Buffer{})
+		t.CheckNoError(err)
+		t.CheckDeepEqual("main", syncedRef)
+		t.CheckDeepEqual("", syncedCommit)
+
+		// The lock file is left untouched
+		unchanged, err := remoteconfig.ReadLock(tmpDir.Path("skaffold.lock"))
+		t.CheckNoError(err)
+		t.CheckDeepEqual(lock, unchanged)
+	})
+}
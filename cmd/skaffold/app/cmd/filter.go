@@ -18,6 +18,7 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -40,6 +41,9 @@ var doFilter = runFilter
 func NewCmdFilter() *cobra.Command {
 	var debuggingFilters bool
 	var renderFromBuildOutputFile flags.BuildOutputFileFlag
+	var transformsConfig string
+	var resourceSelectorConfig string
+	var validateConfig string
 
 	return NewCmd("filter").
 		Hidden(). // internal command
@@ -49,21 +53,31 @@ func NewCmdFilter() *cobra.Command {
 		WithFlags([]*Flag{
 			{Value: &renderFromBuildOutputFile, Name: "build-artifacts", Shorthand: "a", Usage: "File containing build result from a previous 'skaffold build --file-output'"},
 			{Value: &debuggingFilters, Name: "debugging", DefValue: false, Usage: `Apply debug transforms similar to "skaffold debug"`, IsEnum: true},
+			{Value: &opts.EphemeralNamespace, Name: "ephemeral-namespace", DefValue: "", Usage: "Name of the ephemeral namespace chosen by the parent Skaffold process"},
+			{Value: &transformsConfig, Name: "transforms", DefValue: "", Usage: "Manifest transforms, in JSON, of the deployer of the parent Skaffold process"},
+			{Value: &resourceSelectorConfig, Name: "resource-selector", DefValue: "", Usage: "Custom resources to transform, in JSON, as selected by the deployer of the parent Skaffold process"},
+			{Value: &validateConfig, Name: "validate", DefValue: "", Usage: "Validation config, in JSON, of the manifests passed by the parent Skaffold process"},
 		}).
 		NoArgs(func(ctx context.Context, out io.Writer) error {
-			return doFilter(ctx, out, debuggingFilters, renderFromBuildOutputFile.BuildArtifacts())
+			return doFilter(ctx, out, debuggingFilters, renderFromBuildOutputFile.BuildArtifacts(), transformsConfig, resourceSelectorConfig, validateConfig)
 		})
 }
 
-// runFilter loads the Kubernetes manifests from stdin and applies the debug transformations.
+// runFilter loads the Kubernetes manifests from stdin and applies the debug transformations
+// and the transforms given by the parent Skaffold process, then validates the result if a validation config is given.
+// Custom resources are only transformed if they're selected by the parent Skaffold process.
 // Unlike `skaffold debug`, this filtering affects all images and not just the built artifacts.
-func runFilter(ctx context.Context, out io.Writer, debuggingFilters bool, buildArtifacts []build.Artifact) error {
+func runFilter(ctx context.Context, out io.Writer, debuggingFilters bool, buildArtifacts []build.Artifact, transformsConfig, resourceSelectorConfig, validateConfig string) error {
 	return withRunner(ctx, out, func(r runner.Runner, configs []*latest.SkaffoldConfig) error {
 		manifestList, err := manifest.Load(os.Stdin)
 		if err != nil {
 			return fmt.Errorf("loading manifests: %w", err)
 		}
-		if debuggingFilters {
+		transformer, err := newTransformer(transformsConfig, resourceSelectorConfig)
+		if err != nil {
+			return err
+		}
+		if debuggingFilters || transformer != nil {
 			// TODO(bdealwis): refactor this code
 			debugHelpersRegistry, err := config.GetDebugHelpersRegistry(opts.GlobalConfig)
 			if err != nil {
@@ -74,19 +88,71 @@ func runFilter(ctx context.Context, out io.Writer, debuggingFilters bool, buildA
 				return fmt.Errorf("retrieving insecure registries: %w", err)
 			}
 
-			manifestList, err = debugging.ApplyDebuggingTransforms(manifestList, buildArtifacts, manifest.Registries{
-				DebugHelpersRegistry: debugHelpersRegistry,
-				InsecureRegistries:   insecureRegistries,
-			})
+			if debuggingFilters {
+				manifestList, err = debugging.ApplyDebuggingTransforms(manifestList, buildArtifacts, manifest.Registries{
+					DebugHelpersRegistry: debugHelpersRegistry,
+					InsecureRegistries:   insecureRegistries,
+					ResourceSelector:     transformer.ResourceSelector(),
+				})
+				if err != nil {
+					return fmt.Errorf("transforming manifests: %w", err)
+				}
+			}
+
+			manifestList, err = manifest.ApplyTransforms(manifestList, buildArtifacts, transformer, insecureRegistries, debugHelpersRegistry)
 			if err != nil {
 				return fmt.Errorf("transforming manifests: %w", err)
 			}
 		}
+		if validateConfig != "" {
+			if err := validateManifests(manifestList, validateConfig); err != nil {
+				return err
+			}
+		}
 		out.Write([]byte(manifestList.String()))
 		return nil
 	})
 }
 
+func newTransformer(transformsConfig, resourceSelectorConfig string) (*manifest.Transformer, error) {
+	var cfgs []latest.ManifestTransform
+	if transformsConfig != "" {
+		if err := json.Unmarshal([]byte(transformsConfig), &cfgs); err != nil {
+			return nil, fmt.Errorf("reading manifest transforms: %w", err)
+		}
+	}
+	var filters []latest.ResourceFilter
+	if resourceSelectorConfig != "" {
+		if err := json.Unmarshal([]byte(resourceSelectorConfig), &filters); err != nil {
+			return nil, fmt.Errorf("reading resource selector: %w", err)
+		}
+	}
+	selector, err := manifest.NewResourceSelector(filters)
+	if err != nil {
+		return nil, fmt.Errorf("configuring resource selector: %w", err)
+	}
+	transformer, err := manifest.NewTransformer(cfgs, selector, nil)
+	if err != nil {
+		return nil, fmt.Errorf("configuring manifest transforms: %w", err)
+	}
+	return transformer, nil
+}
+
+func validateManifests(manifestList manifest.ManifestList, validateConfig string) error {
+	var cfg latest.ValidateConfig
+	if err := json.Unmarshal([]byte(validateConfig), &cfg); err != nil {
+		return fmt.Errorf("reading validation config: %w", err)
+	}
+	validator, err := manifest.NewValidator(cfg)
+	if err != nil {
+		return err
+	}
+	if err := validator.Validate(manifestList); err != nil {
+		return fmt.Errorf("validating manifests: %w", err)
+	}
+	return nil
+}
+
 func getInsecureRegistries(opts config.SkaffoldOptions, configs []*latest.SkaffoldConfig) (map[string]bool, error) {
 	cfgRegistries, err := config.GetInsecureRegistries(opts.GlobalConfig)
 	if err != nil {
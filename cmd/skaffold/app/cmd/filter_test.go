@@ -17,9 +17,13 @@ limitations under the License.
 package cmd
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -33,3 +37,77 @@ func TestFilterIsHidden(t *testing.T) {
 		t.CheckDeepEqual(true, cmd.Hidden)
 	})
 }
+
+func TestFilterValidateManifests(t *testing.T) {
+	tests := []struct {
+		description    string
+		manifests      string
+		validateConfig string
+		shouldErr      bool
+	}{
+		{
+			description:    "valid",
+			manifests:      "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: web\ndata:\n  key: value",
+			validateConfig: "{}",
+		},
+		{
+			description:    "invalid",
+			manifests:      "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: web\ndata: [value]",
+			validateConfig: "{}",
+			shouldErr:      true,
+		},
+		{
+			description:    "invalid config",
+			manifests:      "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: web",
+			validateConfig: "{",
+			shouldErr:      true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			err := validateManifests(manifest.ManifestList{[]byte(test.manifests)}, test.validateConfig)
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestFilterNewTransformer(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		cfgs := []latest.ManifestTransform{
+			{Namespace: "prod"},
+			{Sidecar: &latest.SidecarTransform{Container: schemautil.HelmOverrides{Values: map[string]interface{}{"name": "proxy", "image": "envoy"}}}},
+		}
+		transforms, err := json.Marshal(cfgs)
+		t.CheckNoError(err)
+
+		transformer, err := newTransformer(string(transforms), "")
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(cfgs, transformer.Config())
+		t.CheckDeepEqual((*manifest.ResourceSelector)(nil), transformer.ResourceSelector())
+	})
+}
+
+func TestFilterNewTransformerWithResourceSelector(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		filters := []latest.ResourceFilter{{GroupKind: "Rollout.argoproj.io", Image: []string{".spec.template.spec.containers.*.image"}}}
+		selector, err := json.Marshal(filters)
+		t.CheckNoError(err)
+
+		transformer, err := newTransformer("", string(selector))
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(0, len(transformer.Config()))
+		t.CheckDeepEqual(filters, transformer.ResourceSelector().Config())
+	})
+}
+
+func TestFilterNewTransformerWithoutTransforms(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		transformer, err := newTransformer("", "")
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual((*manifest.Transformer)(nil), transformer)
+	})
+}
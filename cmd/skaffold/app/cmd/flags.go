@@ -83,7 +83,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.Profiles,
 		DefValue:      []string{},
 		FlagAddMethod: "StringSliceVar",
-		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "diagnose"},
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "diagnose", "refresh", "lock", "config", "filter"},
 	},
 	{
 		Name:          "namespace",
@@ -128,6 +128,23 @@ var flagRegistry = []Flag{
 		FlagAddMethod: "StringVar",
 		DefinedOn:     []string{"all"},
 	},
+	{
+		Name:          "lock-file",
+		Usage:         "Path to the lock file pinning the versions of remote config dependencies (default skaffold.lock next to the Skaffold config file)",
+		Value:         &opts.LockFile,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"all"},
+	},
+	{
+		Name:          "remote-cache-only",
+		Usage:         "Only use the copies of remote config dependencies already in the git repositories cache, without cloning or syncing them",
+		Value:         &opts.RemoteCacheOnly,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"all"},
+		IsEnum:        true,
+	},
 	{
 		Name:          "insecure-registry",
 		Usage:         "Target registries for built images which are not secure",
@@ -151,7 +168,7 @@ var flagRegistry = []Flag{
 	},
 	{
 		Name:          "event-log-file",
-		Usage:         "Save Skaffold events to the provided file after skaffold has finished executing, requires --enable-rpc=true",
+		Usage:         "Save Skaffold events to the provided file after skaffold has finished executing, in the format of the version 2 of the event API, requires --enable-rpc=true",
 		Value:         &opts.EventLogFile,
 		DefValue:      "",
 		FlagAddMethod: "StringVar",
@@ -284,6 +301,15 @@ var flagRegistry = []Flag{
 		FlagAddMethod: "StringVar",
 		DefinedOn:     []string{"run"},
 	},
+	{
+		Name:          "image-reference",
+		Usage:         "How built images are referenced in manifests and build outputs: 'tag', 'digest' or 'tag@digest'. Images loaded into a local cluster are referenced by a tag computed from their image ID instead of a digest. Defaults to what each builder returns",
+		Value:         &opts.ImageReference,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"build", "debug", "deploy", "dev", "render", "run"},
+		IsEnum:        true,
+	},
 	{
 		Name:          "config",
 		Shorthand:     "c",
@@ -299,7 +325,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.KubeContext,
 		DefValue:      "",
 		FlagAddMethod: "StringVar",
-		DefinedOn:     []string{"build", "debug", "delete", "deploy", "dev", "run", "filter"},
+		DefinedOn:     []string{"build", "debug", "delete", "deploy", "dev", "run", "filter", "gc"},
 	},
 	{
 		Name:          "kubeconfig",
@@ -307,7 +333,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.KubeConfig,
 		DefValue:      "",
 		FlagAddMethod: "StringVar",
-		DefinedOn:     []string{"build", "debug", "delete", "deploy", "dev", "run", "filter"},
+		DefinedOn:     []string{"build", "debug", "delete", "deploy", "dev", "run", "filter", "gc"},
 	},
 	{
 		Name:          "tag",
@@ -423,6 +449,55 @@ var flagRegistry = []Flag{
 		DefinedOn:     []string{"dev", "run", "debug", "build", "deploy"},
 		IsEnum:        true,
 	},
+	{
+		Name:          "logs-image",
+		Usage:         "Only show the logs of containers with an image matching one of the given patterns (prefixed with `!` to negate the match)",
+		Value:         &opts.Logs.Images,
+		DefValue:      []string{},
+		FlagAddMethod: "StringSliceVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy"},
+	},
+	{
+		Name:          "logs-container",
+		Usage:         "Only show the logs of containers with a name matching one of the given patterns (prefixed with `!` to negate the match)",
+		Value:         &opts.Logs.Containers,
+		DefValue:      []string{},
+		FlagAddMethod: "StringSliceVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy"},
+	},
+	{
+		Name:          "logs-include",
+		Usage:         "Only show the log lines matching one of the given regular expressions",
+		Value:         &opts.Logs.Include,
+		DefValue:      []string{},
+		FlagAddMethod: "StringSliceVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy"},
+	},
+	{
+		Name:          "logs-exclude",
+		Usage:         "Hide the log lines matching one of the given regular expressions",
+		Value:         &opts.Logs.Exclude,
+		DefValue:      []string{},
+		FlagAddMethod: "StringSliceVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy"},
+	},
+	{
+		Name:          "logs-level",
+		Usage:         "Minimum level of the JSON structured log lines to show (trace, debug, info, warn, error, fatal)",
+		Value:         &opts.Logs.Level,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy"},
+		IsEnum:        true,
+	},
+	{
+		Name:          "logs-dir",
+		Usage:         "Directory where the full logs of each container are also written",
+		Value:         &opts.Logs.Dir,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy"},
+	},
 	{
 		Name:          "wait-for-deletions",
 		Usage:         "Wait for pending deletions to complete before a deployment",
@@ -448,6 +523,14 @@ var flagRegistry = []Flag{
 		FlagAddMethod: "DurationVar",
 		DefinedOn:     []string{"deploy", "dev", "run", "debug"},
 	},
+	{
+		Name:          "resource-ttl",
+		Usage:         "Duration after which the deployed resources can be garbage collected by later Skaffold runs, if they're still there. Zero means never",
+		Value:         &opts.ResourceTTL,
+		DefValue:      time.Duration(0),
+		FlagAddMethod: "DurationVar",
+		DefinedOn:     []string{"deploy", "dev", "run", "debug"},
+	},
 	{
 		Name:          "build-image",
 		Shorthand:     "b",
@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/gc"
+)
+
+// for tests
+var (
+	listResources   = gc.List
+	deleteResources = gc.Delete
+	timeNow         = time.Now
+)
+
+// NewCmdGC describes the CLI command to garbage collect the resources left behind by Skaffold sessions.
+func NewCmdGC() *cobra.Command {
+	var namespaces []string
+	var allNamespaces bool
+	var olderThan time.Duration
+	var dryRun bool
+
+	return NewCmd("gc").
+		WithDescription("[alpha] Delete the resources left behind by previous Skaffold sessions").
+		WithLongDescription("Lists the resources deployed by Skaffold, grouped by session, and deletes the stale ones. A resource is stale if its session was last deployed before `--older-than` or if it has outlived the TTL set with `--resource-ttl`, both measured from its last deployment. Resources are only deleted if the namespaces to clean are given with `--namespace` or `--all-namespaces`.").
+		WithExample("List the stale resources without deleting them", "gc --dry-run").
+		WithExample("Delete the resources of sessions inactive for a week in namespace `dev`", "gc --older-than=168h -n dev").
+		WithExample("Delete the stale resources and ephemeral namespaces of the whole cluster", "gc --all-namespaces").
+		WithCommonFlags().
+		WithFlags([]*Flag{
+			{Value: &namespaces, Name: "namespace", Shorthand: "n", DefValue: []string{}, Usage: "Look for resources in these namespaces"},
+			{Value: &allNamespaces, Name: "all-namespaces", Shorthand: "A", DefValue: false, Usage: "Look for resources in all the namespaces, including the ephemeral namespaces", IsEnum: true},
+			{Value: &olderThan, Name: "older-than", DefValue: 24 * time.Hour, FlagAddMethod: "DurationVar", Usage: "Sessions last deployed before this duration are stale. Zero means that only resources with an expired TTL are stale"},
+			{Value: &dryRun, Name: "dry-run", DefValue: false, Usage: "List the stale resources without deleting them. Implied if neither --namespace nor --all-namespaces is given", IsEnum: true},
+		}).
+		NoArgs(func(ctx context.Context, out io.Writer) error {
+			return doGC(ctx, out, namespaces, allNamespaces, olderThan, dryRun)
+		})
+}
+
+func doGC(ctx context.Context, out io.Writer, namespaces []string, allNamespaces bool, olderThan time.Duration, dryRun bool) error {
+	if len(namespaces) > 0 && allNamespaces {
+		return errors.New("--namespace and --all-namespaces are mutually exclusive")
+	}
+	// Deleting from the whole cluster has to be asked for explicitly
+	scoped := len(namespaces) > 0 || allNamespaces
+
+	kubectx.ConfigureKubeConfig(opts.KubeConfig, opts.KubeContext, "")
+
+	resources, err := listResources(ctx, "", namespaces)
+	if err != nil {
+		return fmt.Errorf("listing resources deployed by Skaffold: %w", err)
+	}
+	if len(resources) == 0 {
+		fmt.Fprintln(out, "No resources deployed by Skaffold")
+		return nil
+	}
+
+	current := timeNow()
+	var stale []gc.Resource
+	for _, s := range gc.Sessions(resources) {
+		sessionIsStale := olderThan > 0 && current.Sub(s.LastDeployed) > olderThan
+
+		user := s.User
+		if user == "" {
+			user = "unknown user"
+		}
+		header := fmt.Sprintf("Run %s by %s, deployed %s ago", s.RunID, user, duration.HumanDuration(current.Sub(s.LastDeployed)))
+		if sessionIsStale {
+			color.Yellow.Fprintf(out, "%s (stale)\n", header)
+		} else {
+			color.Default.Fprintln(out, header)
+		}
+
+		for _, r := range s.Resources {
+			line := " - " + r.String()
+			if r.Namespace != "" {
+				line += fmt.Sprintf(" in namespace %q", r.Namespace)
+			}
+			switch {
+			case r.Expired(current):
+				line += fmt.Sprintf(" (TTL of %s expired)", r.TTL)
+				stale = append(stale, r)
+			case sessionIsStale:
+				stale = append(stale, r)
+			}
+			fmt.Fprintln(out, line)
+		}
+	}
+
+	switch {
+	case len(stale) == 0:
+		fmt.Fprintln(out, "No stale resources")
+		return nil
+	case dryRun:
+		fmt.Fprintf(out, "%d stale resources would be deleted\n", len(stale))
+		return nil
+	case !scoped:
+		fmt.Fprintf(out, "%d stale resources would be deleted. Pass --namespace or --all-namespaces to delete them\n", len(stale))
+		return nil
+	}
+
+	if err := deleteResources(ctx, "", stale); err != nil {
+		return err
+	}
+	color.Green.Fprintf(out, "Deleted %d stale resources\n", len(stale))
+	return nil
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/gc"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestGC(t *testing.T) {
+	current := time.Date(2021, 4, 10, 12, 0, 0, 0, time.UTC)
+	deployments := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	configMaps := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+	web := gc.Resource{GVR: deployments, Kind: "Deployment", Namespace: "default", Name: "web", RunID: "run1", User: "jane", Deployed: current.Add(-72 * time.Hour)}
+	cfg := gc.Resource{GVR: configMaps, Kind: "ConfigMap", Namespace: "default", Name: "cfg", RunID: "run2", Deployed: current.Add(-2 * time.Hour), TTL: time.Hour}
+	api := gc.Resource{GVR: deployments, Kind: "Deployment", Namespace: "default", Name: "api", RunID: "run2", Deployed: current.Add(-time.Hour)}
+
+	tests := []struct {
+		description     string
+		resources       []gc.Resource
+		namespaces      []string
+		allNamespaces   bool
+		olderThan       time.Duration
+		dryRun          bool
+		deleteErr       error
+		expectedDeleted []gc.Resource
+		expectedOutput  string
+		shouldErr       bool
+	}{
+		{
+			description:    "nothing deployed",
+			olderThan:      24 * time.Hour,
+			expectedOutput: "No resources deployed by Skaffold\n",
+		},
+		{
+			description:     "stale sessions and expired resources are deleted",
+			resources:       []gc.Resource{api, web, cfg},
+			olderThan:       24 * time.Hour,
+			expectedDeleted: []gc.Resource{web, cfg},
+			expectedOutput: `Run run1 by jane, deployed 3d ago (stale)
+ - deployment.apps/web in namespace "default"
+Run run2 by unknown user, deployed 60m ago
+ - deployment.apps/api in namespace "default"
+ - configmap/cfg in namespace "default" (TTL of 1h0m0s expired)
+Deleted 2 stale resources
+`,
+		},
+		{
+			description:     "only expired resources",
+			resources:       []gc.Resource{web, cfg},
+			expectedDeleted: []gc.Resource{cfg},
+			expectedOutput: `Run run1 by jane, deployed 3d ago
+ - deployment.apps/web in namespace "default"
+Run run2 by unknown user, deployed 120m ago
+ - configmap/cfg in namespace "default" (TTL of 1h0m0s expired)
+Deleted 1 stale resources
+`,
+		},
+		{
+			description: "dry run",
+			resources:   []gc.Resource{web},
+			olderThan:   24 * time.Hour,
+			dryRun:      true,
+			expectedOutput: `Run run1 by jane, deployed 3d ago (stale)
+ - deployment.apps/web in namespace "default"
+1 stale resources would be deleted
+`,
+		},
+		{
+			description: "no stale resources",
+			resources:   []gc.Resource{api},
+			olderThan:   24 * time.Hour,
+			expectedOutput: `Run run2 by unknown user, deployed 60m ago
+ - deployment.apps/api in namespace "default"
+No stale resources
+`,
+		},
+		{
+			description:     "all namespaces",
+			resources:       []gc.Resource{web},
+			namespaces:      []string{},
+			allNamespaces:   true,
+			olderThan:       24 * time.Hour,
+			expectedDeleted: []gc.Resource{web},
+			expectedOutput: `Run run1 by jane, deployed 3d ago (stale)
+ - deployment.apps/web in namespace "default"
+Deleted 1 stale resources
+`,
+		},
+		{
+			description: "dry run without namespaces",
+			resources:   []gc.Resource{web},
+			namespaces:  []string{},
+			olderThan:   24 * time.Hour,
+			expectedOutput: `Run run1 by jane, deployed 3d ago (stale)
+ - deployment.apps/web in namespace "default"
+1 stale resources would be deleted. Pass --namespace or --all-namespaces to delete them
+`,
+		},
+		{
+			description:   "namespaces and all namespaces",
+			namespaces:    []string{"default"},
+			allNamespaces: true,
+			shouldErr:     true,
+		},
+		{
+			description:     "deletion error",
+			resources:       []gc.Resource{web},
+			olderThan:       24 * time.Hour,
+			deleteErr:       errors.New("forbidden"),
+			expectedDeleted: []gc.Resource{web},
+			shouldErr:       true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var deleted []gc.Resource
+			t.Override(&timeNow, func() time.Time { return current })
+			namespaces := test.namespaces
+			if namespaces == nil {
+				namespaces = []string{"default"}
+			}
+			t.Override(&listResources, func(_ context.Context, kubeContext string, listed []string) ([]gc.Resource, error) {
+				t.CheckDeepEqual(namespaces, listed)
+				return test.resources, nil
+			})
+			t.Override(&deleteResources, func(_ context.Context, kubeContext string, resources []gc.Resource) error {
+				deleted = resources
+				return test.deleteErr
+			})
+
+			var out bytes.Buffer
+			err := doGC(context.Background(), &out, namespaces, test.allNamespaces, test.olderThan, test.dryRun)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedDeleted, deleted)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expectedOutput, out.String())
+			}
+		})
+	}
+}
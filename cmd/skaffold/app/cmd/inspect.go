@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+var inspectOutput string
+
+// NewCmdInspect describes the CLI command to inspect the resolved skaffold configuration and recorded events.
+func NewCmdInspect() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inspect",
+		Short: "Inspect the resolved configuration of the current project",
+	}
+
+	cmd.AddCommand(NewCmdInspectConfig())
+	cmd.AddCommand(NewCmdInspectEvents())
+	return cmd
+}
+
+func NewCmdInspectConfig() *cobra.Command {
+	return NewCmd("config").
+		WithDescription("Print the effective configuration of all modules, annotated with the source of each value").
+		WithLongDescription("Prints the configuration of all modules after resolving dependencies, applying profiles and setting default values. Each value is annotated with where it came from: the file it was read from, the profile or profile patch that set it, or `default` for values set by Skaffold.").
+		WithExample("Print the effective configuration of the current project", "inspect config").
+		WithExample("Print the effective configuration with a given profile, in json format", "inspect config -p PROFILE -o json").
+		WithCommonFlags().
+		WithFlags([]*Flag{
+			{Value: &inspectOutput, Name: "output", Shorthand: "o", DefValue: "yaml", Usage: "Type of output: `yaml` or `json`."}}).
+		NoArgs(inspectConfig)
+}
+
+// effectiveConfig is a single module of `skaffold inspect config -o json`.
+type effectiveConfig struct {
+	File    string                 `json:"file"`
+	Config  map[string]interface{} `json:"config"`
+	Sources map[string]string      `json:"sources"`
+}
+
+func inspectConfig(ctx context.Context, out io.Writer) error {
+	if inspectOutput != "yaml" && inspectOutput != "json" {
+		return fmt.Errorf(`invalid output type: %q. Must be "yaml" or "json"`, inspectOutput)
+	}
+	configs, tracker, err := getAllConfigsWithProvenance(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if inspectOutput == "yaml" {
+		buf, err := tracker.AnnotatedYAML(configs)
+		if err != nil {
+			return fmt.Errorf("marshalling configuration: %w", err)
+		}
+		_, err = out.Write(buf)
+		return err
+	}
+
+	effective := []effectiveConfig{}
+	for _, c := range configs {
+		// round-trip through yaml so that the json output uses the same field names as `skaffold.yaml`.
+		buf, err := yaml.Marshal(c)
+		if err != nil {
+			return fmt.Errorf("marshalling configuration: %w", err)
+		}
+		var fields map[string]interface{}
+		if err := yaml.Unmarshal(buf, &fields); err != nil {
+			return fmt.Errorf("marshalling configuration: %w", err)
+		}
+		effective = append(effective, effectiveConfig{File: tracker.File(c), Config: fields, Sources: tracker.Sources(c)})
+	}
+	return json.NewEncoder(out).Encode(effective)
+}
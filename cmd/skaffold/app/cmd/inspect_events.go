@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	//nolint:golint,staticcheck
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/spf13/cobra"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/server"
+	protoV2 "github.com/GoogleContainerTools/skaffold/proto/v2"
+)
+
+var (
+	inspectEventsOutput string
+	serveEvents         bool
+	realtimeEvents      bool
+)
+
+// NewCmdInspectEvents describes the CLI command to inspect a recorded event log.
+func NewCmdInspectEvents() *cobra.Command {
+	return NewCmd("events").
+		WithDescription("Summarize or replay the events recorded with `--event-log-file`").
+		WithLongDescription("Reads an event log file written with `--event-log-file`. By default, prints a summary of each dev loop iteration: the tasks that ran, how long they took and the errors they returned. With `-o timeline`, prints the state of the session after each event. With `--serve`, serves the recorded events over the v2 event API until interrupted, so that tools can be developed against a recorded session.").
+		WithExample("Summarize the iterations of a recorded session", "inspect events events.json").
+		WithExample("Print the state timeline of a recorded session", "inspect events events.json -o timeline").
+		WithExample("Serve the recorded events with their original timing", "inspect events events.json --serve --realtime").
+		WithFlags([]*Flag{
+			{Value: &inspectEventsOutput, Name: "output", Shorthand: "o", DefValue: "summary", Usage: "Type of output: `summary` or `timeline`."},
+			{Value: &serveEvents, Name: "serve", DefValue: false, Usage: "Serve the recorded events over the v2 event API instead of printing them"},
+			{Value: &realtimeEvents, Name: "realtime", DefValue: false, Usage: "When serving, send the events with the delays of the recorded session"},
+			{Value: &opts.RPCPort, Name: "rpc-port", DefValue: constants.DefaultRPCPort, FlagAddMethod: "IntVar", Usage: "tcp port to expose event API"},
+			{Value: &opts.RPCHTTPPort, Name: "rpc-http-port", DefValue: constants.DefaultRPCHTTPPort, FlagAddMethod: "IntVar", Usage: "tcp port to expose event REST API over HTTP"},
+		}).
+		ExactArgs(1, inspectEvents)
+}
+
+func inspectEvents(ctx context.Context, out io.Writer, args []string) error {
+	if inspectEventsOutput != "summary" && inspectEventsOutput != "timeline" {
+		return fmt.Errorf(`invalid output type: %q. Must be "summary" or "timeline"`, inspectEventsOutput)
+	}
+	events, err := eventV2.LoadEventsFromFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case serveEvents:
+		return serveRecordedEvents(ctx, out, events)
+	case inspectEventsOutput == "timeline":
+		return printTimeline(out, events)
+	default:
+		printSummary(out, eventV2.Summarize(events))
+		return nil
+	}
+}
+
+func serveRecordedEvents(ctx context.Context, out io.Writer, events []*protoV2.Event) error {
+	serverOpts := opts
+	serverOpts.EnableRPC = true
+	serverOpts.EventLogFile = ""
+	shutdown, err := server.InitializeV2(serverOpts)
+	if err != nil {
+		return fmt.Errorf("initializing api server: %w", err)
+	}
+	shutdownAPIServer = shutdown
+
+	fmt.Fprintf(out, "Serving %d events. Press Ctrl+C to stop.\n", len(events))
+	if err := eventV2.Replay(ctx, events, realtimeEvents); err != nil && ctx.Err() == nil {
+		return err
+	}
+	<-ctx.Done()
+	return nil
+}
+
+// timelineEntry is a line of `skaffold inspect events -o timeline`.
+type timelineEntry struct {
+	Event json.RawMessage `json:"event"`
+	State json.RawMessage `json:"state"`
+}
+
+func printTimeline(out io.Writer, events []*protoV2.Event) error {
+	marshaller := jsonpb.Marshaler{}
+	encoder := json.NewEncoder(out)
+	for _, change := range eventV2.StateTimeline(events) {
+		var event, state bytes.Buffer
+		if err := marshaller.Marshal(&event, change.Event); err != nil {
+			return fmt.Errorf("marshalling event: %w", err)
+		}
+		if err := marshaller.Marshal(&state, change.State); err != nil {
+			return fmt.Errorf("marshalling state: %w", err)
+		}
+		if err := encoder.Encode(timelineEntry{Event: event.Bytes(), State: state.Bytes()}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printSummary(out io.Writer, iterations []*eventV2.IterationSummary) {
+	for _, it := range iterations {
+		color.Default.Fprintf(out, "Iteration %d: %s", it.Iteration, it.Status)
+		if it.Duration > 0 {
+			fmt.Fprintf(out, " in %s", it.Duration.Round(time.Millisecond))
+		}
+		fmt.Fprintln(out)
+
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		for _, task := range it.Tasks {
+			indent := "  "
+			if task.Type == "RESOURCE_STATUS_CHECK" {
+				indent = "    "
+			}
+			line := []string{indent + task.Type, task.Name, task.Status, task.Duration.Round(time.Millisecond).String()}
+			if task.Error != "" {
+				line = append(line, fmt.Sprintf("%s: %s", task.ErrorCode, task.Error))
+			}
+			fmt.Fprintln(w, strings.Join(line, "\t"))
+		}
+		w.Flush()
+	}
+}
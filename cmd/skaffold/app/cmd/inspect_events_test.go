@@ -0,0 +1,78 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const recordedEvents = `{"iteration":1,"taskId":"DEV_LOOP-1","taskType":"DEV_LOOP","status":"STARTED"}
+{"iteration":1,"taskId":"BUILD-2","parentTaskId":"DEV_LOOP-1","taskType":"BUILD","name":"web","status":"STARTED"}
+{"iteration":1,"taskId":"BUILD-2","parentTaskId":"DEV_LOOP-1","taskType":"BUILD","name":"web","status":"FAILED","duration":"1.500s","error":"no Dockerfile","errorCode":"BUILD_USER_ERROR"}
+{"iteration":1,"taskId":"DEV_LOOP-1","taskType":"DEV_LOOP","status":"FAILED","duration":"2s"}
+`
+
+func TestInspectEvents(t *testing.T) {
+	tests := []struct {
+		description string
+		output      string
+		shouldErr   bool
+		expected    string
+	}{
+		{
+			description: "summary",
+			output:      "summary",
+			expected: `Iteration 1: FAILED in 2s
+  BUILD  web  FAILED  1.5s  BUILD_USER_ERROR: no Dockerfile
+`,
+		},
+		{
+			description: "timeline",
+			output:      "timeline",
+			expected:    `{"event":{"iteration":1,"taskId":"BUILD-2","parentTaskId":"DEV_LOOP-1","taskType":"BUILD","name":"web","status":"STARTED"},"state":{"buildState":{"artifacts":{"web":"In Progress"}}`,
+		},
+		{
+			description: "invalid output",
+			output:      "yaml",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			file := t.NewTempDir().Write("events.json", recordedEvents).Path("events.json")
+			t.Override(&inspectEventsOutput, test.output)
+			t.Override(&serveEvents, false)
+
+			var out bytes.Buffer
+			err := inspectEvents(context.Background(), &out, []string{file})
+
+			t.CheckError(test.shouldErr, err)
+			if test.output == "timeline" {
+				lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+				t.CheckDeepEqual(3, len(lines))
+				t.CheckContains(test.expected, lines[1])
+			} else {
+				t.CheckDeepEqual(test.expected, out.String())
+			}
+		})
+	}
+}
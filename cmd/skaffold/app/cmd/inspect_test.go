@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestInspectConfig(t *testing.T) {
+	skaffoldYaml := fmt.Sprintf(`apiVersion: %s
+kind: Config
+build:
+  artifacts:
+  - image: app
+profiles:
+- name: prod
+  patches:
+  - path: /build/artifacts/0/image
+    value: app-prod
+`, latest.Version)
+
+	tests := []struct {
+		description string
+		output      string
+		shouldErr   bool
+		expected    string
+	}{
+		{
+			description: "yaml",
+			output:      "yaml",
+			expected: `# Source: {{file}}
+apiVersion: ` + latest.Version + ` # from: {{file}}
+kind: Config # from: {{file}}
+build:
+  artifacts:
+  - image: app-prod # from: profile prod patch 0 (replace /build/artifacts/0/image)
+    context: . # from: default
+    docker:
+      dockerfile: Dockerfile # from: default
+  tagPolicy:
+    gitCommit: {} # from: default
+  local:
+    concurrency: 1 # from: default
+deploy:
+  logs:
+    prefix: container # from: default
+profiles:
+- name: prod # from: {{file}}
+  patches:
+  - path: /build/artifacts/0/image # from: {{file}}
+    value: app-prod # from: {{file}}
+`,
+		},
+		{
+			description: "json",
+			output:      "json",
+			expected: `[{"file":"{{file}}","config":{"apiVersion":"` + latest.Version + `",` +
+				`"build":{"artifacts":[{"context":".","docker":{"dockerfile":"Dockerfile"},"image":"app-prod"}],"local":{"concurrency":1},"tagPolicy":{"gitCommit":{}}},` +
+				`"deploy":{"logs":{"prefix":"container"}},"kind":"Config","profiles":[{"name":"prod","patches":[{"path":"/build/artifacts/0/image","value":"app-prod"}]}]},` +
+				`"sources":{"apiVersion":"{{file}}","build.artifacts[0].context":"default","build.artifacts[0].docker.dockerfile":"default",` +
+				`"build.artifacts[0].image":"profile prod patch 0 (replace /build/artifacts/0/image)","build.local.concurrency":"default",` +
+				`"build.tagPolicy.gitCommit":"default","deploy.logs.prefix":"default","kind":"{{file}}","profiles[0].name":"{{file}}",` +
+				`"profiles[0].patches[0].path":"{{file}}","profiles[0].patches[0].value":"{{file}}"}}]` + "\n",
+		},
+		{
+			description: "invalid output",
+			output:      "plain",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Write("skaffold.yaml", skaffoldYaml)
+			tmpDir.Chdir()
+			t.Override(&inspectOutput, test.output)
+			t.Override(&opts, config.SkaffoldOptions{ConfigurationFile: "skaffold.yaml", Profiles: []string{"prod"}})
+
+			var out bytes.Buffer
+			err := inspectConfig(context.Background(), &out)
+			file := filepath.Join(tmpDir.Root(), "skaffold.yaml")
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, strings.ReplaceAll(test.expected, "{{file}}", file), out.String())
+		})
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -28,9 +29,11 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/git"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/remoteconfig"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/defaults"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/provenance"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tags"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
@@ -52,16 +55,59 @@ type configOpts struct {
 type record struct {
 	appliedProfiles  map[string]string      // config -> list of applied profiles
 	configNameToFile map[string]string      // configName -> file path
-	cachedRepos      map[string]interface{} // git repo -> cache path or error
+	cachedRepos      map[string]interface{} // remote dependency -> cache path or error
+	lock             *remoteconfig.Lock     // pinned versions of remote dependencies, if any
+	resolved         *remoteconfig.Lock     // versions of remote dependencies resolved in this run, if requested
+	tracker          *provenance.Tracker    // sources of config values, if requested
+	ctx              context.Context        // cancels the syncing of remote dependencies
 }
 
-func newRecord() *record {
-	return &record{appliedProfiles: make(map[string]string), configNameToFile: make(map[string]string), cachedRepos: make(map[string]interface{})}
+func newRecord(ctx context.Context) *record {
+	return &record{appliedProfiles: make(map[string]string), configNameToFile: make(map[string]string), cachedRepos: make(map[string]interface{}), ctx: ctx}
 }
 
-func getAllConfigs(opts config.SkaffoldOptions) ([]*latest.SkaffoldConfig, error) {
-	cOpts := configOpts{file: opts.ConfigurationFile, selection: nil, profiles: opts.Profiles, isRequired: false, isDependency: false}
-	cfgs, err := getConfigs(cOpts, opts, newRecord())
+func getAllConfigs(ctx context.Context, opts config.SkaffoldOptions) ([]*latest.SkaffoldConfig, error) {
+	lock, err := remoteconfig.ReadLock(remoteconfig.LockFile(opts))
+	if err != nil {
+		return nil, err
+	}
+	cfgs, _, err := resolveAllConfigs(ctx, opts, lock, false)
+	return cfgs, err
+}
+
+// resolveAllConfigs parses all configs, pinning remote dependencies to the versions in `lock` if it isn't nil.
+// If `resolveVersions` is true, it also returns the versions of the remote dependencies that were resolved.
+func resolveAllConfigs(ctx context.Context, opts config.SkaffoldOptions, lock *remoteconfig.Lock, resolveVersions bool) ([]*latest.SkaffoldConfig, *remoteconfig.Lock, error) {
+	r := newRecord(ctx)
+	r.lock = lock
+	if resolveVersions {
+		r.resolved = &remoteconfig.Lock{}
+	}
+	cfgs, err := parseAllConfigs(opts, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cfgs, r.resolved, nil
+}
+
+// getAllConfigsWithProvenance parses all configs like `getAllConfigs`, and additionally tracks the source of every value in the resulting configs.
+func getAllConfigsWithProvenance(ctx context.Context, opts config.SkaffoldOptions) ([]*latest.SkaffoldConfig, *provenance.Tracker, error) {
+	lock, err := remoteconfig.ReadLock(remoteconfig.LockFile(opts))
+	if err != nil {
+		return nil, nil, err
+	}
+	r := newRecord(ctx)
+	r.lock = lock
+	r.tracker = provenance.NewTracker()
+	cfgs, err := parseAllConfigs(opts, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cfgs, r.tracker, nil
+}
+
+func parseAllConfigs(opts config.SkaffoldOptions, r *record) ([]*latest.SkaffoldConfig, error) {
+	cfgs, err := getConfigs(configOpts{file: opts.ConfigurationFile, selection: nil, profiles: opts.Profiles, isRequired: false, isDependency: false}, opts, r)
 	if err != nil {
 		return nil, err
 	}
@@ -138,18 +184,38 @@ func processEachConfig(config *latest.SkaffoldConfig, cfgOpts configOpts, opts c
 	// `requiredConfigs` specifies if we are already in the dependency-tree of a required config, so all selected configs are required even if they are not explicitly named via the configuration flag.
 	required := cfgOpts.isRequired || len(opts.ConfigurationFilter) == 0 || util.StrSliceContains(opts.ConfigurationFilter, config.Metadata.Name)
 
-	profiles, err := schema.ApplyProfiles(config, opts, cfgOpts.profiles)
+	var trace func(string) error
+	if r.tracker != nil {
+		if err := r.tracker.Start(config, cfgOpts.file); err != nil {
+			return nil, err
+		}
+		trace = func(source string) error { return r.tracker.Observe(config, source) }
+	}
+	// profile activation conditions like `fileExists` are relative to the file the config was read from.
+	profileOpts := opts
+	profileOpts.ConfigurationFile = cfgOpts.file
+	profiles, err := schema.ApplyProfilesWithTrace(config, profileOpts, cfgOpts.profiles, trace)
 	if err != nil {
 		return nil, fmt.Errorf("applying profiles: %w", err)
 	}
 	if err := defaults.Set(config); err != nil {
 		return nil, fmt.Errorf("setting default values: %w", err)
 	}
+	if trace != nil {
+		if err := trace(provenance.Default); err != nil {
+			return nil, err
+		}
+	}
 	// convert relative file paths to absolute for all configs that are not invoked explicitly. This avoids maintaining multiple root directory information since the dependency skaffold configs would have their own root directory.
 	if cfgOpts.isDependency {
 		if err := tags.MakeFilePathsAbsolute(config, filepath.Dir(cfgOpts.file)); err != nil {
 			return nil, fmt.Errorf("setting absolute filepaths: %w", err)
 		}
+		if r.tracker != nil {
+			if err := r.tracker.Refresh(config); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	sort.Strings(profiles)
@@ -195,12 +261,25 @@ func filterActiveProfiles(d latest.ConfigDependency, profiles []string) []string
 func processEachDependency(d latest.ConfigDependency, cfgOpts configOpts, opts config.SkaffoldOptions, r *record) ([]*latest.SkaffoldConfig, error) {
 	path := d.Path
 
-	if d.GitRepo != nil {
-		cachePath, err := cacheRepo(*d.GitRepo, opts, r)
+	switch {
+	case d.GitRepo != nil:
+		cachePath, err := cacheRepo(*d.GitRepo, cfgOpts.profiles, opts, r)
 		if err != nil {
 			return nil, fmt.Errorf("caching remote dependency %s: %w", d.GitRepo.Repo, err)
 		}
 		path = cachePath
+	case d.OCIArtifact != nil:
+		cachePath, err := cacheOCIArtifact(*d.OCIArtifact, cfgOpts.profiles, opts, r)
+		if err != nil {
+			return nil, fmt.Errorf("caching remote dependency %s: %w", d.OCIArtifact.Image, err)
+		}
+		path = cachePath
+	case d.Archive != nil:
+		cachePath, err := cacheArchive(*d.Archive, cfgOpts.profiles, opts, r)
+		if err != nil {
+			return nil, fmt.Errorf("caching remote dependency %s: %w", d.Archive.URL, err)
+		}
+		path = cachePath
 	}
 
 	if path == "" {
@@ -233,27 +312,125 @@ func processEachDependency(d latest.ConfigDependency, cfgOpts configOpts, opts c
 }
 
 // cacheRepo downloads the referenced git repository to skaffold's cache if required and returns the path to the target configuration file in that repository.
-func cacheRepo(g latest.GitInfo, opts config.SkaffoldOptions, r *record) (string, error) {
+func cacheRepo(g latest.GitInfo, profiles []string, opts config.SkaffoldOptions, r *record) (string, error) {
+	dep := remoteconfig.LockedDependency{Type: "git", Source: g.Repo, Ref: g.Ref, Path: g.Path, ActiveProfiles: profiles}
+	if g.Commit != "" {
+		dep.Ref = g.Commit
+	}
+	locked, err := r.pinned(dep)
+	if err != nil {
+		return "", err
+	}
+	if locked != nil {
+		g.Commit = locked.Resolved
+	}
+
 	key := fmt.Sprintf("%s@%s", g.Repo, g.Ref)
+	if g.Commit != "" {
+		key = fmt.Sprintf("%s@%s", g.Repo, g.Commit)
+	}
+	if g.Sparse {
+		key = fmt.Sprintf("%s:%s", key, g.Path)
+	}
+	root, err := cacheDependency(key, r, func() (string, error) { return git.SyncRepo(g, opts) })
+	if err != nil {
+		return "", err
+	}
+	if r.resolved != nil {
+		if dep.Resolved, err = git.HeadCommit(root); err != nil {
+			return "", err
+		}
+		r.resolved.Add(dep)
+	}
+	return filepath.Join(root, g.Path), nil
+}
+
+// cacheOCIArtifact pulls the referenced OCI artifact to skaffold's cache if required and returns the path to the target configuration file in that artifact.
+func cacheOCIArtifact(a latest.OCIArtifactInfo, profiles []string, opts config.SkaffoldOptions, r *record) (string, error) {
+	dep := remoteconfig.LockedDependency{Type: remoteconfig.TypeOCI, Source: a.Image, Path: a.Path, ActiveProfiles: profiles}
+	locked, err := r.pinned(dep)
+	if err != nil {
+		return "", err
+	}
+	if locked != nil {
+		if a.Image, err = remoteconfig.PinnedImage(a.Image, locked.Resolved); err != nil {
+			return "", err
+		}
+	}
+
+	key := fmt.Sprintf("oci://%s", a.Image)
+	root, err := cacheDependency(key, r, func() (string, error) { return remoteconfig.SyncOCIArtifact(r.ctx, a, opts) })
+	if err != nil {
+		return "", err
+	}
+	if r.resolved != nil {
+		if dep.Resolved, err = remoteconfig.ResolvedDigest(root); err != nil {
+			return "", err
+		}
+		r.resolved.Add(dep)
+	}
+	return filepath.Join(root, a.Path), nil
+}
+
+// cacheArchive downloads the referenced archive to skaffold's cache if required and returns the path to the target configuration file in that archive.
+func cacheArchive(a latest.ArchiveInfo, profiles []string, opts config.SkaffoldOptions, r *record) (string, error) {
+	dep := remoteconfig.LockedDependency{Type: remoteconfig.TypeArchive, Source: a.URL, Path: a.Path, Resolved: "sha256:" + strings.ToLower(a.SHA256), ActiveProfiles: profiles}
+	locked, err := r.pinned(dep)
+	if err != nil {
+		return "", err
+	}
+	if locked != nil && locked.Resolved != dep.Resolved {
+		return "", fmt.Errorf("archive %s has checksum %s in the lock file but %s in the skaffold config; run `skaffold dependencies lock` to update the lock file", a.URL, locked.Resolved, dep.Resolved)
+	}
+
+	key := fmt.Sprintf("%s@%s", a.URL, a.SHA256)
+	root, err := cacheDependency(key, r, func() (string, error) { return remoteconfig.SyncArchive(r.ctx, a, opts) })
+	if err != nil {
+		return "", err
+	}
+	if r.resolved != nil {
+		r.resolved.Add(dep)
+	}
+	return filepath.Join(root, a.Path), nil
+}
+
+// cacheDependency syncs a remote dependency once per run and returns its cache directory.
+func cacheDependency(key string, r *record, sync func() (string, error)) (string, error) {
 	if p, found := r.cachedRepos[key]; found {
 		switch v := p.(type) {
 		case string:
-			return filepath.Join(v, g.Path), nil
+			return v, nil
 		case error:
 			return "", v
 		default:
-			logrus.Fatalf("unable to check download status of repo %s at ref %s", g.Repo, g.Ref)
+			logrus.Fatalf("unable to check download status of remote dependency %s", key)
 			return "", nil
 		}
-	} else {
-		p, err := git.SyncRepo(g, opts)
-		if err != nil {
-			r.cachedRepos[key] = err
-			return "", err
+	}
+	p, err := sync()
+	if err != nil {
+		r.cachedRepos[key] = err
+		return "", err
+	}
+	r.cachedRepos[key] = p
+	return p, nil
+}
+
+// pinned returns the locked version of a remote dependency, or `nil` if there's no lock file.
+func (r *record) pinned(dep remoteconfig.LockedDependency) (*remoteconfig.LockedDependency, error) {
+	if r.lock == nil {
+		return nil, nil
+	}
+	locked := r.lock.Find(dep)
+	if locked == nil {
+		return nil, fmt.Errorf("remote dependency %s is missing from the lock file; run `skaffold dependencies lock` to update the lock file", dep.Source)
+	}
+	for _, p := range dep.ActiveProfiles {
+		if !util.StrSliceContains(locked.ActiveProfiles, p) {
+			logrus.Warnf("remote dependency %s is activated with profile %q which wasn't active when the lock file was created", dep.Source, p)
 		}
-		r.cachedRepos[key] = p
-		return filepath.Join(p, g.Path), nil
 	}
+	return locked, nil
 }
 
 // checkRevisit ensures that each config is activated with the same set of active profiles
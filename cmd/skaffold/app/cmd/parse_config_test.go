@@ -17,6 +17,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 	"strings"
@@ -24,6 +25,7 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/git"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/remoteconfig"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
@@ -400,6 +402,32 @@ requires:
 				createCfg("cfg01", "image01", ".", nil),
 			},
 		},
+		{
+			description: "remote oci and archive dependencies",
+			documents: []document{
+				{path: "skaffold.yaml", configs: []mockCfg{{name: "cfg00", requiresStanza: `
+requires:
+  - oci:
+      image: doc1
+    configs: [cfg10]
+  - archive:
+      url: doc2
+      sha256: abcd
+      path: skaffold.yaml
+    configs: [cfg21]
+`}}},
+				{path: "doc1/skaffold.yaml", configs: []mockCfg{{name: "cfg10", requiresStanza: ""}}},
+				{path: "doc2/skaffold.yaml", configs: []mockCfg{{name: "cfg20", requiresStanza: ""}, {name: "cfg21", requiresStanza: ""}}},
+			},
+			expected: []*latest.SkaffoldConfig{
+				createCfg("cfg10", "image10", "doc1", nil),
+				createCfg("cfg21", "image21", "doc2", nil),
+				createCfg("cfg00", "image00", ".", []latest.ConfigDependency{
+					{OCIArtifact: &latest.OCIArtifactInfo{Image: "doc1"}, Names: []string{"cfg10"}},
+					{Archive: &latest.ArchiveInfo{URL: "doc2", SHA256: "abcd", Path: "skaffold.yaml"}, Names: []string{"cfg21"}},
+				}),
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -432,7 +460,13 @@ requires:
 				}
 			}
 			t.Override(&git.SyncRepo, func(g latest.GitInfo, _ config.SkaffoldOptions) (string, error) { return g.Repo, nil })
-			cfgs, err := getAllConfigs(config.SkaffoldOptions{
+			t.Override(&remoteconfig.SyncOCIArtifact, func(_ context.Context, a latest.OCIArtifactInfo, _ config.SkaffoldOptions) (string, error) {
+				return a.Image, nil
+			})
+			t.Override(&remoteconfig.SyncArchive, func(_ context.Context, a latest.ArchiveInfo, _ config.SkaffoldOptions) (string, error) {
+				return a.URL, nil
+			})
+			cfgs, err := getAllConfigs(context.Background(), config.SkaffoldOptions{
 				Command:             "dev",
 				ConfigurationFile:   test.documents[0].path,
 				ConfigurationFilter: test.configFilter,
@@ -443,3 +477,81 @@ requires:
 		})
 	}
 }
+
+func TestResolveAllConfigsWithLock(t *testing.T) {
+	requires := `
+requires:
+  - git:
+      repo: doc1
+      ref: main
+    activeProfiles:
+    - name: pf0
+  - oci:
+      image: gcr.io/foo/doc2:v1
+`
+	tests := []struct {
+		description    string
+		lock           *remoteconfig.Lock
+		shouldErr      bool
+		expectedCommit string
+		expectedImage  string
+		expectedLock   *remoteconfig.Lock
+	}{
+		{
+			description:    "no lock file resolves versions",
+			expectedCommit: "",
+			expectedImage:  "gcr.io/foo/doc2:v1",
+			expectedLock: &remoteconfig.Lock{Dependencies: []remoteconfig.LockedDependency{
+				{Type: "git", Source: "doc1", Ref: "main", Resolved: "abc123", ActiveProfiles: []string{"pf0"}},
+				{Type: "oci", Source: "gcr.io/foo/doc2:v1", Resolved: "sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"},
+			}},
+		},
+		{
+			description: "lock file pins versions",
+			lock: &remoteconfig.Lock{Dependencies: []remoteconfig.LockedDependency{
+				{Type: "git", Source: "doc1", Ref: "main", Resolved: "def456", ActiveProfiles: []string{"pf0"}},
+				{Type: "oci", Source: "gcr.io/foo/doc2:v1", Resolved: "sha256:60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752"},
+			}},
+			expectedCommit: "def456",
+			expectedImage:  "gcr.io/foo/doc2@sha256:60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752",
+		},
+		{
+			description: "dependency missing from lock file",
+			lock: &remoteconfig.Lock{Dependencies: []remoteconfig.LockedDependency{
+				{Type: "git", Source: "doc1", Ref: "main", Resolved: "def456"},
+			}},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir()
+			tmpDir.Write("skaffold.yaml", fmt.Sprintf(template, latest.Version, "cfg00", requires, "00", "00", "00")).
+				Write("doc1/skaffold.yaml", fmt.Sprintf(template, latest.Version, "cfg10", "", "10", "10", "10")).
+				Write("doc2/skaffold.yaml", fmt.Sprintf(template, latest.Version, "cfg20", "", "20", "20", "20")).
+				Chdir()
+
+			var syncedCommit, syncedImage string
+			t.Override(&git.SyncRepo, func(g latest.GitInfo, _ config.SkaffoldOptions) (string, error) {
+				syncedCommit = g.Commit
+				return g.Repo, nil
+			})
+			t.Override(&git.HeadCommit, func(string) (string, error) { return "abc123", nil })
+			t.Override(&remoteconfig.SyncOCIArtifact, func(_ context.Context, a latest.OCIArtifactInfo, _ config.SkaffoldOptions) (string, error) {
+				syncedImage = a.Image
+				return "doc2", nil
+			})
+			t.Override(&remoteconfig.ResolvedDigest, func(string) (string, error) {
+				return "sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", nil
+			})
+
+			_, lock, err := resolveAllConfigs(context.Background(), config.SkaffoldOptions{Command: "dev", ConfigurationFile: "skaffold.yaml"}, test.lock, test.lock == nil)
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expectedCommit, syncedCommit)
+				t.CheckDeepEqual(test.expectedImage, syncedImage)
+				t.CheckDeepEqual(test.expectedLock, lock)
+			}
+		})
+	}
+}
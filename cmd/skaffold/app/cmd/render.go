@@ -18,6 +18,7 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -48,6 +49,9 @@ func NewCmdRender() *cobra.Command {
 			{Value: &renderFromBuildOutputFile, Name: "build-artifacts", Shorthand: "a", Usage: "File containing build result from a previous 'skaffold build --file-output'"},
 			{Value: &offline, Name: "offline", DefValue: false, Usage: `Do not connect to Kubernetes API server for manifest creation and validation. This is helpful when no Kubernetes cluster is available (e.g. GitOps model). No metadata.namespace attribute is injected in this case - the manifest content does not get changed.`, IsEnum: true},
 			{Value: &renderOutputPath, Name: "output", DefValue: "", Usage: "file to write rendered manifests to"},
+			{Value: &opts.RenderOutputDir, Name: "output-dir", DefValue: "", Usage: "Directory to write rendered manifests to, one file per resource grouped by module, namespace and kind, along with an index of the files written"},
+			{Value: &opts.RenderKustomization, Name: "kustomization", DefValue: false, Usage: "With --output-dir, leave built images untagged in the resources and write a kustomization.yaml overriding them instead", IsEnum: true},
+			{Value: &opts.RenderHermetic, Name: "hermetic", DefValue: false, Usage: "Render without any network access, from the images given with --build-artifacts. Implies --offline, sorts the resources and fails if a deployer would need to download manifests or charts", IsEnum: true},
 			{Value: &opts.DigestSource, Name: "digest-source", DefValue: "local", Usage: "Set to 'local' to build images locally and use digests from built images; Set to 'remote' to resolve the digest of images by tag from the remote registry; Set to 'none' to use tags directly from the Kubernetes manifests", IsEnum: true},
 		}).
 		WithHouseKeepingMessages().
@@ -55,6 +59,16 @@ func NewCmdRender() *cobra.Command {
 }
 
 func doRender(ctx context.Context, out io.Writer) error {
+	if renderOutputPath != "" && opts.RenderOutputDir != "" {
+		return errors.New("--output and --output-dir are mutually exclusive")
+	}
+	if opts.RenderKustomization && opts.RenderOutputDir == "" {
+		return errors.New("--kustomization requires --output-dir")
+	}
+	if opts.RenderHermetic && renderFromBuildOutputFile.String() == "" {
+		return errors.New("--hermetic requires --build-artifacts")
+	}
+
 	buildOut := ioutil.Discard
 	if showBuild {
 		buildOut = out
@@ -45,7 +45,7 @@ import (
 var createRunner = createNewRunner
 
 func withRunner(ctx context.Context, out io.Writer, action func(runner.Runner, []*latest.SkaffoldConfig) error) error {
-	runner, config, err := createRunner(out, opts)
+	runner, config, err := createRunner(ctx, out, opts)
 	sErrors.SetSkaffoldOptions(opts)
 	if err != nil {
 		return err
@@ -57,8 +57,8 @@ func withRunner(ctx context.Context, out io.Writer, action func(runner.Runner, [
 }
 
 // createNewRunner creates a Runner and returns the SkaffoldConfig associated with it.
-func createNewRunner(out io.Writer, opts config.SkaffoldOptions) (runner.Runner, []*latest.SkaffoldConfig, error) {
-	runCtx, configs, err := runContext(out, opts)
+func createNewRunner(ctx context.Context, out io.Writer, opts config.SkaffoldOptions) (runner.Runner, []*latest.SkaffoldConfig, error) {
+	runCtx, configs, err := runContext(ctx, out, opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -73,25 +73,21 @@ func createNewRunner(out io.Writer, opts config.SkaffoldOptions) (runner.Runner,
 	return runner, configs, nil
 }
 
-func runContext(out io.Writer, opts config.SkaffoldOptions) (*runcontext.RunContext, []*latest.SkaffoldConfig, error) {
-	configs, err := withFallbackConfig(out, opts, getAllConfigs)
+func runContext(ctx context.Context, out io.Writer, opts config.SkaffoldOptions) (*runcontext.RunContext, []*latest.SkaffoldConfig, error) {
+	configs, err := withFallbackConfig(ctx, out, opts, getAllConfigs)
 	if err != nil {
 		return nil, nil, err
 	}
 	setDefaultDeployer(configs)
-	var pipelines []latest.Pipeline
-	for _, cfg := range configs {
-		pipelines = append(pipelines, cfg.Pipeline)
-	}
 
-	// TODO: Should support per-config kubecontext. Right now we constrain all configs to define the same kubecontext.
+	// The first config's kubecontext is the active one. Configs that target other kubecontexts get their own clients.
 	kubectx.ConfigureKubeConfig(opts.KubeConfig, opts.KubeContext, configs[0].Deploy.KubeContext)
 
 	if err := validation.Process(configs); err != nil {
 		return nil, nil, fmt.Errorf("invalid skaffold config: %w", err)
 	}
 
-	runCtx, err := runcontext.GetRunContext(opts, pipelines)
+	runCtx, err := runcontext.GetRunContext(opts, configs)
 	if err != nil {
 		return nil, nil, fmt.Errorf("getting run context: %w", err)
 	}
@@ -104,15 +100,15 @@ func runContext(out io.Writer, opts config.SkaffoldOptions) (*runcontext.RunCont
 }
 
 // withFallbackConfig will try to automatically generate a config if root `skaffold.yaml` file does not exist.
-func withFallbackConfig(out io.Writer, opts config.SkaffoldOptions, getCfgs func(opts config.SkaffoldOptions) ([]*latest.SkaffoldConfig, error)) ([]*latest.SkaffoldConfig, error) {
-	configs, err := getCfgs(opts)
+func withFallbackConfig(ctx context.Context, out io.Writer, opts config.SkaffoldOptions, getCfgs func(context.Context, config.SkaffoldOptions) ([]*latest.SkaffoldConfig, error)) ([]*latest.SkaffoldConfig, error) {
+	configs, err := getCfgs(ctx, opts)
 	if err == nil {
 		return configs, nil
 	}
 	if os.IsNotExist(errors.Unwrap(err)) {
 		if opts.AutoCreateConfig && initializer.ValidCmd(opts) {
 			color.Default.Fprintf(out, "Skaffold config file %s not found - Trying to create one for you...\n", opts.ConfigurationFile)
-			config, err := initializer.Transparent(context.Background(), out, initConfig.Config{Opts: opts})
+			config, err := initializer.Transparent(ctx, out, initConfig.Config{Opts: opts})
 			if err != nil {
 				return nil, fmt.Errorf("unable to generate skaffold config file automatically - try running `skaffold init`: %w", err)
 			}
@@ -0,0 +1,37 @@
+#!/usr/bin/env bash
+
+# Copyright 2021 The Skaffold Authors
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+set -euo pipefail
+
+DIR="$( cd "$( dirname "${BASH_SOURCE[0]}" )" && pwd )"
+BIN=${DIR}/bin
+STATIK=${BIN}/statik-dashboard
+
+cd ${DIR}/..
+
+# asset namespaces require a more recent statik than the one in hack/tools
+if ! [[ -x ${STATIK} ]]; then
+    echo 'Installing statik tool'
+    GO111MODULE=on go build -o ${STATIK} github.com/rakyll/statik
+fi
+
+TMP_DIR=$(mktemp -d ${TMPDIR:-/tmp}/generate-dashboard.XXXXXX)
+trap "rm -rf $TMP_DIR" EXIT
+
+cp pkg/skaffold/server/dashboard/*.html pkg/skaffold/server/dashboard/*.js pkg/skaffold/server/dashboard/*.css "${TMP_DIR}"
+
+${STATIK} -f -m -src=${TMP_DIR} -ns dashboard -c "Package statik contains the assets of the web dashboard." -dest pkg/skaffold/server/dashboard
+gofmt -w pkg/skaffold/server/dashboard/statik/statik.go
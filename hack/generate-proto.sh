@@ -21,5 +21,8 @@ docker run --rm gen-proto cat skaffold.pb.go > proto/v1/skaffold.pb.go
 docker run --rm gen-proto cat skaffold.pb.gw.go > proto/v1/skaffold.pb.gw.go
 docker run --rm gen-proto cat index.md > docs/content/en/docs/references/api/grpc.md
 docker run --rm gen-proto cat skaffold.swagger.json > docs/content/en/api/skaffold.swagger.json
+docker run --rm -w /proto/v2 gen-proto cat skaffold.pb.go > proto/v2/skaffold.pb.go
+docker run --rm -w /proto/v2 gen-proto cat skaffold.pb.gw.go > proto/v2/skaffold.pb.gw.go
+docker run --rm -w /proto/v2 gen-proto cat index.md > docs/content/en/docs/references/api/grpc-v2.md
 
 printf "\nFinished generating proto files, please commit the results.\n"
@@ -90,7 +90,7 @@ spec:
 			}}),
 		}, nil, &latest.KubectlDeploy{
 			Manifests: []string{"deployment.yaml"},
-		})
+		}, nil, nil)
 		t.RequireNoError(err)
 		var b bytes.Buffer
 		err = deployer.Render(context.Background(), &b, test.builds, false, test.renderPath)
@@ -250,7 +250,7 @@ spec:
 				},
 			}, nil, &latest.KubectlDeploy{
 				Manifests: []string{"deployment.yaml"},
-			})
+			}, nil, nil)
 			t.RequireNoError(err)
 			var b bytes.Buffer
 			err = deployer.Render(context.Background(), &b, test.builds, false, "")
@@ -436,7 +436,7 @@ spec:
 				}}),
 			}, nil, &latest.HelmDeploy{
 				Releases: test.helmReleases,
-			})
+			}, nil, nil)
 			t.RequireNoError(err)
 			var b bytes.Buffer
 			err = deployer.Render(context.Background(), &b, test.builds, true, "")
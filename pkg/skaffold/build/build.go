@@ -58,6 +58,9 @@ func (g ArtifactGraph) Dependencies(a *latest.Artifact) []*latest.Artifact {
 type Builder interface {
 	Build(ctx context.Context, out io.Writer, tags tag.ImageTags, artifacts []*latest.Artifact) ([]Artifact, error)
 
+	// Retag applies the additional tags of each image to the built artifacts.
+	Retag(ctx context.Context, out io.Writer, artifacts []Artifact, tags tag.AdditionalTags) error
+
 	// Prune removes images built with Skaffold
 	Prune(context.Context, io.Writer) error
 }
@@ -75,6 +78,9 @@ type PipelineBuilder interface {
 	// PostBuild executes any one-time teardown required after all builds on this builder are complete
 	PostBuild(ctx context.Context, out io.Writer) error
 
+	// Retag applies additional tags to an image built by this pipeline. Pushed images are tagged in their registry.
+	Retag(ctx context.Context, out io.Writer, artifact Artifact, tags []string) error
+
 	// Concurrency specifies the max number of builds that can run at any one time. If concurrency is 0, then all builds can run in parallel.
 	Concurrency() int
 
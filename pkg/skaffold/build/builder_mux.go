@@ -97,6 +97,24 @@ func (b *BuilderMux) Build(ctx context.Context, out io.Writer, tags tag.ImageTag
 	return ar, nil
 }
 
+// Retag applies the additional tags to the built artifacts, using the builder of each artifact.
+func (b *BuilderMux) Retag(ctx context.Context, out io.Writer, artifacts []Artifact, tags tag.AdditionalTags) error {
+	for _, a := range artifacts {
+		additional := tags[a.ImageName]
+		if len(additional) == 0 {
+			continue
+		}
+		p, found := b.byImageName[a.ImageName]
+		if !found {
+			return fmt.Errorf("no builder found for artifact %q", a.ImageName)
+		}
+		if err := p.Retag(ctx, out, a, additional); err != nil {
+			return fmt.Errorf("tagging %q: %w", a.Tag, err)
+		}
+	}
+	return nil
+}
+
 // Prune removes built images.
 func (b *BuilderMux) Prune(ctx context.Context, writer io.Writer) error {
 	for _, builder := range b.builders {
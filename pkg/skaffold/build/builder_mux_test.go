@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"io"
+	"io/ioutil"
 	"testing"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -85,6 +86,37 @@ func TestNewBuilderMux(t *testing.T) {
 	}
 }
 
+func TestBuilderMuxRetag(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		cfg := &mockConfig{pipelines: []latest.Pipeline{
+			{Build: latest.BuildConfig{
+				BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}},
+				Artifacts: []*latest.Artifact{{ImageName: "app1"}},
+			}},
+			{Build: latest.BuildConfig{
+				BuildType: latest.BuildType{Cluster: &latest.ClusterDetails{}},
+				Artifacts: []*latest.Artifact{{ImageName: "app2"}, {ImageName: "app3"}},
+			}},
+		}}
+		b, err := NewBuilderMux(cfg, nil, newMockPipelineBuilder)
+		t.CheckNoError(err)
+
+		artifacts := []Artifact{
+			{ImageName: "app1", Tag: "app1:1.4.2"},
+			{ImageName: "app2", Tag: "app2:1.4.2"},
+			{ImageName: "app3", Tag: "app3:1.4.2"},
+		}
+		err = b.Retag(context.Background(), ioutil.Discard, artifacts, map[string][]string{
+			"app1": {"app1:1.4", "app1:1"},
+			"app3": {"app3:1.4"},
+		})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"app1:1.4.2 -> app1:1.4", "app1:1.4.2 -> app1:1"}, b.builders[0].(*mockPipelineBuilder).retagged)
+		t.CheckDeepEqual([]string{"app3:1.4.2 -> app3:1.4"}, b.builders[1].(*mockPipelineBuilder).retagged)
+	})
+}
+
 type mockConfig struct {
 	pipelines []latest.Pipeline
 }
@@ -96,6 +128,7 @@ func (m *mockConfig) GetPipelines() []latest.Pipeline {
 type mockPipelineBuilder struct {
 	concurrency int
 	builderType string
+	retagged    []string
 }
 
 func (m *mockPipelineBuilder) PreBuild(ctx context.Context, out io.Writer) error { return nil }
@@ -106,6 +139,13 @@ func (m *mockPipelineBuilder) Build(ctx context.Context, out io.Writer, artifact
 
 func (m *mockPipelineBuilder) PostBuild(ctx context.Context, out io.Writer) error { return nil }
 
+func (m *mockPipelineBuilder) Retag(_ context.Context, _ io.Writer, artifact Artifact, tags []string) error {
+	for _, t := range tags {
+		m.retagged = append(m.retagged, artifact.Tag+" -> "+t)
+	}
+	return nil
+}
+
 func (m *mockPipelineBuilder) Concurrency() int { return m.concurrency }
 
 func (m *mockPipelineBuilder) Prune(context.Context, io.Writer) error { return nil }
@@ -60,6 +60,11 @@ func (b *Builder) PostBuild(_ context.Context, _ io.Writer) error {
 	return nil
 }
 
+// Retag adds tags to an image pushed by kaniko.
+func (b *Builder) Retag(_ context.Context, out io.Writer, artifact build.Artifact, tags []string) error {
+	return build.RetagRemote(out, artifact, tags, b.cfg)
+}
+
 func (b *Builder) buildArtifact(ctx context.Context, out io.Writer, artifact *latest.Artifact, tag string) (string, error) {
 	// TODO: [#4922] Implement required artifact resolution from the `artifactStore`
 	digest, err := b.runBuildForArtifact(ctx, out, artifact, tag)
@@ -57,6 +57,11 @@ func (b *Builder) PostBuild(_ context.Context, _ io.Writer) error {
 	return nil
 }
 
+// Retag adds tags to an image pushed by Google Cloud Build.
+func (b *Builder) Retag(_ context.Context, out io.Writer, artifact build.Artifact, tags []string) error {
+	return build.RetagRemote(out, artifact, tags, b.cfg)
+}
+
 func (b *Builder) Concurrency() int {
 	return b.GoogleCloudBuild.Concurrency
 }
@@ -18,6 +18,7 @@ package local
 
 import (
 	"context"
+	"fmt"
 	"io"
 
 	"github.com/sirupsen/logrus"
@@ -57,6 +58,21 @@ func (b *Builder) PostBuild(ctx context.Context, _ io.Writer) error {
 	return nil
 }
 
+// Retag applies additional tags to a built image, either in the registry it was pushed to or in the local docker daemon.
+func (b *Builder) Retag(ctx context.Context, out io.Writer, artifact build.Artifact, tags []string) error {
+	if b.pushImages {
+		return build.RetagRemote(out, artifact, tags, b.cfg)
+	}
+
+	for _, t := range tags {
+		fmt.Fprintf(out, "Tagging %s as %s\n", artifact.ImageName, t)
+		if err := b.localDocker.Tag(ctx, artifact.Tag, t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (b *Builder) Concurrency() int {
 	if b.local.Concurrency == nil {
 		return 0
@@ -681,6 +681,26 @@ func (g *gitRepo) tag(tag string) *gitRepo {
 	return g
 }
 
+func (g *gitRepo) annotatedTag(tag string) *gitRepo {
+	head, err := g.repo.Head()
+	failNowIfError(g.t, err)
+
+	now, err := time.Parse("Jan 2, 2006 at 15:04:05 -0700 MST", "Feb 3, 2013 at 19:54:00 -0700 MST")
+	failNowIfError(g.t, err)
+
+	_, err = g.repo.CreateTag(tag, head.Hash(), &git.CreateTagOptions{
+		Tagger: &object.Signature{
+			Name:  "John Doe",
+			Email: "john@doe.org",
+			When:  now,
+		},
+		Message: tag,
+	})
+	failNowIfError(g.t, err)
+
+	return g
+}
+
 func failNowIfError(t *testing.T, err error) {
 	if err != nil {
 		t.Fatal(err)
@@ -0,0 +1,94 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tag
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// description is the position of a commit relative to the nearest annotated git tag.
+type description struct {
+	tag    string
+	ahead  int
+	commit string
+	dirty  bool
+}
+
+var longDescription = regexp.MustCompile(`^(.+)-(\d+)-g([0-9a-f]+)$`)
+
+// describe runs `git describe` on the working directory. Only the annotated tags matching `match`,
+// if it's not empty, are considered. Uncommitted changes in the working directory make it dirty.
+func describe(workingDir, match string, ignoreChanges bool) (description, error) {
+	args := []string{"describe", "--long", "--abbrev=7"}
+	if match != "" {
+		args = append(args, "--match", match)
+	}
+	out, err := runGit(workingDir, args...)
+	if err != nil {
+		return description{}, fmt.Errorf("unable to find an annotated git tag: %w", err)
+	}
+
+	parts := longDescription.FindStringSubmatch(out)
+	if parts == nil {
+		return description{}, fmt.Errorf("unexpected git description %q", out)
+	}
+	ahead, _ := strconv.Atoi(parts[2])
+
+	d := description{tag: parts[1], ahead: ahead, commit: parts[3]}
+	if !ignoreChanges {
+		changes, err := runGit(workingDir, "status", ".", "--porcelain")
+		if err != nil {
+			return description{}, fmt.Errorf("getting git status: %w", err)
+		}
+		d.dirty = len(changes) > 0
+	}
+	return d, nil
+}
+
+// GitDescribe tags an image with the nearest annotated git tag, followed by the number of commits
+// since that tag and the abbreviated commit sha if the commit isn't tagged.
+type GitDescribe struct {
+	match         string
+	ignoreChanges bool
+}
+
+// NewGitDescribe creates a new git describe tagger.
+func NewGitDescribe(match string, ignoreChanges bool) *GitDescribe {
+	return &GitDescribe{
+		match:         match,
+		ignoreChanges: ignoreChanges,
+	}
+}
+
+// GenerateTag generates a tag like `v1.4.2`, `v1.4.2-3-g1a2b3c4` or `v1.4.2-3-g1a2b3c4-dirty`.
+func (t *GitDescribe) GenerateTag(workingDir, _ string) (string, error) {
+	d, err := describe(workingDir, t.match, t.ignoreChanges)
+	if err != nil {
+		return "", err
+	}
+
+	tag := d.tag
+	if d.ahead > 0 {
+		tag = fmt.Sprintf("%s-%d-g%s", tag, d.ahead, d.commit)
+	}
+	if d.dirty {
+		tag += "-dirty"
+	}
+	return sanitizeTag(tag), nil
+}
@@ -0,0 +1,124 @@
+// +build !windows
+
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tag
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestGitDescribe_GenerateTag(t *testing.T) {
+	tests := []struct {
+		description   string
+		createGitRepo func(string)
+		match         string
+		ignoreChanges bool
+		expected      string
+		shouldErr     bool
+	}{
+		{
+			description: "tagged commit",
+			createGitRepo: func(dir string) {
+				gitInit(t, dir).
+					write("source.go", "code").
+					add("source.go").
+					commit("initial").
+					annotatedTag("v1.4.2")
+			},
+			expected: "v1.4.2",
+		},
+		{
+			description: "commits after a tag",
+			createGitRepo: func(dir string) {
+				gitInit(t, dir).
+					write("source.go", "code").
+					add("source.go").
+					commit("initial").
+					annotatedTag("v1.4.2").
+					write("other.go", "other").
+					add("other.go").
+					commit("second commit")
+			},
+			expected: "v1.4.2-1-gaea33bc",
+		},
+		{
+			description: "uncommitted changes",
+			createGitRepo: func(dir string) {
+				gitInit(t, dir).
+					write("source.go", "code").
+					add("source.go").
+					commit("initial").
+					annotatedTag("v1.4.2").
+					write("source.go", "updated code")
+			},
+			expected: "v1.4.2-dirty",
+		},
+		{
+			description: "ignore uncommitted changes",
+			createGitRepo: func(dir string) {
+				gitInit(t, dir).
+					write("source.go", "code").
+					add("source.go").
+					commit("initial").
+					annotatedTag("v1.4.2").
+					write("source.go", "updated code")
+			},
+			ignoreChanges: true,
+			expected:      "v1.4.2",
+		},
+		{
+			description: "only tags matching the pattern",
+			createGitRepo: func(dir string) {
+				gitInit(t, dir).
+					write("source.go", "code").
+					add("source.go").
+					commit("initial").
+					annotatedTag("app/v1.0.0").
+					write("other.go", "other").
+					add("other.go").
+					commit("second commit").
+					annotatedTag("other/v2.0.0")
+			},
+			match:    "app/*",
+			expected: "app_v1.0.0-1-gaea33bc",
+		},
+		{
+			description: "lightweight tags are ignored",
+			createGitRepo: func(dir string) {
+				gitInit(t, dir).
+					write("source.go", "code").
+					add("source.go").
+					commit("initial").
+					tag("v1.4.2")
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir()
+			test.createGitRepo(tmpDir.Root())
+
+			tag, err := NewGitDescribe(test.match, test.ignoreChanges).GenerateTag(tmpDir.Root(), "image")
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, tag)
+		})
+	}
+}
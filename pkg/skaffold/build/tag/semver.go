@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tag
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver"
+)
+
+const defaultPreRelease = "dev"
+
+// SemVer tags an image with the semantic version of the nearest annotated git tag.
+// Builds of commits after that tag, or with uncommitted changes, are tagged with
+// a pre-release of the next patch version.
+type SemVer struct {
+	match         string
+	preRelease    semver.PRVersion
+	aliases       bool
+	ignoreChanges bool
+}
+
+// NewSemVer creates a new semantic version tagger. It fails if the pre-release identifier is invalid.
+func NewSemVer(match, preRelease string, aliases, ignoreChanges bool) (*SemVer, error) {
+	if preRelease == "" {
+		preRelease = defaultPreRelease
+	}
+	pr, err := semver.NewPRVersion(preRelease)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pre-release identifier %q: %w", preRelease, err)
+	}
+
+	return &SemVer{
+		match:         match,
+		preRelease:    pr,
+		aliases:       aliases,
+		ignoreChanges: ignoreChanges,
+	}, nil
+}
+
+// GenerateTag generates a tag like `1.4.2` for a tagged commit, or `1.4.3-dev.3_g1a2b3c4` for the third commit after it.
+// Uncommitted changes add `.dirty` to the build metadata. Build metadata is separated by `_` since `+` isn't valid in an image tag.
+func (t *SemVer) GenerateTag(workingDir, _ string) (string, error) {
+	v, err := t.version(workingDir)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Replace(v.String(), "+", "_", 1), nil
+}
+
+// GenerateAdditionalTags generates the major and minor version aliases of a release, like `1.4` and `1` for `1.4.2`,
+// if aliases are enabled.
+func (t *SemVer) GenerateAdditionalTags(workingDir, _ string) ([]string, error) {
+	if !t.aliases {
+		return nil, nil
+	}
+
+	v, err := t.version(workingDir)
+	if err != nil {
+		return nil, err
+	}
+	if len(v.Pre) > 0 || len(v.Build) > 0 {
+		return nil, nil
+	}
+
+	return []string{fmt.Sprintf("%d.%d", v.Major, v.Minor), fmt.Sprintf("%d", v.Major)}, nil
+}
+
+func (t *SemVer) version(workingDir string) (semver.Version, error) {
+	d, err := describe(workingDir, t.match, t.ignoreChanges)
+	if err != nil {
+		return semver.Version{}, err
+	}
+
+	v, err := semver.ParseTolerant(d.tag)
+	if err != nil {
+		return semver.Version{}, fmt.Errorf("git tag %q isn't a semantic version: %w", d.tag, err)
+	}
+	if d.ahead == 0 && !d.dirty {
+		return v, nil
+	}
+
+	if len(v.Pre) == 0 {
+		v.Patch++
+	}
+	v.Pre = append(v.Pre, t.preRelease, semver.PRVersion{VersionNum: uint64(d.ahead), IsNum: true})
+	v.Build = append(v.Build, "g"+d.commit)
+	if d.dirty {
+		v.Build = append(v.Build, "dirty")
+	}
+	return v, nil
+}
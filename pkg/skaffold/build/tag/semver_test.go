@@ -0,0 +1,137 @@
+// +build !windows
+
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tag
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestSemVer_GenerateTag(t *testing.T) {
+	tests := []struct {
+		description        string
+		createGitRepo      func(string)
+		preRelease         string
+		aliases            bool
+		expected           string
+		expectedAdditional []string
+		shouldErr          bool
+	}{
+		{
+			description: "release",
+			createGitRepo: func(dir string) {
+				gitInit(t, dir).
+					write("source.go", "code").
+					add("source.go").
+					commit("initial").
+					annotatedTag("v1.4.2")
+			},
+			aliases:            true,
+			expected:           "1.4.2",
+			expectedAdditional: []string{"1.4", "1"},
+		},
+		{
+			description: "release without aliases",
+			createGitRepo: func(dir string) {
+				gitInit(t, dir).
+					write("source.go", "code").
+					add("source.go").
+					commit("initial").
+					annotatedTag("v1.4.2")
+			},
+			expected: "1.4.2",
+		},
+		{
+			description: "commits after a release",
+			createGitRepo: func(dir string) {
+				gitInit(t, dir).
+					write("source.go", "code").
+					add("source.go").
+					commit("initial").
+					annotatedTag("v1.4.2").
+					write("other.go", "other").
+					add("other.go").
+					commit("second commit")
+			},
+			aliases:  true,
+			expected: "1.4.3-dev.1_gaea33bc",
+		},
+		{
+			description: "uncommitted changes with custom pre-release",
+			createGitRepo: func(dir string) {
+				gitInit(t, dir).
+					write("source.go", "code").
+					add("source.go").
+					commit("initial").
+					annotatedTag("v1.4.2").
+					write("source.go", "updated code")
+			},
+			preRelease: "snapshot",
+			expected:   "1.4.3-snapshot.0_geefe1b9.dirty",
+		},
+		{
+			description: "commits after a pre-release",
+			createGitRepo: func(dir string) {
+				gitInit(t, dir).
+					write("source.go", "code").
+					add("source.go").
+					commit("initial").
+					annotatedTag("v2.0.0-rc.1").
+					write("other.go", "other").
+					add("other.go").
+					commit("second commit")
+			},
+			expected: "2.0.0-rc.1.dev.1_gaea33bc",
+		},
+		{
+			description: "tag isn't a semantic version",
+			createGitRepo: func(dir string) {
+				gitInit(t, dir).
+					write("source.go", "code").
+					add("source.go").
+					commit("initial").
+					annotatedTag("latest")
+			},
+			aliases:   true,
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir()
+			test.createGitRepo(tmpDir.Root())
+
+			tagger, err := NewSemVer("", test.preRelease, test.aliases, false)
+			t.CheckNoError(err)
+
+			tag, err := tagger.GenerateTag(tmpDir.Root(), "image")
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, tag)
+
+			additional, err := tagger.GenerateAdditionalTags(tmpDir.Root(), "image")
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expectedAdditional, additional)
+		})
+	}
+}
+
+func TestNewSemVer_InvalidPreRelease(t *testing.T) {
+	_, err := NewSemVer("", "01", false, false)
+
+	testutil.CheckError(t, true, err)
+}
@@ -20,18 +20,31 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/warnings"
 )
 
 // ImageTags maps image names to tags
 type ImageTags map[string]string
 
+// AdditionalTags maps image names to the fully qualified image names that are applied
+// to an image after it's built, next to the tag in ImageTags.
+type AdditionalTags map[string][]string
+
 // Tagger is an interface for tag strategies to be implemented against
 type Tagger interface {
 	// GenerateTag generates a tag for an artifact.
 	GenerateTag(workingDir, imageName string) (string, error)
 }
 
+// MultiTagger is implemented by the taggers that can generate more than one tag for an artifact.
+type MultiTagger interface {
+	Tagger
+
+	// GenerateAdditionalTags generates the tags applied to an artifact next to the one returned by GenerateTag.
+	GenerateAdditionalTags(workingDir, imageName string) ([]string, error)
+}
+
 const DeprecatedImageName = "_DEPRECATED_IMAGE_NAME_"
 
 // GenerateFullyQualifiedImageName resolves the fully qualified image name for an artifact.
@@ -67,3 +80,41 @@ func GenerateFullyQualifiedImageName(t Tagger, workingDir, imageName string) (st
 
 	return fmt.Sprintf("%s:%s", imageName, tag), nil
 }
+
+// GenerateAdditionalImageNames resolves the fully qualified image names of the additional tags of an artifact.
+// There are none if the tagger doesn't implement MultiTagger.
+func GenerateAdditionalImageNames(t Tagger, workingDir, imageName string) ([]string, error) {
+	mt, ok := t.(MultiTagger)
+	if !ok {
+		return nil, nil
+	}
+
+	tags, err := mt.GenerateAdditionalTags(workingDir, imageName)
+	if err != nil {
+		return nil, fmt.Errorf("generating additional tags: %w", err)
+	}
+
+	var names []string
+	for _, tag := range tags {
+		names = append(names, fmt.Sprintf("%s:%s", imageName, tag))
+	}
+	return names, nil
+}
+
+// ExpandAdditionalTags resolves the fully qualified image names of the additional tags configured for an artifact.
+// Each tag is a template that can reference environment variables and `{{.IMAGE_NAME}}`.
+func ExpandAdditionalTags(imageName string, templates []string) ([]string, error) {
+	var names []string
+	for _, t := range templates {
+		tag, err := util.ExpandEnvTemplateOrFail(t, map[string]string{"IMAGE_NAME": imageName})
+		if err != nil {
+			return nil, fmt.Errorf("expanding additional tag %q: %w", t, err)
+		}
+		if tag == "" {
+			return nil, fmt.Errorf("additional tag %q of %q is empty", t, imageName)
+		}
+
+		names = append(names, fmt.Sprintf("%s:%s", imageName, sanitizeTag(tag)))
+	}
+	return names, nil
+}
@@ -117,3 +117,39 @@ func TestTagger_GenerateFullyQualifiedImageName(t *testing.T) {
 		})
 	}
 }
+
+func TestExpandAdditionalTags(t *testing.T) {
+	tests := []struct {
+		description string
+		templates   []string
+		expected    []string
+		shouldErr   bool
+	}{
+		{
+			description: "no additional tags",
+		},
+		{
+			description: "static and templated tags",
+			templates:   []string{"latest", "{{.BRANCH}}", "{{.IMAGE_NAME}}-{{.BRANCH}}"},
+			expected:    []string{"test:latest", "test:feature_x", "test:test-feature_x"},
+		},
+		{
+			description: "undefined variable",
+			templates:   []string{"{{.UNKNOWN}}"},
+			shouldErr:   true,
+		},
+		{
+			description: "empty tag",
+			templates:   []string{"{{.EMPTY}}"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.OSEnviron, func() []string { return []string{"BRANCH=feature/x", "EMPTY="} })
+
+			names, err := ExpandAdditionalTags("test", test.templates)
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, names)
+		})
+	}
+}
@@ -36,6 +36,19 @@ func (t *TaggerMux) GenerateTag(workingDir, imageName string) (string, error) {
 	return tagger.GenerateTag(workingDir, imageName)
 }
 
+// GenerateAdditionalTags generates the additional tags of an artifact, if its tagger is a MultiTagger.
+func (t *TaggerMux) GenerateAdditionalTags(workingDir, imageName string) ([]string, error) {
+	tagger, found := t.byImageName[imageName]
+	if !found {
+		return nil, fmt.Errorf("no valid tagger found for artifact: %q", imageName)
+	}
+	mt, ok := tagger.(MultiTagger)
+	if !ok {
+		return nil, nil
+	}
+	return mt.GenerateAdditionalTags(workingDir, imageName)
+}
+
 func NewTaggerMux(runCtx *runcontext.RunContext) (Tagger, error) {
 	pipelines := runCtx.GetPipelines()
 	m := make(map[string]Tagger)
@@ -72,6 +85,12 @@ func getTagger(runCtx *runcontext.RunContext, t *latest.TagPolicy) (Tagger, erro
 	case t.DateTimeTagger != nil:
 		return NewDateTimeTagger(t.DateTimeTagger.Format, t.DateTimeTagger.TimeZone), nil
 
+	case t.GitDescribeTagger != nil:
+		return NewGitDescribe(t.GitDescribeTagger.Match, t.GitDescribeTagger.IgnoreChanges), nil
+
+	case t.SemVerTagger != nil:
+		return NewSemVer(t.SemVerTagger.Match, t.SemVerTagger.PreRelease, t.SemVerTagger.Aliases, t.SemVerTagger.IgnoreChanges)
+
 	case t.CustomTemplateTagger != nil:
 		components, err := CreateComponents(t.CustomTemplateTagger)
 
@@ -110,6 +129,16 @@ func CreateComponents(t *latest.CustomTemplateTagger) (map[string]Tagger, error)
 		case c.DateTimeTagger != nil:
 			components[name] = NewDateTimeTagger(c.DateTimeTagger.Format, c.DateTimeTagger.TimeZone)
 
+		case c.GitDescribeTagger != nil:
+			components[name] = NewGitDescribe(c.GitDescribeTagger.Match, c.GitDescribeTagger.IgnoreChanges)
+
+		case c.SemVerTagger != nil:
+			semVer, err := NewSemVer(c.SemVerTagger.Match, c.SemVerTagger.PreRelease, false, c.SemVerTagger.IgnoreChanges)
+			if err != nil {
+				return nil, err
+			}
+			components[name] = semVer
+
 		case c.CustomTemplateTagger != nil:
 			return nil, fmt.Errorf("nested customTemplate components are not supported in skaffold (%s)", name)
 
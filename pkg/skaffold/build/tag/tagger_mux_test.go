@@ -26,6 +26,7 @@ import (
 func TestCreateComponents(t *testing.T) {
 	gitExample, _ := NewGitCommit("", "", false)
 	envExample, _ := NewEnvTemplateTagger("test")
+	semVerExample, _ := NewSemVer("", "", false, false)
 
 	tests := []struct {
 		description          string
@@ -41,6 +42,8 @@ func TestCreateComponents(t *testing.T) {
 					{Name: "FOE", Component: latest.TagPolicy{ShaTagger: &latest.ShaTagger{}}},
 					{Name: "BAR", Component: latest.TagPolicy{EnvTemplateTagger: &latest.EnvTemplateTagger{Template: "test"}}},
 					{Name: "BAT", Component: latest.TagPolicy{DateTimeTagger: &latest.DateTimeTagger{}}},
+					{Name: "BAZ", Component: latest.TagPolicy{GitDescribeTagger: &latest.GitDescribeTagger{}}},
+					{Name: "QUX", Component: latest.TagPolicy{SemVerTagger: &latest.SemVerTagger{}}},
 				},
 			},
 			expected: map[string]Tagger{
@@ -48,6 +51,8 @@ func TestCreateComponents(t *testing.T) {
 				"FOE": &ChecksumTagger{},
 				"BAR": envExample,
 				"BAT": NewDateTimeTagger("", ""),
+				"BAZ": NewGitDescribe("", false),
+				"QUX": semVerExample,
 			},
 		},
 		{
@@ -59,6 +64,15 @@ func TestCreateComponents(t *testing.T) {
 			},
 			shouldErr: true,
 		},
+		{
+			description: "invalid semver pre-release",
+			customTemplateTagger: &latest.CustomTemplateTagger{
+				Components: []latest.TaggerComponent{
+					{Name: "FOO", Component: latest.TagPolicy{SemVerTagger: &latest.SemVerTagger{PreRelease: "01"}}},
+				},
+			},
+			shouldErr: true,
+		},
 		{
 			description: "recurring names",
 			customTemplateTagger: &latest.CustomTemplateTagger{
@@ -89,3 +103,49 @@ func TestCreateComponents(t *testing.T) {
 		})
 	}
 }
+
+type fakeMultiTagger struct {
+	tags []string
+}
+
+func (f *fakeMultiTagger) GenerateTag(string, string) (string, error) { return "latest", nil }
+
+func (f *fakeMultiTagger) GenerateAdditionalTags(string, string) ([]string, error) {
+	return f.tags, nil
+}
+
+func TestTaggerMux_GenerateAdditionalTags(t *testing.T) {
+	mux := &TaggerMux{byImageName: map[string]Tagger{
+		"multi":  &fakeMultiTagger{tags: []string{"1.4", "1"}},
+		"single": &ChecksumTagger{},
+	}}
+
+	tests := []struct {
+		description string
+		imageName   string
+		expected    []string
+		shouldErr   bool
+	}{
+		{
+			description: "multi tagger",
+			imageName:   "multi",
+			expected:    []string{"multi:1.4", "multi:1"},
+		},
+		{
+			description: "single tagger",
+			imageName:   "single",
+		},
+		{
+			description: "unknown image",
+			imageName:   "unknown",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			names, err := GenerateAdditionalImageNames(mux, ".", test.imageName)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, names)
+		})
+	}
+}
@@ -18,6 +18,8 @@ package build
 
 import (
 	"context"
+	"fmt"
+	"io"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 )
@@ -59,3 +61,14 @@ func TagWithDigest(tag, digest string) string {
 func TagWithImageID(ctx context.Context, tag string, imageID string, localDocker docker.LocalDaemon) (string, error) {
 	return localDocker.TagWithImageID(ctx, tag, imageID)
 }
+
+// RetagRemote adds tags to an image that was pushed to a registry, without pulling it.
+func RetagRemote(out io.Writer, artifact Artifact, tags []string, cfg docker.Config) error {
+	for _, t := range tags {
+		fmt.Fprintf(out, "Tagging %s as %s\n", artifact.ImageName, t)
+		if err := docker.AddRemoteTag(artifact.Tag, t, cfg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
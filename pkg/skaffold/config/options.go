@@ -37,6 +37,17 @@ type WaitForDeletions struct {
 	Enabled bool
 }
 
+// LogsOptions are options set by the command line to filter the logs of deployed containers.
+// They take precedence over the `deploy.logs` configuration.
+type LogsOptions struct {
+	Images     []string
+	Containers []string
+	Include    []string
+	Exclude    []string
+	Level      string
+	Dir        string
+}
+
 // SkaffoldOptions are options that are set by command line arguments not included
 // in the config file itself
 type SkaffoldOptions struct {
@@ -61,6 +72,9 @@ type SkaffoldOptions struct {
 	AutoCreateConfig      bool
 	AssumeYes             bool
 	RenderOutput          string
+	RenderOutputDir       string
+	RenderKustomization   bool
+	RenderHermetic        bool
 	ProfileAutoActivation bool
 	DryRun                bool
 	SkipRender            bool
@@ -80,6 +94,7 @@ type SkaffoldOptions struct {
 	KubeContext        string
 	KubeConfig         string
 	DigestSource       string
+	ImageReference     string
 	WatchPollInterval  int
 	DefaultRepo        StringOrUndefined
 	CustomLabels       []string
@@ -87,6 +102,7 @@ type SkaffoldOptions struct {
 	Profiles           []string
 	InsecureRegistries []string
 	Muted              Muted
+	Logs               LogsOptions
 	Command            string
 	RPCPort            int
 	RPCHTTPPort        int
@@ -97,6 +113,18 @@ type SkaffoldOptions struct {
 	MinikubeProfile  string
 	RepoCacheDir     string
 	WaitForDeletions WaitForDeletions
+
+	// LockFile is the path to the lock file pinning the versions of remote config dependencies.
+	LockFile string
+
+	// RemoteCacheOnly disables fetching remote config dependencies and only uses the repositories already in `RepoCacheDir`.
+	RemoteCacheOnly bool
+
+	// EphemeralNamespace is the name of the ephemeral namespace when it was chosen by a parent Skaffold process.
+	EphemeralNamespace string
+
+	// ResourceTTL is added as an annotation to the deployed resources so that later Skaffold runs garbage collect them.
+	ResourceTTL time.Duration
 }
 
 type RunMode string
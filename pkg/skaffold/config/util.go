@@ -91,6 +91,20 @@ func ResolveConfigFile(configFile string) (string, error) {
 	return configFile, util.VerifyOrCreateFile(configFile)
 }
 
+// GetRepoCacheDir returns the directory where remote config dependencies are cached, defaulting to `~/.skaffold/repos`.
+func GetRepoCacheDir(opts SkaffoldOptions) (string, error) {
+	if opts.RepoCacheDir != "" {
+		return opts.RepoCacheDir, nil
+	}
+
+	// cache location unspecified, use ~/.skaffold/repos
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", fmt.Errorf("retrieving home directory: %w", err)
+	}
+	return filepath.Join(home, constants.DefaultSkaffoldDir, "repos"), nil
+}
+
 // ReadConfigFileNoCache reads the given config yaml file and unmarshals the contents.
 // Only visible for testing, use ReadConfigFile instead.
 func ReadConfigFileNoCache(configFile string) (*GlobalConfig, error) {
@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"encoding/json"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// transformCustomResource configures the pod specs of a custom resource for debugging. The pod specs are found
+// with the image paths configured in the `resourceSelector` section of the skaffold.yaml, held by `rs`.
+// Returns true if changed, false otherwise.
+func transformCustomResource(b []byte, retrieveImageConfiguration configurationRetriever, debugHelpersRegistry string, rs *manifest.ResourceSelector) ([]byte, bool, error) {
+	m := make(map[string]interface{})
+	if err := yaml.Unmarshal(b, &m); err != nil {
+		return nil, false, fmt.Errorf("reading Kubernetes YAML: %w", err)
+	}
+
+	changed := false
+	for _, template := range manifest.CustomResourcePodTemplates(m, rs) {
+		var metadata metav1.ObjectMeta
+		if err := convert(template.Metadata, &metadata); err != nil {
+			return nil, false, err
+		}
+		var podSpec v1.PodSpec
+		if err := convert(template.Spec, &podSpec); err != nil {
+			return nil, false, err
+		}
+
+		if !transformPodSpec(&metadata, &podSpec, retrieveImageConfiguration, debugHelpersRegistry) {
+			continue
+		}
+
+		// Only the annotations of the metadata are changed by the transforms.
+		if len(metadata.Annotations) > 0 {
+			template.Metadata["annotations"] = metadata.Annotations
+		}
+		if err := replaceFields(template.Spec, &podSpec); err != nil {
+			return nil, false, err
+		}
+		changed = true
+	}
+	if !changed {
+		return b, false, nil
+	}
+
+	updated, err := yaml.Marshal(m)
+	if err != nil {
+		return nil, false, fmt.Errorf("marshalling yaml: %w", err)
+	}
+	return updated, true, nil
+}
+
+// convert converts an untyped object into a typed one.
+func convert(from interface{}, to interface{}) error {
+	buf, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(buf, to)
+}
+
+// replaceFields replaces the fields of an untyped object with those of a typed one.
+func replaceFields(o map[string]interface{}, from interface{}) error {
+	updated := map[string]interface{}{}
+	if err := convert(from, &updated); err != nil {
+		return err
+	}
+
+	for k := range o {
+		delete(o, k)
+	}
+	for k, v := range updated {
+		o[k] = v
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestTransformCustomResource(t *testing.T) {
+	defer func(c []containerTransformer) { containerTransforms = c }(containerTransforms)
+	containerTransforms = append(containerTransforms, testTransformer{})
+
+	rs, err := manifest.NewResourceSelector([]latest.ResourceFilter{{
+		GroupKind: "Rollout.argoproj.io",
+		Image:     []string{".spec.template.spec.containers.*.image"},
+	}})
+	testutil.CheckError(t, false, err)
+
+	tests := []struct {
+		description string
+		in          string
+		out         string
+		changed     bool
+	}{
+		{
+			description: "selected custom resource",
+			in: `apiVersion: argoproj.io/v1alpha1
+kind: Rollout
+metadata:
+  name: my-app
+spec:
+  template:
+    spec:
+      containers:
+      - image: gcr.io/k8s-debug/debug-example:latest
+        name: example
+`,
+			out: `apiVersion: argoproj.io/v1alpha1
+kind: Rollout
+metadata:
+  name: my-app
+spec:
+  template:
+    metadata:
+      annotations:
+        debug.cloud.google.com/config: '{"example":{"runtime":"test"}}'
+    spec:
+      containers:
+      - env:
+        - name: KEY
+          value: value
+        image: gcr.io/k8s-debug/debug-example:latest
+        name: example
+        ports:
+        - containerPort: 9999
+          name: test
+        resources: {}
+`,
+			changed: true,
+		},
+		{
+			description: "other custom resource",
+			in: `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: my-app
+spec:
+  template:
+    spec:
+      containers:
+      - image: gcr.io/k8s-debug/debug-example:latest
+        name: example
+`,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			retriever := func(image string) (imageConfiguration, error) {
+				return imageConfiguration{}, nil
+			}
+
+			out, changed, err := transformCustomResource([]byte(test.in), retriever, "HELPERS", rs)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.changed, changed)
+			if changed {
+				t.CheckDeepEqual(test.out, string(out))
+			}
+		})
+	}
+}
@@ -59,15 +59,21 @@ func ApplyDebuggingTransforms(l manifest.ManifestList, builds []build.Artifact,
 		}
 		return imageConfiguration{}, fmt.Errorf("no build artifact for %q", image)
 	}
-	return applyDebuggingTransforms(l, retriever, registries.DebugHelpersRegistry)
+	return applyDebuggingTransforms(l, retriever, registries.DebugHelpersRegistry, registries.ResourceSelector)
 }
 
-func applyDebuggingTransforms(l manifest.ManifestList, retriever configurationRetriever, debugHelpersRegistry string) (manifest.ManifestList, error) {
+func applyDebuggingTransforms(l manifest.ManifestList, retriever configurationRetriever, debugHelpersRegistry string, rs *manifest.ResourceSelector) (manifest.ManifestList, error) {
 	var updated manifest.ManifestList
 	for _, manifest := range l {
 		obj, _, err := decodeFromYaml(manifest, nil, nil)
 		if err != nil {
-			logrus.Debugf("Unable to interpret manifest for debugging: %v\n", err)
+			// Custom resources can only be transformed if their pod specs were configured
+			transformed, changed, crErr := transformCustomResource(manifest, retriever, debugHelpersRegistry, rs)
+			if crErr != nil || !changed {
+				logrus.Debugf("Unable to interpret manifest for debugging: %v\n", err)
+			} else {
+				manifest = transformed
+			}
 		} else if transformManifest(obj, retriever, debugHelpersRegistry) {
 			manifest, err = encodeAsYaml(obj)
 			if err != nil {
@@ -594,7 +594,7 @@ status: {}`,
 
 			l, err := manifest.Load(bytes.NewReader([]byte(test.in)))
 			t.CheckError(false, err)
-			result, err := applyDebuggingTransforms(l, retriever, "HELPERS")
+			result, err := applyDebuggingTransforms(l, retriever, "HELPERS", nil)
 
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.out, result.String())
 		})
@@ -76,7 +76,25 @@ type Deployer struct {
 	kubeContext string
 	kubeConfig  string
 	namespace   string
-	configFile  string
+
+	// configFile, profiles and modules are passed to the `skaffold filter` post-renderer so that it reads the same configs
+	configFile string
+	profiles   []string
+	modules    []string
+
+	// ephemeralNamespace is passed to the `skaffold filter` post-renderer so that it moves resources to the same namespace
+	ephemeralNamespace string
+
+	// transformer applies the transforms of the deploy config, if any, and holds the annotations of the deployment.
+	// Like the validation config, they're passed to the `skaffold filter` post-renderer.
+	transformer *manifest.Transformer
+
+	// validator checks the manifests before they are applied, if validation is configured.
+	// Its configuration is passed to the `skaffold filter` post-renderer, which sees the manifests helm applies.
+	validator *manifest.Validator
+
+	globalConfig       string
+	insecureRegistries map[string]bool
 
 	// packaging temporary directory, used for predictable test output
 	pkgTmpDir string
@@ -90,8 +108,17 @@ type Deployer struct {
 	bV semver.Version
 }
 
+// Config contains the configuration needed by the helm deployer.
+type Config interface {
+	kubectl.Config
+	GetEphemeralNamespace() string
+	Profiles() []string
+	ConfigurationFilter() []string
+}
+
 // NewDeployer returns a configured Deployer.  Returns an error if current version of helm is less than 3.0.0.
-func NewDeployer(cfg kubectl.Config, labels map[string]string, h *latest.HelmDeploy) (*Deployer, error) {
+// The manifests are transformed by `transformer` and checked by `validator` before they are applied, unless they're nil.
+func NewDeployer(cfg Config, labels map[string]string, h *latest.HelmDeploy, transformer *manifest.Transformer, validator *manifest.Validator) (*Deployer, error) {
 	hv, err := binVer()
 	if err != nil {
 		return nil, versionGetErr(err)
@@ -108,9 +135,17 @@ func NewDeployer(cfg kubectl.Config, labels map[string]string, h *latest.HelmDep
 		namespace:   cfg.GetKubeNamespace(),
 		forceDeploy: cfg.ForceDeploy(),
 		configFile:  cfg.ConfigurationFile(),
+		profiles:    cfg.Profiles(),
+		modules:     cfg.ConfigurationFilter(),
 		labels:      labels,
 		bV:          hv,
 		enableDebug: cfg.Mode() == config.RunModes.Debug,
+
+		ephemeralNamespace: cfg.GetEphemeralNamespace(),
+		transformer:        transformer,
+		validator:          validator,
+		globalConfig:       cfg.GlobalConfig(),
+		insecureRegistries: cfg.GetInsecureRegistries(),
 	}, nil
 }
 
@@ -153,7 +188,7 @@ func (h *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 		}
 	}
 
-	if err := label.Apply(ctx, h.labels, dRes); err != nil {
+	if err := label.Apply(ctx, h.kubeContext, h.labels, h.transformer.Annotations(), dRes); err != nil {
 		return nil, helmLabelErr(fmt.Errorf("adding labels: %w", err))
 	}
 
@@ -295,7 +330,22 @@ func (h *Deployer) Render(ctx context.Context, out io.Writer, builds []build.Art
 		renderedManifests.Write(outBuffer.Bytes())
 	}
 
-	return manifest.Write(renderedManifests.String(), filepath, out)
+	if len(manifest.GetTransforms()) == 0 && len(h.transformer.Config()) == 0 {
+		return manifest.Write(renderedManifests.String(), filepath, out)
+	}
+
+	manifests, err := manifest.Load(renderedManifests)
+	if err != nil {
+		return err
+	}
+	debugHelpersRegistry, err := config.GetDebugHelpersRegistry(h.globalConfig)
+	if err != nil {
+		return deployerr.DebugHelperRetrieveErr(err)
+	}
+	if manifests, err = manifest.ApplyTransforms(manifests, builds, h.transformer, h.insecureRegistries, debugHelpersRegistry); err != nil {
+		return err
+	}
+	return manifest.Write(manifests.String(), filepath, out)
 }
 
 // deployRelease deploys a single release
@@ -311,9 +361,19 @@ func (h *Deployer) deployRelease(ctx context.Context, out io.Writer, releaseName
 	}
 
 	var installEnv []string
-	if h.enableDebug {
+	// The debugging transforms and the transforms of the configuration are applied,
+	// and the manifests are validated, by a `skaffold filter` post-renderer.
+	// The annotations of the deployment are stamped along with the labels, once the release is deployed.
+	if h.enableDebug || len(h.transformer.Config()) > 0 || h.validator != nil {
 		if h.bV.LT(helm31Version) {
-			return nil, fmt.Errorf("debug requires at least Helm 3.1 (current: %v)", h.bV)
+			switch {
+			case h.enableDebug:
+				return nil, fmt.Errorf("debug requires at least Helm 3.1 (current: %v)", h.bV)
+			case h.validator != nil:
+				return nil, fmt.Errorf("manifest validation requires at least Helm 3.1 (current: %v)", h.bV)
+			default:
+				return nil, fmt.Errorf("manifest transforms require at least Helm 3.1 (current: %v)", h.bV)
+			}
 		}
 		var binary string
 		if binary, err = osExecutable(); err != nil {
@@ -331,7 +391,10 @@ func (h *Deployer) deployRelease(ctx context.Context, out io.Writer, releaseName
 			defer cleanup()
 		}
 
-		cmdLine := h.generateSkaffoldDebugFilter(buildsFile)
+		var cmdLine []string
+		if cmdLine, err = h.generateSkaffoldFilter(buildsFile); err != nil {
+			return nil, err
+		}
 
 		// need to include current environment, specifically for HOME to lookup ~/.kube/config
 		env := util.EnvSliceToMap(util.OSEnviron(), "=")
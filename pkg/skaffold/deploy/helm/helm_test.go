@@ -18,15 +18,27 @@ package helm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"path/filepath"
 	"testing"
 
 	"github.com/mitchellh/go-homedir"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	fakedynclient "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
+	fakeclient "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
@@ -445,7 +457,7 @@ func TestNewDeployer(t *testing.T) {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.Override(&util.DefaultExecCommand, testutil.CmdRunWithOutput("helm version --client", test.helmVersion))
 
-			_, err := NewDeployer(&helmConfig{}, nil, &testDeployConfig)
+			_, err := NewDeployer(&helmConfig{}, nil, &testDeployConfig, nil, nil)
 			t.CheckError(test.shouldErr, err)
 		})
 	}
@@ -958,7 +970,7 @@ func TestHelmDeploy(t *testing.T) {
 				namespace:  test.namespace,
 				force:      test.force,
 				configFile: "test.yaml",
-			}, nil, &test.helm)
+			}, nil, &test.helm, nil, nil)
 			t.RequireNoError(err)
 
 			if test.configure != nil {
@@ -974,6 +986,55 @@ func TestHelmDeploy(t *testing.T) {
 	}
 }
 
+func TestHelmDeployLabelsReleaseOfKubeContext(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		dep := &appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Name: "skaffold-helm", Namespace: "testReleaseNamespace"},
+		}
+		client := fakeclient.NewSimpleClientset(dep)
+		client.Resources = append(client.Resources, &metav1.APIResourceList{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{{Kind: "Deployment", Name: "deployments", Namespaced: true}},
+		})
+		dynClient := fakedynclient.NewSimpleDynamicClient(scheme.Scheme, dep)
+
+		// The release is deployed to the kube-context of the config, not to the active one.
+		var kubeContexts []string
+		t.Override(&kubernetesclient.Client, func() (kubernetes.Interface, error) {
+			return nil, errors.New("the active kube-context shouldn't be used")
+		})
+		t.Override(&kubernetesclient.DynamicClient, func() (dynamic.Interface, error) {
+			return nil, errors.New("the active kube-context shouldn't be used")
+		})
+		t.Override(&kubernetesclient.ClientForContext, func(kubeContext string) (kubernetes.Interface, error) {
+			kubeContexts = append(kubeContexts, kubeContext)
+			return client, nil
+		})
+		t.Override(&kubernetesclient.DynamicClientForContext, func(kubeContext string) (dynamic.Interface, error) {
+			kubeContexts = append(kubeContexts, kubeContext)
+			return dynClient, nil
+		})
+		t.Override(&util.OSEnviron, func() []string { return []string{"FOO=FOOBAR"} })
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRunWithOutput("helm version --client", version32).
+			AndRun("helm --kube-context kubecontext get all --namespace testReleaseNamespace skaffold-helm --kubeconfig kubeconfig").
+			AndRun("helm --kube-context kubecontext dep build examples/test --kubeconfig kubeconfig").
+			AndRun("helm --kube-context kubecontext upgrade skaffold-helm examples/test --namespace testReleaseNamespace -f skaffold-overrides.yaml --set-string image=docker.io:5000/skaffold-helm:3605e7bc17cf46e53f4d81c4cbc24e5b4c495184 --set some.key=somevalue --kubeconfig kubeconfig").
+			AndRunWithOutput("helm --kube-context kubecontext get all --namespace testReleaseNamespace skaffold-helm --kubeconfig kubeconfig", helmReleaseInfo("testReleaseNamespace", validDeployYaml)))
+
+		deployer, err := NewDeployer(&helmConfig{}, map[string]string{"user/label": "test"}, &testDeployCreateNamespaceConfig, nil, nil)
+		t.RequireNoError(err)
+		_, err = deployer.Deploy(context.Background(), ioutil.Discard, testBuilds)
+		t.CheckNoError(err)
+
+		t.CheckDeepEqual([]string{kubectl.TestKubeContext, kubectl.TestKubeContext}, kubeContexts)
+		labelled, err := dynClient.Resource(schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}).Namespace("testReleaseNamespace").Get(context.Background(), "skaffold-helm", metav1.GetOptions{})
+		t.CheckNoError(err)
+		t.CheckDeepEqual(map[string]string{"user/label": "test"}, labelled.GetLabels())
+	})
+}
+
 func TestHelmCleanup(t *testing.T) {
 	tests := []struct {
 		description      string
@@ -1036,7 +1097,7 @@ func TestHelmCleanup(t *testing.T) {
 
 			deployer, err := NewDeployer(&helmConfig{
 				namespace: test.namespace,
-			}, nil, &test.helm)
+			}, nil, &test.helm, nil, nil)
 			t.RequireNoError(err)
 
 			deployer.Cleanup(context.Background(), ioutil.Discard)
@@ -1146,7 +1207,7 @@ func TestHelmDependencies(t *testing.T) {
 					SkipBuildDependencies: test.skipBuildDependencies,
 					Remote:                test.remote,
 				}},
-			})
+			}, nil, nil)
 			t.RequireNoError(err)
 			deps, err := deployer.Dependencies()
 
@@ -1358,7 +1419,7 @@ func TestHelmRender(t *testing.T) {
 			t.Override(&util.DefaultExecCommand, test.commands)
 			deployer, err := NewDeployer(&helmConfig{
 				namespace: test.namespace,
-			}, nil, &test.helm)
+			}, nil, &test.helm, nil, nil)
 			t.RequireNoError(err)
 			err = deployer.Render(context.Background(), ioutil.Discard, test.builds, true, file)
 			t.CheckError(test.shouldErr, err)
@@ -1407,29 +1468,84 @@ func TestWriteBuildArtifacts(t *testing.T) {
 	}
 }
 
-func TestGenerateSkaffoldDebugFilter(t *testing.T) {
+func TestGenerateSkaffoldFilter(t *testing.T) {
 	tests := []struct {
-		description string
-		buildFile   string
-		result      []string
+		description        string
+		enableDebug        bool
+		buildFile          string
+		ephemeralNamespace string
+		profiles           []string
+		modules            []string
+		transforms         []latest.ManifestTransform
+		resourceSelector   []latest.ResourceFilter
+		validate           bool
+		result             []string
 	}{
 		{
 			description: "empty buildfile is skipped",
+			enableDebug: true,
 			buildFile:   "",
 			result:      []string{"filter", "--debugging", "--kube-context", "kubecontext", "--kubeconfig", "kubeconfig"},
 		},
 		{
 			description: "buildfile is added",
+			enableDebug: true,
 			buildFile:   "buildfile",
 			result:      []string{"filter", "--debugging", "--kube-context", "kubecontext", "--build-artifacts", "buildfile", "--kubeconfig", "kubeconfig"},
 		},
+		{
+			description: "no debugging transforms outside of debug mode",
+			buildFile:   "buildfile",
+			result:      []string{"filter", "--kube-context", "kubecontext", "--build-artifacts", "buildfile", "--kubeconfig", "kubeconfig"},
+		},
+		{
+			description:        "ephemeral namespace is passed on",
+			ephemeralNamespace: "skaffold-bob",
+			result:             []string{"filter", "--kube-context", "kubecontext", "--ephemeral-namespace", "skaffold-bob", "--kubeconfig", "kubeconfig"},
+		},
+		{
+			description: "transforms are passed on",
+			transforms:  []latest.ManifestTransform{{Labels: map[string]string{"team": "web"}}},
+			result:      []string{"filter", "--kube-context", "kubecontext", "--transforms", `[{"Namespace":"","Labels":{"team":"web"},"Annotations":null,"Patch":null,"Sidecar":null,"EnvFile":"","Function":null}]`, "--kubeconfig", "kubeconfig"},
+		},
+		{
+			description:      "resource selector is passed on",
+			resourceSelector: []latest.ResourceFilter{{GroupKind: "Rollout.argoproj.io", Image: []string{".spec.images.*"}}},
+			result:           []string{"filter", "--kube-context", "kubecontext", "--resource-selector", `[{"GroupKind":"Rollout.argoproj.io","Image":[".spec.images.*"],"Labels":null,"Recursive":false}]`, "--kubeconfig", "kubeconfig"},
+		},
+		{
+			description: "profiles and modules are passed on",
+			profiles:    []string{"prod", "-local"},
+			modules:     []string{"web"},
+			result:      []string{"filter", "--kube-context", "kubecontext", "--profile", "prod", "--profile", "-local", "--module", "web", "--kubeconfig", "kubeconfig"},
+		},
+		{
+			description: "validation config is passed on",
+			validate:    true,
+			result:      []string{"filter", "--kube-context", "kubecontext", "--validate", `{"KubernetesVersion":"","KubernetesSchema":"","CRDs":null,"IgnoreUnknownKinds":false,"Policies":{"RequireResourceLimits":false,"ForbidLatestTag":false}}`, "--kubeconfig", "kubeconfig"},
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.Override(&util.DefaultExecCommand, testutil.CmdRunWithOutput("helm version --client", version31))
-			h, err := NewDeployer(&helmConfig{}, nil, &testDeployConfig)
+			var validator *manifest.Validator
+			if test.validate {
+				var err error
+				validator, err = manifest.NewValidator(latest.ValidateConfig{})
+				t.RequireNoError(err)
+			}
+			selector, err := manifest.NewResourceSelector(test.resourceSelector)
 			t.RequireNoError(err)
-			result := h.generateSkaffoldDebugFilter(test.buildFile)
+			transformer, err := manifest.NewTransformer(test.transforms, selector, nil)
+			t.RequireNoError(err)
+			h, err := NewDeployer(&helmConfig{RunContext: runcontext.RunContext{
+				EphemeralNamespace: test.ephemeralNamespace,
+				Opts:               config.SkaffoldOptions{Profiles: test.profiles, ConfigurationFilter: test.modules},
+			}}, nil, &testDeployConfig, transformer, validator)
+			t.RequireNoError(err)
+			h.enableDebug = test.enableDebug
+			result, err := h.generateSkaffoldFilter(test.buildFile)
+			t.CheckNoError(err)
 			t.CheckDeepEqual(test.result, result)
 		})
 	}
@@ -1467,3 +1583,70 @@ MANIFEST:
 ---
 %s`, namespace, manifest)
 }
+
+func TestCheckHermetic(t *testing.T) {
+	tests := []struct {
+		description string
+		files       map[string]string
+		release     latest.HelmRelease
+		shouldErr   bool
+	}{
+		{
+			description: "local chart without dependencies",
+			files:       map[string]string{"chart/Chart.yaml": "name: chart"},
+			release:     latest.HelmRelease{Name: "app", ChartPath: "chart"},
+		},
+		{
+			description: "vendored dependencies",
+			files: map[string]string{
+				"chart/Chart.yaml":              "name: chart\ndependencies:\n- name: redis\n- name: postgresql",
+				"chart/charts/redis/Chart.yaml": "name: redis",
+				"chart/charts/postgresql-1.tgz": "",
+			},
+			release: latest.HelmRelease{Name: "app", ChartPath: "chart"},
+		},
+		{
+			description: "packaged chart",
+			files:       map[string]string{"chart-1.0.0.tgz": ""},
+			release:     latest.HelmRelease{Name: "app", ChartPath: "chart-1.0.0.tgz"},
+		},
+		{
+			description: "dependency to download",
+			files:       map[string]string{"chart/Chart.yaml": "name: chart\ndependencies:\n- name: redis\n  repository: https://charts.example.com"},
+			release:     latest.HelmRelease{Name: "app", ChartPath: "chart"},
+			shouldErr:   true,
+		},
+		{
+			description: "helm 2 requirements to download",
+			files: map[string]string{
+				"chart/Chart.yaml":        "name: chart",
+				"chart/requirements.yaml": "dependencies:\n- name: redis",
+			},
+			release:   latest.HelmRelease{Name: "app", ChartPath: "chart"},
+			shouldErr: true,
+		},
+		{
+			description: "remote chart",
+			release:     latest.HelmRelease{Name: "app", ChartPath: "stable/redis", Remote: true},
+			shouldErr:   true,
+		},
+		{
+			description: "missing chart",
+			release:     latest.HelmRelease{Name: "app", ChartPath: "chart"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().WriteFiles(test.files)
+			release := test.release
+			if !release.Remote {
+				release.ChartPath = tmpDir.Path(release.ChartPath)
+			}
+
+			err := CheckHermetic(&latest.HelmDeploy{Releases: []latest.HelmRelease{release}})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
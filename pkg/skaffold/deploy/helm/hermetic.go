@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// chartDependencies is the part of a `Chart.yaml` or `requirements.yaml` that lists the dependencies of a chart.
+type chartDependencies struct {
+	Dependencies []struct {
+		Name string `yaml:"name"`
+	} `yaml:"dependencies"`
+}
+
+// CheckHermetic returns an error if rendering the releases would need to download charts.
+// Chart dependencies must be vendored in the `charts/` directory of the chart.
+func CheckHermetic(d *latest.HelmDeploy) error {
+	for _, r := range d.Releases {
+		if r.Remote {
+			return fmt.Errorf("release %q uses the remote chart %q", r.Name, r.ChartPath)
+		}
+
+		info, err := os.Stat(r.ChartPath)
+		if err != nil {
+			return fmt.Errorf("chart %q of release %q isn't available locally", r.ChartPath, r.Name)
+		}
+		if !info.IsDir() {
+			// Packaged charts embed their dependencies
+			continue
+		}
+
+		if err := checkVendoredDependencies(r.ChartPath); err != nil {
+			return fmt.Errorf("release %q: %w", r.Name, err)
+		}
+	}
+	return nil
+}
+
+func checkVendoredDependencies(chartPath string) error {
+	for _, file := range []string{"Chart.yaml", "requirements.yaml"} {
+		buf, err := ioutil.ReadFile(filepath.Join(chartPath, file))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		var deps chartDependencies
+		if err := yaml.Unmarshal(buf, &deps); err != nil {
+			return fmt.Errorf("parsing %s: %w", file, err)
+		}
+
+		for _, dep := range deps.Dependencies {
+			if info, err := os.Stat(filepath.Join(chartPath, "charts", dep.Name)); err == nil && info.IsDir() {
+				continue
+			}
+			if archives, _ := filepath.Glob(filepath.Join(chartPath, "charts", dep.Name+"-*.tgz")); len(archives) > 0 {
+				continue
+			}
+			return fmt.Errorf("dependency %q of chart %q isn't vendored in its charts/ directory, run `helm dependency build`", dep.Name, chartPath)
+		}
+	}
+	return nil
+}
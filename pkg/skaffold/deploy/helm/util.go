@@ -140,17 +140,53 @@ func pairParamsToArtifacts(builds []build.Artifact, params map[string]string) (m
 	return paramToBuildResult, nil
 }
 
-func (h *Deployer) generateSkaffoldDebugFilter(buildsFile string) []string {
-	args := []string{"filter", "--debugging", "--kube-context", h.kubeContext}
+// generateSkaffoldFilter returns the `skaffold filter` command line used as a post-renderer
+// to apply the manifest transforms, including the debugging transforms in debug mode, and to validate the manifests.
+func (h *Deployer) generateSkaffoldFilter(buildsFile string) ([]string, error) {
+	args := []string{"filter"}
+	if h.enableDebug {
+		args = append(args, "--debugging")
+	}
+	args = append(args, "--kube-context", h.kubeContext)
+	if h.ephemeralNamespace != "" {
+		args = append(args, "--ephemeral-namespace", h.ephemeralNamespace)
+	}
 	if len(buildsFile) > 0 {
 		args = append(args, "--build-artifacts", buildsFile)
 	}
+	if len(h.transformer.Config()) > 0 {
+		transforms, err := json.Marshal(h.transformer.Config())
+		if err != nil {
+			return nil, fmt.Errorf("marshalling manifest transforms: %w", err)
+		}
+		args = append(args, "--transforms", string(transforms))
+	}
+	if selector := h.transformer.ResourceSelector(); selector != nil {
+		filters, err := json.Marshal(selector.Config())
+		if err != nil {
+			return nil, fmt.Errorf("marshalling resource selector: %w", err)
+		}
+		args = append(args, "--resource-selector", string(filters))
+	}
+	if h.validator != nil {
+		validate, err := json.Marshal(h.validator.Config())
+		if err != nil {
+			return nil, fmt.Errorf("marshalling validation config: %w", err)
+		}
+		args = append(args, "--validate", string(validate))
+	}
+	for _, p := range h.profiles {
+		args = append(args, "--profile", p)
+	}
+	for _, m := range h.modules {
+		args = append(args, "--module", m)
+	}
 	args = append(args, h.Flags.Global...)
 
 	if h.kubeConfig != "" {
 		args = append(args, "--kubeconfig", h.kubeConfig)
 	}
-	return args
+	return args, nil
 }
 
 func (h *Deployer) releaseNamespace(r latest.HelmRelease) (string, error) {
@@ -201,6 +237,12 @@ func envVarForImage(imageName string, digest string) map[string]string {
 // exec executes the helm command, writing combined stdout/stderr to the provided writer
 func (h *Deployer) exec(ctx context.Context, out io.Writer, useSecrets bool, env []string, args ...string) error {
 	args = append([]string{"--kube-context", h.kubeContext}, args...)
+	for _, p := range h.profiles {
+		args = append(args, "--profile", p)
+	}
+	for _, m := range h.modules {
+		args = append(args, "--module", m)
+	}
 	args = append(args, h.Flags.Global...)
 
 	if h.kubeConfig != "" {
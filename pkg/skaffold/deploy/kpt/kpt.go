@@ -30,14 +30,15 @@ import (
 
 	"golang.org/x/mod/semver"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"sigs.k8s.io/kustomize/kyaml/fn/framework"
+	"sigs.k8s.io/kustomize/kyaml/kio"
+	"sigs.k8s.io/kustomize/kyaml/kio/kioutil"
 	k8syaml "sigs.k8s.io/yaml"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kustomize"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/types"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -50,6 +51,7 @@ const (
 	tmpKustomizeDir   = ".kustomize"
 	kptFnAnnotation   = "config.kubernetes.io/function"
 	kptFnLocalConfig  = "config.kubernetes.io/local-config"
+	kptFile           = "Kptfile"
 
 	kptDownloadLink = "https://googlecontainertools.github.io/kpt/installation/"
 	kptMinVersion   = "0.34.0"
@@ -66,43 +68,44 @@ type Deployer struct {
 	insecureRegistries map[string]bool
 	labels             map[string]string
 	globalConfig       string
+	kubeContext        string
+	kubeConfig         string
+	// transformer applies the transforms of the deploy config, if any.
+	transformer *manifest.Transformer
+
+	// validator checks the manifests before they are applied, if validation is configured.
+	validator *manifest.Validator
 }
 
 // NewDeployer generates a new Deployer object contains the kptDeploy schema.
-func NewDeployer(cfg types.Config, labels map[string]string, d *latest.KptDeploy) *Deployer {
+// The manifests are transformed by `transformer` and checked by `validator` before they are applied, unless they're nil.
+func NewDeployer(cfg kubectl.Config, labels map[string]string, d *latest.KptDeploy, transformer *manifest.Transformer, validator *manifest.Validator) *Deployer {
 	return &Deployer{
 		KptDeploy:          d,
 		insecureRegistries: cfg.GetInsecureRegistries(),
 		labels:             labels,
 		globalConfig:       cfg.GlobalConfig(),
+		kubeContext:        cfg.GetKubeContext(),
+		kubeConfig:         cfg.GetKubeConfig(),
+		transformer:        transformer,
+		validator:          validator,
 	}
 }
 
 var sanityCheck = versionCheck
 
 // versionCheck checks if the kpt and kustomize versions meet the minimum requirements.
-func versionCheck(dir string, stdout io.Writer) error {
-	kptCmd := exec.Command("kpt", "version")
-	out, err := util.RunCmdOut(kptCmd)
-	if err != nil {
-		return fmt.Errorf("kpt is not installed yet\nSee kpt installation: %v",
-			kptDownloadLink)
-	}
-	version := strings.TrimSuffix(string(out), "\n")
-	// kpt follows semver but does not have "v" prefix.
-	if !semver.IsValid("v" + version) {
-		return fmt.Errorf("unknown kpt version %v\nPlease upgrade your "+
-			"local kpt CLI to a version >= %v\nSee kpt installation: %v",
-			string(out), kptMinVersion, kptDownloadLink)
-	}
-	if semver.Compare("v"+version, "v"+kptMinVersion) < 0 {
-		return fmt.Errorf("you are using kpt %q\nPlease update your kpt version to"+
-			" >= %v\nSee kpt installation: %v", version[0], kptMinVersion, kptDownloadLink)
+// kpt is only required to run kpt functions.
+func versionCheck(dir string, runFunctions bool, stdout io.Writer) error {
+	if runFunctions {
+		if err := kptVersionCheck(); err != nil {
+			return err
+		}
 	}
 
 	// Users can choose not to use kustomize in kpt deployer mode. We only check the kustomize
 	// version when kustomization.yaml config is directed under .deploy.kpt.dir path.
-	_, err = kustomize.FindKustomizationConfig(dir)
+	_, err := kustomize.FindKustomizationConfig(dir)
 	if err == nil {
 		kustomizeCmd := exec.Command("kustomize", "version")
 		out, err := util.RunCmdOut(kustomizeCmd)
@@ -128,13 +131,32 @@ func versionCheck(dir string, stdout io.Writer) error {
 	return nil
 }
 
+// kptVersionCheck checks if the kpt version meets the minimum requirement.
+func kptVersionCheck() error {
+	kptCmd := exec.Command("kpt", "version")
+	out, err := util.RunCmdOut(kptCmd)
+	if err != nil {
+		return fmt.Errorf("kpt is not installed yet\nSee kpt installation: %v",
+			kptDownloadLink)
+	}
+	version := strings.TrimSuffix(string(out), "\n")
+	// kpt follows semver but does not have "v" prefix.
+	if !semver.IsValid("v" + version) {
+		return fmt.Errorf("unknown kpt version %v\nPlease upgrade your "+
+			"local kpt CLI to a version >= %v\nSee kpt installation: %v",
+			string(out), kptMinVersion, kptDownloadLink)
+	}
+	if semver.Compare("v"+version, "v"+kptMinVersion) < 0 {
+		return fmt.Errorf("you are using kpt %q\nPlease update your kpt version to"+
+			" >= %v\nSee kpt installation: %v", version[0], kptMinVersion, kptDownloadLink)
+	}
+	return nil
+}
+
 // Deploy hydrates the manifests using kustomizations and kpt functions as described in the render method,
-// outputs them to the applyDir, and runs `kpt live apply` against applyDir to create resources in the cluster.
-// `kpt live apply` supports automated pruning declaratively via resources in the applyDir.
+// outputs them to the applyDir, and applies the resources of the applyDir like `kpt live apply` does.
+// The resources that were removed from the applyDir since the last apply are pruned.
 func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Artifact) ([]string, error) {
-	if err := sanityCheck(k.Dir, out); err != nil {
-		return nil, err
-	}
 	flags, err := k.getKptFnRunArgs()
 	if err != nil {
 		return []string{}, err
@@ -144,6 +166,12 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 		return nil, err
 	}
 
+	if k.validator != nil {
+		if err := k.validator.Validate(manifests); err != nil {
+			return nil, fmt.Errorf("validating manifests: %w", err)
+		}
+	}
+
 	if len(manifests) == 0 {
 		return nil, nil
 	}
@@ -160,10 +188,7 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 	}
 
 	manifest.Write(manifests.String(), filepath.Join(applyDir, "resources.yaml"), out)
-	cmd := exec.CommandContext(ctx, "kpt", kptCommandArgs(applyDir, []string{"live", "apply"}, k.getKptLiveApplyArgs(), nil)...)
-	cmd.Stdout = out
-	cmd.Stderr = out
-	if err := util.RunCmd(cmd); err != nil {
+	if err := k.liveApply(ctx, out, applyDir); err != nil {
 		return nil, err
 	}
 
@@ -208,28 +233,18 @@ func (k *Deployer) Dependencies() ([]string, error) {
 	return deps.ToList(), nil
 }
 
-// Cleanup deletes what was deployed by calling `kpt live destroy`.
+// Cleanup deletes what was deployed, like `kpt live destroy` does.
 func (k *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
 	applyDir, err := k.getApplyDir(ctx)
 	if err != nil {
 		return fmt.Errorf("getting applyDir: %w", err)
 	}
 
-	cmd := exec.CommandContext(ctx, "kpt", kptCommandArgs(applyDir, []string{"live", "destroy"}, nil, nil)...)
-	cmd.Stdout = out
-	cmd.Stderr = out
-	if err := util.RunCmd(cmd); err != nil {
-		return err
-	}
-
-	return nil
+	return k.liveDestroy(out, applyDir)
 }
 
 // Render hydrates manifests using both kustomization and kpt functions.
 func (k *Deployer) Render(ctx context.Context, out io.Writer, builds []build.Artifact, _ bool, filepath string) error {
-	if err := sanityCheck(k.Dir, out); err != nil {
-		return err
-	}
 	flags, err := k.getKptFnRunArgs()
 	if err != nil {
 		return err
@@ -243,10 +258,22 @@ func (k *Deployer) Render(ctx context.Context, out io.Writer, builds []build.Art
 	return manifest.Write(manifests.String(), filepath, out)
 }
 
+// CheckHermetic returns an error if the kpt functions can't run without network access.
+func CheckHermetic(d *latest.KptDeploy) error {
+	if d.Fn.Image != "" {
+		return fmt.Errorf("kpt function image %q may need to be pulled", d.Fn.Image)
+	}
+	if d.Fn.Network || d.Fn.NetworkName != "" {
+		return errors.New("kpt functions are given network access")
+	}
+	return nil
+}
+
 // renderManifests handles a majority of the hydration process for manifests.
 // This involves reading configs from a source directory, running kustomize build, running kpt pipelines,
 // adding image digests, and adding run-id labels.
-func (k *Deployer) renderManifests(ctx context.Context, _ io.Writer, builds []build.Artifact,
+// The configs are read and written like `kpt fn source` and `kpt fn sink` do, the kpt binary only runs the functions.
+func (k *Deployer) renderManifests(ctx context.Context, out io.Writer, builds []build.Artifact,
 	flags []string) (manifest.ManifestList, error) {
 	var err error
 	debugHelpersRegistry, err := config.GetDebugHelpersRegistry(k.globalConfig)
@@ -256,10 +283,7 @@ func (k *Deployer) renderManifests(ctx context.Context, _ io.Writer, builds []bu
 
 	var buf []byte
 	// Read the manifests under k.Dir as "source".
-	cmd := exec.CommandContext(
-		ctx, "kpt", kptCommandArgs(k.Dir, []string{"fn", "source"},
-			nil, nil)...)
-	buf, err = util.RunCmdOut(cmd)
+	buf, err = source(k.Dir)
 	if err != nil {
 		return nil, fmt.Errorf("reading config manifests: %w", err)
 	}
@@ -271,10 +295,7 @@ func (k *Deployer) renderManifests(ctx context.Context, _ io.Writer, builds []bu
 	// adds it back to the pipeline after kustomize build finishes (append kptFn).
 	var kptFnBuf []byte
 	if len(k.Fn.FnPath) > 0 {
-		cmd = exec.CommandContext(
-			ctx, "kpt", kptCommandArgs(k.Fn.FnPath, []string{"fn", "source"},
-				nil, nil)...)
-		if kptFnBuf, err = util.RunCmdOut(cmd); err != nil {
+		if kptFnBuf, err = source(k.Fn.FnPath); err != nil {
 			return nil, fmt.Errorf("kpt source the fn-path config %v", err)
 		}
 	} else {
@@ -285,6 +306,11 @@ func (k *Deployer) renderManifests(ctx context.Context, _ io.Writer, builds []bu
 		return nil, err
 	}
 
+	runFunctions := k.Fn.Image != "" || len(kptFn) > 0
+	if err := sanityCheck(k.Dir, runFunctions, out); err != nil {
+		return nil, err
+	}
+
 	// Hydrate the manifests source.
 	_, err = kustomize.FindKustomizationConfig(k.Dir)
 	// Only run kustomize if kustomization.yaml is found.
@@ -302,7 +328,7 @@ func (k *Deployer) renderManifests(ctx context.Context, _ io.Writer, builds []bu
 			os.RemoveAll(tmpKustomizeDir)
 		}()
 
-		err = k.sink(ctx, buf, tmpKustomizeDir)
+		err = sink(buf, tmpKustomizeDir)
 		if err != nil {
 			return nil, err
 		}
@@ -313,14 +339,16 @@ func (k *Deployer) renderManifests(ctx context.Context, _ io.Writer, builds []bu
 			return nil, fmt.Errorf("kustomize build: %w", err)
 		}
 	}
-	// Run kpt functions against the hydrated manifests.
-	cmd = exec.CommandContext(ctx, "kpt", kptCommandArgs("", []string{"fn", "run"}, flags, nil)...)
-	buf = append(buf, []byte("---\n")...)
-	buf = append(buf, kptFn...)
-	cmd.Stdin = bytes.NewBuffer(buf)
-	buf, err = util.RunCmdOut(cmd)
-	if err != nil {
-		return nil, fmt.Errorf("running kpt functions: %w", err)
+	if runFunctions {
+		// Run kpt functions against the hydrated manifests.
+		cmd := exec.CommandContext(ctx, "kpt", kptCommandArgs("", []string{"fn", "run"}, flags, nil)...)
+		buf = append(buf, []byte("---\n")...)
+		buf = append(buf, kptFn...)
+		cmd.Stdin = bytes.NewBuffer(buf)
+		buf, err = util.RunCmdOut(cmd)
+		if err != nil {
+			return nil, fmt.Errorf("running kpt functions: %w", err)
+		}
 	}
 
 	// Store the manipulated manifests to the sink dir.
@@ -332,7 +360,7 @@ func (k *Deployer) renderManifests(ctx context.Context, _ io.Writer, builds []bu
 		if err := os.MkdirAll(k.Fn.SinkDir, os.ModePerm); err != nil {
 			return nil, fmt.Errorf("creating sink directory %s: %w", k.Fn.SinkDir, err)
 		}
-		err = k.sink(ctx, buf, k.Fn.SinkDir)
+		err = sink(buf, k.Fn.SinkDir)
 		if err != nil {
 			return nil, fmt.Errorf("sinking to directory %s: %w", k.Fn.SinkDir, err)
 		}
@@ -341,6 +369,11 @@ func (k *Deployer) renderManifests(ctx context.Context, _ io.Writer, builds []bu
 			k.Fn.SinkDir)
 	}
 
+	// Without functions to run, the configs are still wrapped in a ResourceList
+	if buf, err = unwrap(buf); err != nil {
+		return nil, fmt.Errorf("reading hydrated manifests: %w", err)
+	}
+
 	var manifests manifest.ManifestList
 	if len(buf) > 0 {
 		manifests.Append(buf)
@@ -349,31 +382,32 @@ func (k *Deployer) renderManifests(ctx context.Context, _ io.Writer, builds []bu
 	if err != nil {
 		return nil, fmt.Errorf("excluding kpt functions from manifests: %w", err)
 	}
-	manifests, err = manifests.ReplaceImages(builds)
+	manifests, err = manifests.ReplaceImages(builds, k.transformer.ResourceSelector())
 	if err != nil {
 		return nil, fmt.Errorf("replacing images in manifests: %w", err)
 	}
 
-	if manifests, err = manifest.ApplyTransforms(manifests, builds, k.insecureRegistries, debugHelpersRegistry); err != nil {
+	if manifests, err = manifest.ApplyTransforms(manifests, builds, k.transformer, k.insecureRegistries, debugHelpersRegistry); err != nil {
 		return nil, err
 	}
 
-	return manifests.SetLabels(k.labels)
+	return manifests.SetLabels(k.labels, k.transformer.ResourceSelector())
 }
 
 func (k *Deployer) getKptFunc(buf []byte) ([]byte, error) {
 	input := bytes.NewBufferString(string(buf))
-	rl := framework.ResourceList{
+	rl := kio.ByteReadWriter{
 		Reader: input,
 	}
 	// Manipulate the kustomize "Rnode"(Kustomize term) and pulls out the "Items"
 	// from ResourceLists.
-	if err := rl.Read(); err != nil {
+	items, err := rl.Read()
+	if err != nil {
 		return nil, fmt.Errorf("reading ResourceList %w", err)
 	}
 	var kptFn []byte
-	for i := range rl.Items {
-		item, err := rl.Items[i].String()
+	for i := range items {
+		item, err := items[i].String()
 		if err != nil {
 			return nil, fmt.Errorf("reading Item %w", err)
 		}
@@ -393,11 +427,38 @@ func (k *Deployer) getKptFunc(buf []byte) ([]byte, error) {
 	return kptFn, nil
 }
 
-func (k *Deployer) sink(ctx context.Context, buf []byte, sinkDir string) error {
-	cmd := exec.CommandContext(ctx, "kpt", kptCommandArgs(sinkDir, []string{"fn", "sink"}, nil, nil)...)
-	cmd.Stdin = bytes.NewBuffer(buf)
-	_, err := util.RunCmdOut(cmd)
-	return err
+// source reads the configs of a directory, or of a file, into a ResourceList like `kpt fn source` does.
+func source(path string) ([]byte, error) {
+	var buf bytes.Buffer
+	err := kio.Pipeline{
+		Inputs: []kio.Reader{kio.LocalPackageReader{PackagePath: path, PackageFileName: kptFile, IncludeSubpackages: true}},
+		Outputs: []kio.Writer{kio.ByteWriter{
+			Writer:                &buf,
+			KeepReaderAnnotations: true,
+			WrappingKind:          kio.ResourceListKind,
+			WrappingAPIVersion:    kio.ResourceListAPIVersion,
+		}},
+	}.Execute()
+	return buf.Bytes(), err
+}
+
+// sink writes the configs back to the files they were read from, relative to sinkDir, like `kpt fn sink` does.
+func sink(buf []byte, sinkDir string) error {
+	return kio.Pipeline{
+		Inputs:  []kio.Reader{&kio.ByteReader{Reader: bytes.NewReader(buf)}},
+		Outputs: []kio.Writer{kio.LocalPackageWriter{PackagePath: sinkDir}},
+	}.Execute()
+}
+
+// unwrap turns a ResourceList, or a stream of configs, into a stream of configs without the annotations
+// that record which files they were read from. Those are only needed to sink the configs.
+func unwrap(buf []byte) ([]byte, error) {
+	var out bytes.Buffer
+	err := kio.Pipeline{
+		Inputs:  []kio.Reader{&kio.ByteReader{Reader: bytes.NewReader(buf), OmitReaderAnnotations: true}},
+		Outputs: []kio.Writer{kio.ByteWriter{Writer: &out, ClearAnnotations: []string{kioutil.PathAnnotation}}},
+	}.Execute()
+	return out.Bytes(), err
 }
 
 // excludeKptFn adds an annotation "config.kubernetes.io/local-config: 'true'" to kpt function.
@@ -459,9 +520,8 @@ func (k *Deployer) getApplyDir(ctx context.Context) (string, error) {
 	}
 
 	if _, err := os.Stat(filepath.Join(kptHydrated, inventoryTemplate)); os.IsNotExist(err) {
-		cmd := exec.CommandContext(ctx, "kpt", kptCommandArgs(kptHydrated, []string{"live", "init"}, k.getKptLiveInitArgs(), nil)...)
-		if _, err := util.RunCmdOut(cmd); err != nil {
-			return "", err
+		if err := writeInventoryTemplate(kptHydrated, k.Live.Apply.InventoryID, k.Live.Apply.InventoryNamespace); err != nil {
+			return "", fmt.Errorf("writing inventory template: %w", err)
 		}
 	}
 
@@ -559,41 +619,3 @@ func (k *Deployer) getKptFnRunArgs() ([]string, error) {
 
 	return flags, nil
 }
-
-// getKptLiveApplyArgs returns a list of arguments that the user specified for the `kpt live apply` command.
-func (k *Deployer) getKptLiveApplyArgs() []string {
-	var flags []string
-
-	if len(k.Live.Options.PollPeriod) > 0 {
-		flags = append(flags, "--poll-period", k.Live.Options.PollPeriod)
-	}
-
-	if len(k.Live.Options.PrunePropagationPolicy) > 0 {
-		flags = append(flags, "--prune-propagation-policy", k.Live.Options.PrunePropagationPolicy)
-	}
-
-	if len(k.Live.Options.PruneTimeout) > 0 {
-		flags = append(flags, "--prune-timeout", k.Live.Options.PruneTimeout)
-	}
-
-	if len(k.Live.Options.ReconcileTimeout) > 0 {
-		flags = append(flags, "--reconcile-timeout", k.Live.Options.ReconcileTimeout)
-	}
-
-	return flags
-}
-
-// getKptLiveInitArgs returns a list of arguments that the user specified for the `kpt live init` command.
-func (k *Deployer) getKptLiveInitArgs() []string {
-	var flags []string
-
-	if len(k.Live.Apply.InventoryID) > 0 {
-		flags = append(flags, "--inventory-id", k.Live.Apply.InventoryID)
-	}
-
-	if len(k.Live.Apply.InventoryNamespace) > 0 {
-		flags = append(flags, "--namespace", k.Live.Apply.InventoryNamespace)
-	}
-
-	return flags
-}
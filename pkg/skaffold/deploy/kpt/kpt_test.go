@@ -46,65 +46,68 @@ spec:
    - image: gcr.io/project/image1
    name: image1
 `
+
+	testFn = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: set-annotations
+  annotations:
+    config.kubernetes.io/function: |
+      container:
+        image: gcr.io/kpt-fn/set-annotations:v0.1
+`
 )
 
 // Test that kpt deployer manipulate manifests in the given order and no intermediate data is
 // stored after each step:
-//	Step 1. `kp fn source` (read in the manifest as stdin),
-//  Step 2. `kustomize build` (hydrate the manifest),
-//  Step 3. `kpt fn run` (validate, transform or generate the manifests via kpt functions),
-//  Step 4. `kpt fn sink` (store the stdout in a given dir).
+//
+//	Step 1. read the manifests, like `kpt fn source` does,
+//	Step 2. `kustomize build` (hydrate the manifest),
+//	Step 3. `kpt fn run` (validate, transform or generate the manifests via kpt functions), if there are any,
+//	Step 4. write the manifests to the sink dir, like `kpt fn sink` does.
 func TestKpt_Deploy(t *testing.T) {
 	tests := []struct {
-		description    string
-		builds         []build.Artifact
-		kpt            latest.KptDeploy
-		kustomizations map[string]string
-		commands       util.Command
-		expected       []string
-		shouldErr      bool
+		description string
+		builds      []build.Artifact
+		kpt         latest.KptDeploy
+		files       map[string]string
+		commands    util.Command
+		liveErr     error
+		expected    []string
+		shouldErr   bool
 	}{
 		{
 			description: "no manifest",
 			kpt: latest.KptDeploy{
-				Dir: ".",
+				Dir: "config",
 			},
-			commands: testutil.
-				CmdRunOut("kpt fn source .", ``).
-				AndRunOut("kpt fn run --dry-run", ``),
+			files: map[string]string{"config/README.md": "no manifests"},
 		},
 		{
 			description: "invalid manifest",
 			kpt: latest.KptDeploy{
-				Dir: ".",
+				Dir: "config",
 			},
-			commands: testutil.
-				CmdRunOut("kpt fn source .", ``).
-				AndRunOut("kpt fn run --dry-run", `foo`).
-				AndRunOut("kpt fn sink .tmp-sink-dir", ``),
+			files:     map[string]string{"config/pod.yaml": "foo"},
 			shouldErr: true,
 		},
 		{
 			description: "invalid user specified applyDir",
 			kpt: latest.KptDeploy{
-				Dir: ".",
+				Dir: "config",
 				Live: latest.KptLive{
 					Apply: latest.KptApplyInventory{
 						Dir: "invalid_path",
 					},
 				},
 			},
-			commands: testutil.
-				CmdRunOut("kpt fn source .", ``).
-				AndRunOut("kpt fn run --dry-run", testPod).
-				AndRunOut("kpt fn sink .tmp-sink-dir", ``),
+			files:     map[string]string{"config/pod.yaml": testPod},
 			shouldErr: true,
 		},
-
 		{
 			description: "kustomization and specified kpt fn",
 			kpt: latest.KptDeploy{
-				Dir: ".",
+				Dir: "config",
 				Fn:  latest.KptFn{FnPath: "kpt-func.yaml"},
 				Live: latest.KptLive{
 					Apply: latest.KptApplyInventory{
@@ -112,33 +115,29 @@ func TestKpt_Deploy(t *testing.T) {
 					},
 				},
 			},
-			kustomizations: map[string]string{"Kustomization": `resources:
-				- foo.yaml`},
+			files: map[string]string{
+				"config/Kustomization": "resources:\n- pod.yaml",
+				"config/pod.yaml":      testPod,
+				"kpt-func.yaml":        testFn,
+			},
 			commands: testutil.
-				CmdRunOut("kpt fn source .", ``).
-				AndRunOut("kpt fn source kpt-func.yaml", ``).
-				AndRunOut(fmt.Sprintf("kpt fn sink %v", tmpKustomizeDir), ``).
-				AndRunOut(fmt.Sprintf("kustomize build %v", tmpKustomizeDir), ``).
-				AndRunOut("kpt fn run --dry-run", testPod).
-				AndRun("kpt live apply valid_path"),
+				CmdRunOut(fmt.Sprintf("kustomize build %v", tmpKustomizeDir), testPod).
+				AndRunOut("kpt fn run --dry-run", testPod),
 			expected: []string{"default"},
 		},
 		{
 			description: "kpt live apply fails",
 			kpt: latest.KptDeploy{
-				Dir: ".",
+				Dir: "config",
 			},
-			commands: testutil.
-				CmdRunOut("kpt fn source .", ``).
-				AndRunOut("kpt fn run --dry-run", testPod).
-				AndRunOut("kpt live init .kpt-hydrated", ``).
-				AndRunErr("kpt live apply .kpt-hydrated", errors.New("BUG")),
+			files:     map[string]string{"config/pod.yaml": testPod},
+			liveErr:   errors.New("BUG"),
 			shouldErr: true,
 		},
 		{
 			description: "user specifies reconcile timeout and poll period",
 			kpt: latest.KptDeploy{
-				Dir: ".",
+				Dir: "config",
 				Live: latest.KptLive{
 					Apply: latest.KptApplyInventory{
 						Dir: "valid_path",
@@ -149,15 +148,13 @@ func TestKpt_Deploy(t *testing.T) {
 					},
 				},
 			},
-			commands: testutil.
-				CmdRunOut("kpt fn source .", ``).
-				AndRunOut("kpt fn run --dry-run", testPod).
-				AndRun("kpt live apply valid_path --poll-period 5s --reconcile-timeout 2m"),
+			files:    map[string]string{"config/pod.yaml": testPod},
+			expected: []string{"default"},
 		},
 		{
 			description: "user specifies invalid reconcile timeout and poll period",
 			kpt: latest.KptDeploy{
-				Dir: ".",
+				Dir: "config",
 				Live: latest.KptLive{
 					Apply: latest.KptApplyInventory{
 						Dir: "valid_path",
@@ -168,15 +165,13 @@ func TestKpt_Deploy(t *testing.T) {
 					},
 				},
 			},
-			commands: testutil.
-				CmdRunOut("kpt fn source .", ``).
-				AndRunOut("kpt fn run --dry-run", testPod).
-				AndRun("kpt live apply valid_path --poll-period foo --reconcile-timeout bar"),
+			files:     map[string]string{"config/pod.yaml": testPod},
+			shouldErr: true,
 		},
 		{
 			description: "user specifies prune propagation policy and prune timeout",
 			kpt: latest.KptDeploy{
-				Dir: ".",
+				Dir: "config",
 				Live: latest.KptLive{
 					Apply: latest.KptApplyInventory{
 						Dir: "valid_path",
@@ -187,15 +182,13 @@ func TestKpt_Deploy(t *testing.T) {
 					},
 				},
 			},
-			commands: testutil.
-				CmdRunOut("kpt fn source .", ``).
-				AndRunOut("kpt fn run --dry-run", testPod).
-				AndRun("kpt live apply valid_path --prune-propagation-policy Orphan --prune-timeout 2m"),
+			files:    map[string]string{"config/pod.yaml": testPod},
+			expected: []string{"default"},
 		},
 		{
 			description: "user specifies invalid prune propagation policy and prune timeout",
 			kpt: latest.KptDeploy{
-				Dir: ".",
+				Dir: "config",
 				Live: latest.KptLive{
 					Apply: latest.KptApplyInventory{
 						Dir: "valid_path",
@@ -206,29 +199,72 @@ func TestKpt_Deploy(t *testing.T) {
 					},
 				},
 			},
-			commands: testutil.
-				CmdRunOut("kpt fn source .", ``).
-				AndRunOut("kpt fn run --dry-run", testPod).
-				AndRun("kpt live apply valid_path --prune-propagation-policy foo --prune-timeout bar"),
+			files:     map[string]string{"config/pod.yaml": testPod},
+			shouldErr: true,
 		},
 	}
 	for _, test := range tests {
-		sanityCheck = func(dir string, buf io.Writer) error { return nil }
 		testutil.Run(t, test.description, func(t *testutil.T) {
-			t.Override(&util.DefaultExecCommand, test.commands)
+			t.Override(&sanityCheck, func(string, bool, io.Writer) error { return nil })
+			if test.commands != nil {
+				t.Override(&util.DefaultExecCommand, test.commands)
+			}
+			t.Override(&newLiveClient, fakeLiveClientWith(&fakeLiveClient{err: test.liveErr}))
 			tmpDir := t.NewTempDir().Chdir()
 
-			tmpDir.WriteFiles(test.kustomizations)
+			tmpDir.WriteFiles(test.files)
 
-			k := NewDeployer(&kptConfig{}, nil, &test.kpt)
+			k := NewDeployer(&kptConfig{}, nil, &test.kpt, nil, nil)
 
 			if k.Live.Apply.Dir == "valid_path" {
-				// 0755 is a permission setting where the owner can read, write, and execute.
-				// Others can read and execute but not modify the directory.
-				os.Mkdir(k.Live.Apply.Dir, 0755)
+				tmpDir.Write("valid_path/"+inventoryTemplate, testInventory)
+			}
+
+			namespaces, err := k.Deploy(context.Background(), ioutil.Discard, test.builds)
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, namespaces)
+		})
+	}
+}
+
+func TestKpt_DeployWithoutKpt(t *testing.T) {
+	tests := []struct {
+		description string
+		kpt         latest.KptDeploy
+		files       map[string]string
+		commands    util.Command
+		shouldErr   bool
+	}{
+		{
+			description: "kpt isn't needed without functions",
+			kpt:         latest.KptDeploy{Dir: "config"},
+			files:       map[string]string{"config/pod.yaml": testPod},
+		},
+		{
+			description: "kpt is needed to run the functions of the configs",
+			kpt:         latest.KptDeploy{Dir: "config"},
+			files:       map[string]string{"config/pod.yaml": testPod, "config/fn.yaml": testFn},
+			commands:    testutil.CmdRunOutErr("kpt version", "", errors.New("not found")),
+			shouldErr:   true,
+		},
+		{
+			description: "kpt is needed to run a function image",
+			kpt:         latest.KptDeploy{Dir: "config", Fn: latest.KptFn{Image: "gcr.io/example.com/my-fn:v1.0.0"}},
+			files:       map[string]string{"config/pod.yaml": testPod},
+			commands:    testutil.CmdRunOutErr("kpt version", "", errors.New("not found")),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			if test.commands != nil {
+				t.Override(&util.DefaultExecCommand, test.commands)
 			}
+			t.Override(&newLiveClient, fakeLiveClientWith(&fakeLiveClient{}))
+			t.NewTempDir().WriteFiles(test.files).Chdir()
+
+			k := NewDeployer(&kptConfig{}, nil, &test.kpt, nil, nil)
+			_, err := k.Deploy(context.Background(), ioutil.Discard, nil)
 
-			_, err := k.Deploy(context.Background(), ioutil.Discard, test.builds)
 			t.CheckError(test.shouldErr, err)
 		})
 	}
@@ -360,7 +396,7 @@ func TestKpt_Dependencies(t *testing.T) {
 			tmpDir.WriteFiles(test.createFiles)
 			tmpDir.WriteFiles(test.kustomizations)
 
-			k := NewDeployer(&kptConfig{}, nil, &test.kpt)
+			k := NewDeployer(&kptConfig{}, nil, &test.kpt, nil, nil)
 
 			res, err := k.Dependencies()
 
@@ -373,8 +409,8 @@ func TestKpt_Cleanup(t *testing.T) {
 	tests := []struct {
 		description string
 		applyDir    string
-		globalFlags []string
-		commands    util.Command
+		inventory   bool
+		liveErr     error
 		shouldErr   bool
 	}{
 		{
@@ -385,31 +421,38 @@ func TestKpt_Cleanup(t *testing.T) {
 		{
 			description: "valid user specified applyDir w/o template resource",
 			applyDir:    "valid_path",
-			commands:    testutil.CmdRunErr("kpt live destroy valid_path", errors.New("BUG")),
 			shouldErr:   true,
 		},
 		{
-			description: "valid user specified applyDir w/ template resource (emulated)",
+			description: "valid user specified applyDir w/ template resource",
 			applyDir:    "valid_path",
-			commands:    testutil.CmdRun("kpt live destroy valid_path"),
+			inventory:   true,
+		},
+		{
+			description: "destroy fails",
+			applyDir:    "valid_path",
+			inventory:   true,
+			liveErr:     errors.New("BUG"),
+			shouldErr:   true,
 		},
 		{
 			description: "unspecified applyDir",
-			commands: testutil.
-				CmdRunOut("kpt live init .kpt-hydrated", "").
-				AndRun("kpt live destroy .kpt-hydrated"),
 		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
-			t.Override(&util.DefaultExecCommand, test.commands)
-			t.NewTempDir().Chdir()
+			client := &fakeLiveClient{err: test.liveErr}
+			t.Override(&newLiveClient, fakeLiveClientWith(client))
+			tmpDir := t.NewTempDir().Chdir()
 
 			if test.applyDir == "valid_path" {
 				// 0755 is a permission setting where the owner can read, write, and execute.
 				// Others can read and execute but not modify the directory.
 				os.Mkdir(test.applyDir, 0755)
 			}
+			if test.inventory {
+				tmpDir.Write("valid_path/"+inventoryTemplate, testInventory)
+			}
 
 			k := NewDeployer(&kptConfig{
 				workingDir: ".",
@@ -419,11 +462,14 @@ func TestKpt_Cleanup(t *testing.T) {
 						Dir: test.applyDir,
 					},
 				},
-			})
+			}, nil, nil)
 
 			err := k.Cleanup(context.Background(), ioutil.Discard)
 
 			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckTrue(client.destroyed != nil)
+			}
 		})
 	}
 }
@@ -472,14 +518,14 @@ spec:
 `
 
 	tests := []struct {
-		description    string
-		builds         []build.Artifact
-		labels         map[string]string
-		kpt            latest.KptDeploy
-		commands       util.Command
-		kustomizations map[string]string
-		expected       string
-		shouldErr      bool
+		description string
+		builds      []build.Artifact
+		labels      map[string]string
+		kpt         latest.KptDeploy
+		commands    util.Command
+		files       map[string]string
+		expected    string
+		shouldErr   bool
 	}{
 		{
 			description: "no fnPath or image specified",
@@ -492,10 +538,7 @@ spec:
 			kpt: latest.KptDeploy{
 				Dir: ".",
 			},
-			commands: testutil.
-				CmdRunOut("kpt fn source .", ``).
-				AndRunOut("kpt fn run --dry-run", output1).
-				AndRunOut("kpt fn sink .tmp-sink-dir", ``),
+			files: map[string]string{"pod.yaml": output1},
 			expected: `apiVersion: v1
 kind: Pod
 metadata:
@@ -523,11 +566,11 @@ spec:
 				Dir: "test",
 				Fn:  latest.KptFn{FnPath: "kpt-func.yaml"},
 			},
-			commands: testutil.
-				CmdRunOut("kpt fn source test", ``).
-				AndRunOut("kpt fn source kpt-func.yaml", ``).
-				AndRunOut("kpt fn run --dry-run", output3).
-				AndRunOut("kpt fn sink .tmp-sink-dir/test", ``),
+			files: map[string]string{
+				"test/pods.yaml": output3,
+				"kpt-func.yaml":  testFn,
+			},
+			commands: testutil.CmdRunOut("kpt fn run --dry-run", output3),
 			expected: `apiVersion: v1
 kind: Pod
 metadata:
@@ -567,10 +610,8 @@ spec:
 				Dir: ".",
 				Fn:  latest.KptFn{Image: "gcr.io/example.com/my-fn:v1.0.0 -- foo=bar"},
 			},
-			commands: testutil.
-				CmdRunOut("kpt fn source .", ``).
-				AndRunOut("kpt fn run --dry-run --image gcr.io/example.com/my-fn:v1.0.0 -- foo=bar", output2).
-				AndRunOut("kpt fn sink .tmp-sink-dir", ``),
+			files:    map[string]string{"pod.yaml": output2},
+			commands: testutil.CmdRunOut("kpt fn run --dry-run --image gcr.io/example.com/my-fn:v1.0.0 -- foo=bar", output2),
 			expected: `apiVersion: v1
 kind: Pod
 metadata:
@@ -594,11 +635,10 @@ spec:
 			labels: map[string]string{"user/label": "test"},
 			kpt: latest.KptDeploy{
 				Dir: ".",
+				Fn:  latest.KptFn{Image: "gcr.io/example.com/my-fn:v1.0.0"},
 			},
-			commands: testutil.
-				CmdRunOut("kpt fn source .", ``).
-				AndRunOut("kpt fn run --dry-run", ``).
-				AndRunOut("kpt fn sink .tmp-sink-dir", ``),
+			files:    map[string]string{"pod.yaml": output1},
+			commands: testutil.CmdRunOut("kpt fn run --dry-run --image gcr.io/example.com/my-fn:v1.0.0", ``),
 			expected: "\n",
 		},
 		{
@@ -609,11 +649,6 @@ spec:
 					FnPath: "kpt-func.yaml",
 					Image:  "gcr.io/example.com/my-fn:v1.0.0 -- foo=bar"},
 			},
-			commands: testutil.
-				CmdRunOut("kpt fn source .", ``).
-				AndRunOut("kpt fn source kpt-func.yaml", ``).
-				AndRunOut("kpt fn run --dry-run --image gcr.io/example.com/my-fn:v1.0.0 -- foo=bar", ``).
-				AndRunOut("kpt fn sink .tmp-sink-dir", ``),
 			shouldErr: true,
 		},
 		{
@@ -627,13 +662,11 @@ spec:
 			kpt: latest.KptDeploy{
 				Dir: ".",
 			},
-			commands: testutil.
-				CmdRunOut("kpt fn source .", ``).
-				AndRunOut(fmt.Sprintf("kpt fn sink %v", tmpKustomizeDir), ``).
-				AndRunOut(fmt.Sprintf("kustomize build %v", tmpKustomizeDir), ``).
-				AndRunOut("kpt fn run --dry-run", output1),
-			kustomizations: map[string]string{"kustomization.yaml": `resources:
-- foo.yaml`},
+			files: map[string]string{
+				"kustomization.yaml": "resources:\n- foo.yaml",
+				"foo.yaml":           output1,
+			},
+			commands: testutil.CmdRunOut(fmt.Sprintf("kustomize build %v", tmpKustomizeDir), output1),
 			expected: `apiVersion: v1
 kind: Pod
 metadata:
@@ -647,23 +680,17 @@ spec:
 		{
 			description: "reading configs from sourceDir fails",
 			kpt: latest.KptDeploy{
-				Dir: ".",
+				Dir: "missing",
 			},
-			commands: testutil.
-				CmdRunOutErr("kpt fn source .", ``, errors.New("BUG")).
-				AndRunOut("kpt fn run --dry-run", "invalid pipeline").
-				AndRunOut("kpt fn sink .tmp-sink-dir", ``),
 			shouldErr: true,
 		},
 		{
 			description: "outputting configs to sinkDir fails",
 			kpt: latest.KptDeploy{
-				Dir: ".",
+				Dir: "config",
+				Fn:  latest.KptFn{SinkDir: "file/sink"},
 			},
-			commands: testutil.
-				CmdRunOut("kpt fn source .", ``).
-				AndRunOut("kpt fn run --dry-run", "invalid pipeline").
-				AndRunOutErr("kpt fn sink .tmp-sink-dir", ``, errors.New("BUG")),
+			files:     map[string]string{"config/pod.yaml": output1, "file": ""},
 			shouldErr: true,
 		},
 		{
@@ -677,25 +704,20 @@ spec:
 			kpt: latest.KptDeploy{
 				Dir: ".",
 			},
-			commands: testutil.
-				CmdRunOut("kpt fn source .", ``).
-				AndRunOut(fmt.Sprintf("kpt fn sink %v", tmpKustomizeDir), ``).
-				AndRunOutErr(fmt.Sprintf("kustomize build %v", tmpKustomizeDir), ``, errors.New("BUG")).
-				AndRunOut("kpt fn run --dry-run", output1).
-				AndRunOut("kpt fn sink .tmp-sink-dir", ``),
-			kustomizations: map[string]string{"kustomization.yaml": `resources:
-- foo.yaml`},
+			files: map[string]string{
+				"kustomization.yaml": "resources:\n- foo.yaml",
+				"foo.yaml":           output1,
+			},
+			commands:  testutil.CmdRunOutErr(fmt.Sprintf("kustomize build %v", tmpKustomizeDir), ``, errors.New("BUG")),
 			shouldErr: true,
 		},
 		{
 			description: "kpt fn run fails",
 			kpt: latest.KptDeploy{
 				Dir: ".",
+				Fn:  latest.KptFn{Image: "gcr.io/example.com/my-fn:v1.0.0"},
 			},
-			commands: testutil.
-				CmdRunOut("kpt fn source .", ``).
-				AndRunOutErr("kpt fn run --dry-run", "invalid pipeline", errors.New("BUG")).
-				AndRunOut("kpt fn sink .tmp-sink-dir", ``),
+			commands:  testutil.CmdRunOutErr("kpt fn run --dry-run --image gcr.io/example.com/my-fn:v1.0.0", "invalid pipeline", errors.New("BUG")),
 			shouldErr: true,
 		},
 		{
@@ -707,10 +729,7 @@ spec:
 					GlobalScope: true,
 				},
 			},
-			commands: testutil.
-				CmdRunOut("kpt fn source .", ``).
-				AndRunOut("kpt fn run --dry-run --global-scope --image gcr.io/example.com/my-fn:v1.0.0 -- foo=bar", ``).
-				AndRunOut("kpt fn sink .tmp-sink-dir", ``),
+			commands: testutil.CmdRunOut("kpt fn run --dry-run --global-scope --image gcr.io/example.com/my-fn:v1.0.0 -- foo=bar", ``),
 			expected: "\n",
 		},
 		{
@@ -722,10 +741,7 @@ spec:
 					Mount: []string{"type=bind", "src=$(pwd)", "dst=/source"},
 				},
 			},
-			commands: testutil.
-				CmdRunOut("kpt fn source .", ``).
-				AndRunOut("kpt fn run --dry-run --mount type=bind,src=$(pwd),dst=/source --image gcr.io/example.com/my-fn:v1.0.0 -- foo=bar", ``).
-				AndRunOut("kpt fn sink .tmp-sink-dir", ``),
+			commands: testutil.CmdRunOut("kpt fn run --dry-run --mount type=bind,src=$(pwd),dst=/source --image gcr.io/example.com/my-fn:v1.0.0 -- foo=bar", ``),
 			expected: "\n",
 		},
 		{
@@ -737,10 +753,7 @@ spec:
 					Mount: []string{"foo", "", "bar"},
 				},
 			},
-			commands: testutil.
-				CmdRunOut("kpt fn source .", ``).
-				AndRunOut("kpt fn run --dry-run --mount foo,,bar --image gcr.io/example.com/my-fn:v1.0.0 -- foo=bar", ``).
-				AndRunOut("kpt fn sink .tmp-sink-dir", ``),
+			commands: testutil.CmdRunOut("kpt fn run --dry-run --mount foo,,bar --image gcr.io/example.com/my-fn:v1.0.0 -- foo=bar", ``),
 			expected: "\n",
 		},
 		{
@@ -753,23 +766,23 @@ spec:
 					NetworkName: "foo",
 				},
 			},
-			commands: testutil.
-				CmdRunOut("kpt fn source .", ``).
-				AndRunOut("kpt fn run --dry-run --network --network-name foo --image gcr.io/example.com/my-fn:v1.0.0 -- foo=bar", ``).
-				AndRunOut("kpt fn sink .tmp-sink-dir", ``),
+			commands: testutil.CmdRunOut("kpt fn run --dry-run --network --network-name foo --image gcr.io/example.com/my-fn:v1.0.0 -- foo=bar", ``),
 			expected: "\n",
 		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
-			t.Override(&util.DefaultExecCommand, test.commands)
+			t.Override(&sanityCheck, func(string, bool, io.Writer) error { return nil })
+			if test.commands != nil {
+				t.Override(&util.DefaultExecCommand, test.commands)
+			}
 			tmpDir := t.NewTempDir().Chdir()
 
-			tmpDir.WriteFiles(test.kustomizations)
+			tmpDir.WriteFiles(test.files)
 
 			k := NewDeployer(&kptConfig{
 				workingDir: ".",
-			}, test.labels, &test.kpt)
+			}, test.labels, &test.kpt, nil, nil)
 
 			var b bytes.Buffer
 			err := k.Render(context.Background(), &b, test.builds, true, "")
@@ -781,11 +794,11 @@ spec:
 
 func TestKpt_GetApplyDir(t *testing.T) {
 	tests := []struct {
-		description string
-		live        latest.KptLive
-		expected    string
-		commands    util.Command
-		shouldErr   bool
+		description       string
+		live              latest.KptLive
+		expected          string
+		expectedInventory string
+		shouldErr         bool
 	}{
 		{
 			description: "specified an invalid applyDir",
@@ -806,9 +819,9 @@ func TestKpt_GetApplyDir(t *testing.T) {
 			expected: "valid_path",
 		},
 		{
-			description: "unspecified applyDir",
-			expected:    ".kpt-hydrated",
-			commands:    testutil.CmdRunOut("kpt live init .kpt-hydrated", ""),
+			description:       "unspecified applyDir",
+			expected:          ".kpt-hydrated",
+			expectedInventory: "default",
 		},
 		{
 			description: "unspecified applyDir with specified inventory-id and namespace",
@@ -818,8 +831,8 @@ func TestKpt_GetApplyDir(t *testing.T) {
 					InventoryNamespace: "foo",
 				},
 			},
-			expected: ".kpt-hydrated",
-			commands: testutil.CmdRunOut("kpt live init .kpt-hydrated --inventory-id 1a23bcde-4f56-7891-a2bc-de34fabcde5f6 --namespace foo", ""),
+			expected:          ".kpt-hydrated",
+			expectedInventory: "foo",
 		},
 		{
 			description: "existing template resource in .kpt-hydrated",
@@ -828,7 +841,6 @@ func TestKpt_GetApplyDir(t *testing.T) {
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
-			t.Override(&util.DefaultExecCommand, test.commands)
 			tmpDir := t.NewTempDir().Chdir()
 
 			if test.live.Apply.Dir == test.expected {
@@ -838,18 +850,26 @@ func TestKpt_GetApplyDir(t *testing.T) {
 			}
 
 			if test.description == "existing template resource in .kpt-hydrated" {
-				tmpDir.Touch(".kpt-hydrated/inventory-template.yaml")
+				tmpDir.Write(".kpt-hydrated/inventory-template.yaml", testInventory)
 			}
 
 			k := NewDeployer(&kptConfig{
 				workingDir: ".",
 			}, nil, &latest.KptDeploy{
 				Live: test.live,
-			})
+			}, nil, nil)
 
 			applyDir, err := k.getApplyDir(context.Background())
 
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, applyDir)
+			if test.expectedInventory != "" {
+				inv, _, err := readInventory(applyDir)
+				t.CheckNoError(err)
+				t.CheckDeepEqual(test.expectedInventory, inv.Namespace())
+				if test.live.Apply.InventoryID != "" {
+					t.CheckDeepEqual(test.live.Apply.InventoryID, inv.ID())
+				}
+			}
 		})
 	}
 }
@@ -1002,7 +1022,7 @@ spec:
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
-			k := NewDeployer(&kptConfig{}, nil, nil)
+			k := NewDeployer(&kptConfig{}, nil, nil, nil, nil)
 			actualManifest, err := k.excludeKptFn(test.manifests)
 			t.CheckErrorAndDeepEqual(false, err, test.expected.String(), actualManifest.String())
 		})
@@ -1014,10 +1034,23 @@ func TestVersionCheck(t *testing.T) {
 		description    string
 		commands       util.Command
 		kustomizations map[string]string
+		withoutFns     bool
 		shouldErr      bool
 		error          error
 		out            string
 	}{
+		{
+			description: "kpt isn't required without functions",
+			withoutFns:  true,
+		},
+		{
+			description: "kpt isn't required without functions, kustomize version is good",
+			commands: testutil.
+				CmdRunOut("kustomize version", `{Version:v3.6.1 GitCommit:a0072a2cf92bf5399565e84c621e1e7c5c1f1094 BuildDate:2020-06-15T20:19:07Z GoOs:darwin GoArch:amd64}`),
+			kustomizations: map[string]string{"Kustomization": `resources:
+				- foo.yaml`},
+			withoutFns: true,
+		},
 		{
 			description: "Both kpt and kustomize versions are good",
 			commands: testutil.
@@ -1115,10 +1148,12 @@ func TestVersionCheck(t *testing.T) {
 	for _, test := range tests {
 		var buf bytes.Buffer
 		testutil.Run(t, test.description, func(t *testutil.T) {
-			t.Override(&util.DefaultExecCommand, test.commands)
+			if test.commands != nil {
+				t.Override(&util.DefaultExecCommand, test.commands)
+			}
 			tmpDir := t.NewTempDir().Chdir()
 			tmpDir.WriteFiles(test.kustomizations)
-			err := versionCheck("", io.Writer(&buf))
+			err := versionCheck("", !test.withoutFns, io.Writer(&buf))
 			t.CheckError(test.shouldErr, err)
 		})
 		testutil.CheckError(t, test.shouldErr, test.error)
@@ -1134,3 +1169,33 @@ type kptConfig struct {
 func (c *kptConfig) WorkingDir() string       { return c.workingDir }
 func (c *kptConfig) GetKubeContext() string   { return kubectl.TestKubeContext }
 func (c *kptConfig) GetKubeNamespace() string { return kubectl.TestNamespace }
+
+func TestKpt_CheckHermetic(t *testing.T) {
+	tests := []struct {
+		description string
+		fn          latest.KptFn
+		shouldErr   bool
+	}{
+		{
+			description: "local functions",
+			fn:          latest.KptFn{FnPath: "fns"},
+		},
+		{
+			description: "function image",
+			fn:          latest.KptFn{Image: "gcr.io/example/fn"},
+			shouldErr:   true,
+		},
+		{
+			description: "network access",
+			fn:          latest.KptFn{FnPath: "fns", Network: true},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			err := CheckHermetic(&latest.KptDeploy{Dir: ".", Fn: test.fn})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
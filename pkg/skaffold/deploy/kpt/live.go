@@ -0,0 +1,347 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kpt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"sigs.k8s.io/cli-utils/pkg/apply"
+	applyevent "sigs.k8s.io/cli-utils/pkg/apply/event"
+	"sigs.k8s.io/cli-utils/pkg/common"
+	"sigs.k8s.io/cli-utils/pkg/inventory"
+	"sigs.k8s.io/cli-utils/pkg/kstatus/status"
+	"sigs.k8s.io/cli-utils/pkg/object"
+	"sigs.k8s.io/cli-utils/pkg/provider"
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+// liveClient applies and deletes the resources of an inventory with the cli-utils libraries,
+// which `kpt live` is built on.
+type liveClient interface {
+	Apply(ctx context.Context, inv inventory.InventoryInfo, objs []*unstructured.Unstructured, opts apply.Options) <-chan applyevent.Event
+	Destroy(inv inventory.InventoryInfo) <-chan applyevent.Event
+}
+
+// For testing
+var newLiveClient = newCLIUtilsClient
+
+type cliUtilsClient struct {
+	provider provider.Provider
+}
+
+func newCLIUtilsClient(kubeContext, kubeConfig string) liveClient {
+	flags := genericclioptions.NewConfigFlags(true)
+	if kubeContext != "" {
+		flags.Context = &kubeContext
+	}
+	if kubeConfig != "" {
+		flags.KubeConfig = &kubeConfig
+	}
+	factory := cmdutil.NewFactory(cmdutil.NewMatchVersionFlags(flags))
+
+	return &cliUtilsClient{provider: provider.NewProvider(factory)}
+}
+
+func (c *cliUtilsClient) Apply(ctx context.Context, inv inventory.InventoryInfo, objs []*unstructured.Unstructured, opts apply.Options) <-chan applyevent.Event {
+	applier := apply.NewApplier(c.provider)
+	if err := applier.Initialize(); err != nil {
+		return errorEvent(err)
+	}
+	return applier.Run(ctx, inv, objs, opts)
+}
+
+func (c *cliUtilsClient) Destroy(inv inventory.InventoryInfo) <-chan applyevent.Event {
+	destroyer := apply.NewDestroyer(c.provider)
+	if err := destroyer.Initialize(); err != nil {
+		return errorEvent(err)
+	}
+	return destroyer.Run(inv, &apply.DestroyerOption{InventoryPolicy: inventory.AdoptIfNoInventory})
+}
+
+func errorEvent(err error) <-chan applyevent.Event {
+	events := make(chan applyevent.Event, 1)
+	events <- applyevent.Event{Type: applyevent.ErrorType, ErrorEvent: applyevent.ErrorEvent{Err: err}}
+	close(events)
+	return events
+}
+
+// liveApply applies the resources of the applyDir, prunes the resources of the inventory that were removed
+// and waits for the applied resources to reconcile.
+func (k *Deployer) liveApply(ctx context.Context, out io.Writer, applyDir string) error {
+	opts, err := k.liveApplyOptions()
+	if err != nil {
+		return err
+	}
+	inv, objs, err := readInventory(applyDir)
+	if err != nil {
+		return err
+	}
+
+	client := newLiveClient(k.kubeContext, k.kubeConfig)
+	return printLiveEvents(out, client.Apply(ctx, inv, objs, opts))
+}
+
+// liveDestroy deletes all the resources of the inventory.
+func (k *Deployer) liveDestroy(out io.Writer, applyDir string) error {
+	inv, _, err := readInventory(applyDir)
+	if err != nil {
+		return err
+	}
+
+	client := newLiveClient(k.kubeContext, k.kubeConfig)
+	return printLiveEvents(out, client.Destroy(inv))
+}
+
+// liveApplyOptions reads the `live.options` of the configuration.
+func (k *Deployer) liveApplyOptions() (apply.Options, error) {
+	opts := apply.Options{
+		EmitStatusEvents: true,
+		InventoryPolicy:  inventory.AdoptIfNoInventory,
+	}
+
+	var err error
+	live := k.Live.Options
+	if opts.PollInterval, err = parseDuration("pollPeriod", live.PollPeriod); err != nil {
+		return opts, err
+	}
+	if opts.PruneTimeout, err = parseDuration("pruneTimeout", live.PruneTimeout); err != nil {
+		return opts, err
+	}
+	if opts.ReconcileTimeout, err = parseDuration("reconcileTimeout", live.ReconcileTimeout); err != nil {
+		return opts, err
+	}
+
+	switch policy := metav1.DeletionPropagation(live.PrunePropagationPolicy); policy {
+	case "":
+	case metav1.DeletePropagationBackground, metav1.DeletePropagationForeground, metav1.DeletePropagationOrphan:
+		opts.PrunePropagationPolicy = policy
+	default:
+		return opts, fmt.Errorf("invalid prunePropagationPolicy %q, it should be Background, Foreground or Orphan", policy)
+	}
+	return opts, nil
+}
+
+func parseDuration(field, value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", field, value, err)
+	}
+	return d, nil
+}
+
+// readInventory reads the resources of the applyDir and splits out the inventory object,
+// the ConfigMap that records the applied resources in the cluster.
+func readInventory(applyDir string) (inventory.InventoryInfo, []*unstructured.Unstructured, error) {
+	files, err := getResources(applyDir)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading %s: %w", applyDir, err)
+	}
+
+	var objs []*unstructured.Unstructured
+	for _, file := range files {
+		buf, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, nil, err
+		}
+		manifests, err := manifest.Load(strings.NewReader(string(buf)))
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading %s: %w", file, err)
+		}
+		for _, m := range manifests {
+			jsonBytes, err := k8syaml.YAMLToJSON(m)
+			if err != nil {
+				return nil, nil, fmt.Errorf("reading %s: %w", file, err)
+			}
+			obj := &unstructured.Unstructured{}
+			if err := obj.UnmarshalJSON(jsonBytes); err != nil {
+				return nil, nil, fmt.Errorf("reading %s: %w", file, err)
+			}
+			objs = append(objs, obj)
+		}
+	}
+
+	inv, objs, err := inventory.SplitUnstructureds(objs)
+	var noInventory inventory.NoInventoryObjError
+	if errors.As(err, &noInventory) {
+		return nil, nil, fmt.Errorf("no inventory template found in %s. Remove `live.apply.dir` to let Skaffold create one, or run `kpt live init %s`", applyDir, applyDir)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return inventory.WrapInventoryInfoObj(inv), objs, nil
+}
+
+// writeInventoryTemplate writes the inventory template that `kpt live init` would write.
+func writeInventoryTemplate(dir, id, namespace string) error {
+	if id == "" {
+		id = uuid.New().String()
+	}
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	template := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":      fmt.Sprintf("inventory-%08d", rand.Intn(100000000)),
+			"namespace": namespace,
+			"labels": map[string]string{
+				common.InventoryLabel: id,
+			},
+		},
+	}
+	buf, err := yaml.Marshal(template)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, inventoryTemplate), buf, 0644)
+}
+
+// printLiveEvents prints the progress of an apply or a destroy, reports the status of the resources
+// as Skaffold events and returns the first error.
+func printLiveEvents(out io.Writer, events <-chan applyevent.Event) error {
+	var failures []string
+	for e := range events {
+		switch e.Type {
+		case applyevent.ErrorType:
+			// Drain the events so that cli-utils doesn't block
+			for range events {
+			}
+			return e.ErrorEvent.Err
+
+		case applyevent.ApplyType:
+			if e.ApplyEvent.Type != applyevent.ApplyEventResourceUpdate {
+				continue
+			}
+			name := resourceName(e.ApplyEvent.Identifier)
+			if e.ApplyEvent.Error != nil {
+				fmt.Fprintf(out, "%s apply failed: %v\n", name, e.ApplyEvent.Error)
+				failures = append(failures, name)
+				continue
+			}
+			fmt.Fprintf(out, "%s %s\n", name, applyOperation(e.ApplyEvent.Operation))
+
+		case applyevent.StatusType:
+			if e.StatusEvent.Type != applyevent.StatusEventResourceUpdate || e.StatusEvent.Resource == nil {
+				continue
+			}
+			resource := e.StatusEvent.Resource
+			fmt.Fprintf(out, "%s is %s: %s\n", resourceName(resource.Identifier), resource.Status, resource.Message)
+			sendStatusEvent(resource.Identifier, resource.Status, resource.Message)
+
+		case applyevent.PruneType:
+			if e.PruneEvent.Type != applyevent.PruneEventResourceUpdate {
+				continue
+			}
+			name := resourceName(e.PruneEvent.Identifier)
+			switch {
+			case e.PruneEvent.Error != nil:
+				fmt.Fprintf(out, "%s prune failed: %v\n", name, e.PruneEvent.Error)
+				failures = append(failures, name)
+			case e.PruneEvent.Operation == applyevent.PruneSkipped:
+				fmt.Fprintf(out, "%s prune skipped\n", name)
+			default:
+				fmt.Fprintf(out, "%s pruned\n", name)
+			}
+
+		case applyevent.DeleteType:
+			if e.DeleteEvent.Type != applyevent.DeleteEventResourceUpdate {
+				continue
+			}
+			name := resourceName(e.DeleteEvent.Identifier)
+			switch {
+			case e.DeleteEvent.Error != nil:
+				fmt.Fprintf(out, "%s delete failed: %v\n", name, e.DeleteEvent.Error)
+				failures = append(failures, name)
+			case e.DeleteEvent.Operation == applyevent.DeleteSkipped:
+				fmt.Fprintf(out, "%s delete skipped\n", name)
+			default:
+				fmt.Fprintf(out, "%s deleted\n", name)
+			}
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to reconcile %s", strings.Join(failures, ", "))
+	}
+	return nil
+}
+
+func applyOperation(op applyevent.ApplyEventOperation) string {
+	switch op {
+	case applyevent.ServersideApplied:
+		return "serverside-applied"
+	case applyevent.Created:
+		return "created"
+	case applyevent.Unchanged:
+		return "unchanged"
+	case applyevent.Configured:
+		return "configured"
+	default:
+		return "failed"
+	}
+}
+
+// resourceName formats an identifier like kubectl does, for example `deployment.apps/web`.
+func resourceName(id object.ObjMetadata) string {
+	kind := strings.ToLower(id.GroupKind.Kind)
+	if id.GroupKind.Group != "" {
+		kind += "." + id.GroupKind.Group
+	}
+	return kind + "/" + id.Name
+}
+
+// sendStatusEvent reports the status of a resource like the status check does.
+func sendStatusEvent(id object.ObjMetadata, s status.Status, message string) {
+	resource := fmt.Sprintf("%s/%s", strings.ToLower(id.GroupKind.Kind), id.Name)
+	if id.Namespace != "" && id.Namespace != "default" {
+		resource = id.Namespace + ":" + resource
+	}
+
+	switch s {
+	case status.CurrentStatus:
+		event.ResourceStatusCheckEventCompleted(resource, proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS, Message: message}, "")
+	case status.FailedStatus:
+		event.ResourceStatusCheckEventCompleted(resource, proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_UNHEALTHY, Message: message}, "")
+	case status.InProgressStatus:
+		event.ResourceStatusCheckEventUpdated(resource, proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING, Message: message}, "")
+	default:
+		event.ResourceStatusCheckEventUpdated(resource, proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN, Message: message}, "")
+	}
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kpt
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/cli-utils/pkg/apply"
+	applyevent "sigs.k8s.io/cli-utils/pkg/apply/event"
+	"sigs.k8s.io/cli-utils/pkg/inventory"
+	"sigs.k8s.io/cli-utils/pkg/object"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const testInventory = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: inventory-12345678
+  namespace: default
+  labels:
+    cli-utils.sigs.k8s.io/inventory-id: 0123-4567
+`
+
+type fakeLiveClient struct {
+	err       error
+	inventory inventory.InventoryInfo
+	objs      []*unstructured.Unstructured
+	opts      apply.Options
+	destroyed inventory.InventoryInfo
+}
+
+func (f *fakeLiveClient) Apply(_ context.Context, inv inventory.InventoryInfo, objs []*unstructured.Unstructured, opts apply.Options) <-chan applyevent.Event {
+	f.inventory, f.objs, f.opts = inv, objs, opts
+	return f.events()
+}
+
+func (f *fakeLiveClient) Destroy(inv inventory.InventoryInfo) <-chan applyevent.Event {
+	f.destroyed = inv
+	return f.events()
+}
+
+func (f *fakeLiveClient) events() <-chan applyevent.Event {
+	if f.err != nil {
+		return errorEvent(f.err)
+	}
+	events := make(chan applyevent.Event)
+	close(events)
+	return events
+}
+
+func fakeLiveClientWith(client *fakeLiveClient) func(string, string) liveClient {
+	return func(string, string) liveClient { return client }
+}
+
+func TestLiveApply(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		client := &fakeLiveClient{}
+		t.Override(&newLiveClient, fakeLiveClientWith(client))
+		tmpDir := t.NewTempDir().
+			Write(inventoryTemplate, testInventory).
+			Write("resources.yaml", testPod)
+
+		k := NewDeployer(&kptConfig{}, nil, &latest.KptDeploy{
+			Live: latest.KptLive{
+				Options: latest.KptApplyOptions{
+					PollPeriod:             "5s",
+					PrunePropagationPolicy: "Orphan",
+					PruneTimeout:           "2m",
+				},
+			},
+		}, nil, nil)
+		err := k.liveApply(context.Background(), &bytes.Buffer{}, tmpDir.Root())
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual("0123-4567", client.inventory.ID())
+		t.CheckDeepEqual(1, len(client.objs))
+		t.CheckDeepEqual("Pod", client.objs[0].GetKind())
+		t.CheckDeepEqual(5*time.Second, client.opts.PollInterval)
+		t.CheckDeepEqual(2*time.Minute, client.opts.PruneTimeout)
+		t.CheckDeepEqual(inventory.AdoptIfNoInventory, client.opts.InventoryPolicy)
+	})
+}
+
+func TestLiveApplyOptions(t *testing.T) {
+	tests := []struct {
+		description string
+		options     latest.KptApplyOptions
+		shouldErr   bool
+	}{
+		{
+			description: "defaults",
+		},
+		{
+			description: "valid options",
+			options:     latest.KptApplyOptions{PollPeriod: "1s", ReconcileTimeout: "1m", PruneTimeout: "1m", PrunePropagationPolicy: "Foreground"},
+		},
+		{
+			description: "invalid poll period",
+			options:     latest.KptApplyOptions{PollPeriod: "foo"},
+			shouldErr:   true,
+		},
+		{
+			description: "invalid reconcile timeout",
+			options:     latest.KptApplyOptions{ReconcileTimeout: "bar"},
+			shouldErr:   true,
+		},
+		{
+			description: "invalid prune propagation policy",
+			options:     latest.KptApplyOptions{PrunePropagationPolicy: "foo"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			k := NewDeployer(&kptConfig{}, nil, &latest.KptDeploy{Live: latest.KptLive{Options: test.options}}, nil, nil)
+
+			_, err := k.liveApplyOptions()
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestPrintLiveEvents(t *testing.T) {
+	deployment := object.ObjMetadata{Name: "web", Namespace: "default", GroupKind: schema.GroupKind{Group: "apps", Kind: "Deployment"}}
+	service := object.ObjMetadata{Name: "web", Namespace: "default", GroupKind: schema.GroupKind{Kind: "Service"}}
+
+	tests := []struct {
+		description string
+		events      []applyevent.Event
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "apply and prune",
+			events: []applyevent.Event{
+				{Type: applyevent.ApplyType, ApplyEvent: applyevent.ApplyEvent{Type: applyevent.ApplyEventResourceUpdate, Identifier: deployment, Operation: applyevent.Created}},
+				{Type: applyevent.ApplyType, ApplyEvent: applyevent.ApplyEvent{Type: applyevent.ApplyEventCompleted}},
+				{Type: applyevent.PruneType, PruneEvent: applyevent.PruneEvent{Type: applyevent.PruneEventResourceUpdate, Identifier: service, Operation: applyevent.Pruned}},
+			},
+			expected: "deployment.apps/web created\nservice/web pruned\n",
+		},
+		{
+			description: "delete",
+			events: []applyevent.Event{
+				{Type: applyevent.DeleteType, DeleteEvent: applyevent.DeleteEvent{Type: applyevent.DeleteEventResourceUpdate, Identifier: service, Operation: applyevent.DeleteSkipped}},
+				{Type: applyevent.DeleteType, DeleteEvent: applyevent.DeleteEvent{Type: applyevent.DeleteEventResourceUpdate, Identifier: deployment, Operation: applyevent.Deleted}},
+			},
+			expected: "service/web delete skipped\ndeployment.apps/web deleted\n",
+		},
+		{
+			description: "failed apply",
+			events: []applyevent.Event{
+				{Type: applyevent.ApplyType, ApplyEvent: applyevent.ApplyEvent{Type: applyevent.ApplyEventResourceUpdate, Identifier: deployment, Error: errors.New("forbidden")}},
+			},
+			expected:  "deployment.apps/web apply failed: forbidden\n",
+			shouldErr: true,
+		},
+		{
+			description: "error",
+			events: []applyevent.Event{
+				{Type: applyevent.ErrorType, ErrorEvent: applyevent.ErrorEvent{Err: errors.New("timeout")}},
+				{Type: applyevent.ApplyType, ApplyEvent: applyevent.ApplyEvent{Type: applyevent.ApplyEventResourceUpdate, Identifier: deployment, Operation: applyevent.Created}},
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			events := make(chan applyevent.Event, len(test.events))
+			for _, e := range test.events {
+				events <- e
+			}
+			close(events)
+
+			var out bytes.Buffer
+			err := printLiveEvents(&out, events)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expected, out.String())
+		})
+	}
+}
+
+func TestReadInventory(t *testing.T) {
+	testutil.Run(t, "no inventory", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Write("resources.yaml", testPod)
+
+		_, _, err := readInventory(tmpDir.Root())
+
+		t.CheckErrorContains("no inventory template found", err)
+	})
+}
@@ -53,11 +53,17 @@ type Deployer struct {
 	insecureRegistries map[string]bool
 	labels             map[string]string
 	skipRender         bool
+	// transformer applies the transforms of the deploy config, if any.
+	transformer *manifest.Transformer
+
+	// validator checks the manifests before they are applied, if validation is configured.
+	validator *manifest.Validator
 }
 
 // NewDeployer returns a new Deployer for a DeployConfig filled
-// with the needed configuration for `kubectl apply`
-func NewDeployer(cfg Config, labels map[string]string, d *latest.KubectlDeploy) (*Deployer, error) {
+// with the needed configuration for `kubectl apply`.
+// The manifests are transformed by `transformer` and checked by `validator` before they are applied, unless they're nil.
+func NewDeployer(cfg Config, labels map[string]string, d *latest.KubectlDeploy, transformer *manifest.Transformer, validator *manifest.Validator) (*Deployer, error) {
 	defaultNamespace := ""
 	if d.DefaultNamespace != nil {
 		var err error
@@ -76,6 +82,8 @@ func NewDeployer(cfg Config, labels map[string]string, d *latest.KubectlDeploy)
 		insecureRegistries: cfg.GetInsecureRegistries(),
 		skipRender:         cfg.SkipRender(),
 		labels:             labels,
+		transformer:        transformer,
+		validator:          validator,
 	}, nil
 }
 
@@ -95,6 +103,12 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 		return nil, err
 	}
 
+	if k.validator != nil {
+		if err := k.validator.Validate(manifests); err != nil {
+			return nil, fmt.Errorf("validating manifests: %w", err)
+		}
+	}
+
 	if len(manifests) == 0 {
 		return nil, nil
 	}
@@ -236,6 +250,20 @@ func (k *Deployer) Render(ctx context.Context, out io.Writer, builds []build.Art
 	return manifest.Write(manifests.String(), filepath, out)
 }
 
+// CheckHermetic returns an error if the manifests can't be rendered without network access,
+// because they need to be downloaded or read from a cluster.
+func CheckHermetic(d *latest.KubectlDeploy) error {
+	for _, m := range d.Manifests {
+		if util.IsURL(m) || strings.HasPrefix(m, "gs://") {
+			return fmt.Errorf("manifest %q needs to be downloaded", m)
+		}
+	}
+	if len(d.RemoteManifests) > 0 {
+		return fmt.Errorf("remote manifests %v need to be read from the cluster", d.RemoteManifests)
+	}
+	return nil
+}
+
 func (k *Deployer) renderManifests(ctx context.Context, out io.Writer, builds []build.Artifact, offline bool) (manifest.ManifestList, error) {
 	if err := k.kubectl.CheckVersion(ctx); err != nil {
 		color.Default.Fprintln(out, "kubectl client version:", k.kubectl.Version(ctx))
@@ -262,7 +290,7 @@ func (k *Deployer) renderManifests(ctx context.Context, out io.Writer, builds []
 	}
 
 	if len(k.originalImages) == 0 {
-		k.originalImages, err = manifests.GetImages()
+		k.originalImages, err = manifests.GetImages(k.transformer.ResourceSelector())
 		if err != nil {
 			return nil, err
 		}
@@ -285,16 +313,16 @@ func (k *Deployer) renderManifests(ctx context.Context, out io.Writer, builds []
 		}
 	}
 
-	manifests, err = manifests.ReplaceImages(builds)
+	manifests, err = manifests.ReplaceImages(builds, k.transformer.ResourceSelector())
 	if err != nil {
 		return nil, err
 	}
 
-	if manifests, err = manifest.ApplyTransforms(manifests, builds, k.insecureRegistries, debugHelpersRegistry); err != nil {
+	if manifests, err = manifest.ApplyTransforms(manifests, builds, k.transformer, k.insecureRegistries, debugHelpersRegistry); err != nil {
 		return nil, err
 	}
 
-	return manifests.SetLabels(k.labels)
+	return manifests.SetLabels(k.labels, k.transformer.ResourceSelector())
 }
 
 // Cleanup deletes what was deployed by calling Deploy.
@@ -317,7 +345,7 @@ func (k *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
 			rm = append(rm, manifest)
 		}
 
-		upd, err := rm.ReplaceImages(k.originalImages)
+		upd, err := rm.ReplaceImages(k.originalImages, k.transformer.ResourceSelector())
 		if err != nil {
 			return err
 		}
@@ -241,7 +241,7 @@ func TestKubectlDeploy(t *testing.T) {
 				},
 				RunContext: runcontext.RunContext{Opts: config.SkaffoldOptions{
 					Namespace: skaffoldNamespaceOption}},
-			}, nil, &test.kubectl)
+			}, nil, &test.kubectl, nil, nil)
 			t.RequireNoError(err)
 
 			_, err = k.Deploy(context.Background(), ioutil.Discard, test.builds)
@@ -251,6 +251,30 @@ func TestKubectlDeploy(t *testing.T) {
 	}
 }
 
+func TestKubectlDeployValidatesBeforeApplying(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		invalid := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: web\ndata: [value]"
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRunOut("kubectl version --client -ojson", KubectlVersion118).
+			AndRunOut("kubectl --context kubecontext --namespace testNamespace create --dry-run=client -oyaml -f configmap.yaml", invalid))
+		t.NewTempDir().
+			Write("configmap.yaml", invalid).
+			Chdir()
+		validator, err := manifest.NewValidator(latest.ValidateConfig{})
+		t.RequireNoError(err)
+
+		k, err := NewDeployer(&kubectlConfig{
+			workingDir: ".",
+			RunContext: runcontext.RunContext{Opts: config.SkaffoldOptions{Namespace: TestNamespace}},
+		}, nil, &latest.KubectlDeploy{Manifests: []string{"configmap.yaml"}}, nil, validator)
+		t.RequireNoError(err)
+
+		_, err = k.Deploy(context.Background(), ioutil.Discard, nil)
+
+		t.CheckErrorContains("validating manifests", err)
+	})
+}
+
 func TestKubectlCleanup(t *testing.T) {
 	tests := []struct {
 		description string
@@ -315,7 +339,7 @@ func TestKubectlCleanup(t *testing.T) {
 			k, err := NewDeployer(&kubectlConfig{
 				workingDir: ".",
 				RunContext: runcontext.RunContext{Opts: config.SkaffoldOptions{Namespace: TestNamespace}},
-			}, nil, &test.kubectl)
+			}, nil, &test.kubectl, nil, nil)
 			t.RequireNoError(err)
 
 			err = k.Cleanup(context.Background(), ioutil.Discard)
@@ -362,7 +386,7 @@ func TestKubectlDeployerRemoteCleanup(t *testing.T) {
 			k, err := NewDeployer(&kubectlConfig{
 				workingDir: ".",
 				RunContext: runcontext.RunContext{Opts: config.SkaffoldOptions{Namespace: TestNamespace}},
-			}, nil, &test.kubectl)
+			}, nil, &test.kubectl, nil, nil)
 			t.RequireNoError(err)
 
 			err = k.Cleanup(context.Background(), ioutil.Discard)
@@ -396,7 +420,7 @@ func TestKubectlRedeploy(t *testing.T) {
 				Enabled: true,
 				Delay:   0 * time.Millisecond,
 				Max:     10 * time.Second},
-		}, nil, &latest.KubectlDeploy{Manifests: []string{tmpDir.Path("deployment-app.yaml"), tmpDir.Path("deployment-web.yaml")}})
+		}, nil, &latest.KubectlDeploy{Manifests: []string{tmpDir.Path("deployment-app.yaml"), tmpDir.Path("deployment-web.yaml")}}, nil, nil)
 		t.RequireNoError(err)
 
 		// Deploy one manifest
@@ -460,7 +484,7 @@ func TestKubectlWaitForDeletions(t *testing.T) {
 				Delay:   0 * time.Millisecond,
 				Max:     10 * time.Second,
 			},
-		}, nil, &latest.KubectlDeploy{Manifests: []string{tmpDir.Path("deployment-web.yaml")}})
+		}, nil, &latest.KubectlDeploy{Manifests: []string{tmpDir.Path("deployment-web.yaml")}}, nil, nil)
 		t.RequireNoError(err)
 
 		var out bytes.Buffer
@@ -497,7 +521,7 @@ func TestKubectlWaitForDeletionsFails(t *testing.T) {
 				Delay:   10 * time.Second,
 				Max:     100 * time.Millisecond,
 			},
-		}, nil, &latest.KubectlDeploy{Manifests: []string{tmpDir.Path("deployment-web.yaml")}})
+		}, nil, &latest.KubectlDeploy{Manifests: []string{tmpDir.Path("deployment-web.yaml")}}, nil, nil)
 		t.RequireNoError(err)
 
 		_, err = deployer.Deploy(context.Background(), ioutil.Discard, []build.Artifact{
@@ -558,7 +582,7 @@ func TestDependencies(t *testing.T) {
 				Touch("00/b.yaml", "00/a.yaml").
 				Chdir()
 
-			k, err := NewDeployer(&kubectlConfig{}, nil, &latest.KubectlDeploy{Manifests: test.manifests})
+			k, err := NewDeployer(&kubectlConfig{}, nil, &latest.KubectlDeploy{Manifests: test.manifests}, nil, nil)
 			t.RequireNoError(err)
 
 			dependencies, err := k.Dependencies()
@@ -676,7 +700,7 @@ spec:
 				defaultRepo: "gcr.io/project",
 			}, nil, &latest.KubectlDeploy{
 				Manifests: []string{tmpDir.Path("deployment.yaml")},
-			})
+			}, nil, nil)
 			t.RequireNoError(err)
 			var b bytes.Buffer
 			err = deployer.Render(context.Background(), &b, test.builds, true, "")
@@ -719,7 +743,7 @@ func TestGCSManifests(t *testing.T) {
 				workingDir: ".",
 				skipRender: test.skipRender,
 				RunContext: runcontext.RunContext{Opts: config.SkaffoldOptions{Namespace: TestNamespace}},
-			}, nil, &test.kubectl)
+			}, nil, &test.kubectl, nil, nil)
 			t.RequireNoError(err)
 
 			_, err = k.Deploy(context.Background(), ioutil.Discard, nil)
@@ -745,3 +769,38 @@ func (c *kubectlConfig) SkipRender() bool                          { return c.sk
 func (c *kubectlConfig) ForceDeploy() bool                         { return c.force }
 func (c *kubectlConfig) DefaultRepo() *string                      { return &c.defaultRepo }
 func (c *kubectlConfig) WaitForDeletions() config.WaitForDeletions { return c.waitForDeletions }
+
+func TestCheckHermetic(t *testing.T) {
+	tests := []struct {
+		description string
+		deploy      latest.KubectlDeploy
+		shouldErr   bool
+	}{
+		{
+			description: "local manifests",
+			deploy:      latest.KubectlDeploy{Manifests: []string{"k8s/*.yaml"}},
+		},
+		{
+			description: "url manifest",
+			deploy:      latest.KubectlDeploy{Manifests: []string{"https://example.com/deployment.yaml"}},
+			shouldErr:   true,
+		},
+		{
+			description: "gcs manifest",
+			deploy:      latest.KubectlDeploy{Manifests: []string{"gs://bucket/deployment.yaml"}},
+			shouldErr:   true,
+		},
+		{
+			description: "remote manifest",
+			deploy:      latest.KubectlDeploy{RemoteManifests: []string{"deployment/web"}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			err := CheckHermetic(&test.deploy)
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
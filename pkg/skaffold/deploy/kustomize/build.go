@@ -0,0 +1,255 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kustomize
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/kustomize/api/filesys"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/api/types"
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// buildOptions turns the `buildArgs`, which are the flags of `kustomize build`,
+// into the options of the kustomize API.
+// Like `kustomize build`, resources are sorted by kind by default
+// and the `helmCharts` generator is only enabled with `--enable-helm`.
+func buildOptions(buildArgs []string) (*krusty.Options, error) {
+	opts := krusty.MakeDefaultOptions()
+	opts.DoLegacyResourceSort = true
+	helmEnabled := false
+	helmCommand := "helm"
+
+	args := BuildCommandArgs(buildArgs, "")
+	for i := 0; i < len(args); i++ {
+		name, value, hasValue := args[i], "", false
+		if j := strings.Index(name, "="); j >= 0 {
+			name, value, hasValue = name[:j], name[j+1:], true
+		}
+		name = strings.ReplaceAll(name, "_", "-")
+
+		nextValue := func() (string, error) {
+			if hasValue {
+				return value, nil
+			}
+			if i+1 >= len(args) {
+				return "", fmt.Errorf("missing value for kustomize build flag %q", name)
+			}
+			i++
+			return args[i], nil
+		}
+		boolValue := func() (bool, error) {
+			if !hasValue {
+				return true, nil
+			}
+			return strconv.ParseBool(value)
+		}
+
+		switch name {
+		case "--load-restrictor":
+			v, err := nextValue()
+			if err != nil {
+				return nil, err
+			}
+			switch v {
+			case types.LoadRestrictionsNone.String(), "none":
+				opts.LoadRestrictions = types.LoadRestrictionsNone
+			case types.LoadRestrictionsRootOnly.String(), "rootOnly":
+				opts.LoadRestrictions = types.LoadRestrictionsRootOnly
+			default:
+				return nil, fmt.Errorf("invalid value %q for kustomize build flag %q", v, name)
+			}
+
+		case "--reorder":
+			v, err := nextValue()
+			if err != nil {
+				return nil, err
+			}
+			switch v {
+			case "legacy":
+				opts.DoLegacyResourceSort = true
+			case "none":
+				opts.DoLegacyResourceSort = false
+			default:
+				return nil, fmt.Errorf("invalid value %q for kustomize build flag %q", v, name)
+			}
+
+		case "--enable-alpha-plugins":
+			enabled, err := boolValue()
+			if err != nil {
+				return nil, err
+			}
+			if enabled {
+				opts.PluginConfig = types.EnabledPluginConfig(types.BploUseStaticallyLinked)
+			}
+
+		case "--enable-managedby-label":
+			enabled, err := boolValue()
+			if err != nil {
+				return nil, err
+			}
+			opts.AddManagedbyLabel = enabled
+
+		case "--enable-helm":
+			enabled, err := boolValue()
+			if err != nil {
+				return nil, err
+			}
+			helmEnabled = enabled
+
+		case "--helm-command":
+			v, err := nextValue()
+			if err != nil {
+				return nil, err
+			}
+			helmCommand = v
+
+		default:
+			return nil, fmt.Errorf("unsupported kustomize build flag %q", args[i])
+		}
+	}
+
+	opts.PluginConfig.HelmConfig.Enabled = helmEnabled
+	opts.PluginConfig.HelmConfig.Command = helmCommand
+	return opts, nil
+}
+
+// kustomizeBuild runs the kustomization in `path` with the kustomize API.
+// It returns the manifests and the local files that kustomize read.
+func kustomizeBuild(opts *krusty.Options, remote *remoteBases, path string) (out []byte, files []string, err error) {
+	// kustomize panics on some invalid kustomizations
+	defer func() {
+		if r := recover(); r != nil {
+			out, files, err = nil, nil, fmt.Errorf("building kustomization in %q: %v", path, r)
+		}
+	}()
+
+	fs := &recordingFS{
+		FileSystem: filesys.MakeFsOnDisk(),
+		remote:     remote,
+		files:      util.NewStringSet(),
+	}
+
+	resources, err := krusty.MakeKustomizer(opts).Run(fs, path)
+	if fs.err != nil {
+		return nil, nil, fs.err
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	out, err = resources.AsYaml()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return out, fs.files.ToList(), nil
+}
+
+// recordingFS is the local file system, except that it records the files that kustomize reads
+// and that it replaces the remote bases of kustomizations with their copies in the cache.
+type recordingFS struct {
+	filesys.FileSystem
+	remote *remoteBases
+
+	files util.StringSet
+	err   error
+}
+
+func (fs *recordingFS) ReadFile(path string) ([]byte, error) {
+	buf, err := fs.FileSystem.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if fs.remote.isCached(path) {
+		return fs.rewrite(path, buf)
+	}
+
+	fs.record(path)
+	if IsKustomizationPath(path) {
+		fs.files.Insert(localChartFiles(filepath.Dir(path), buf)...)
+		return fs.rewrite(path, buf)
+	}
+	return buf, nil
+}
+
+func (fs *recordingFS) Open(path string) (filesys.File, error) {
+	f, err := fs.FileSystem.Open(path)
+	if err == nil && !fs.remote.isCached(path) {
+		fs.record(path)
+	}
+	return f, err
+}
+
+func (fs *recordingFS) record(path string) {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	fs.files.Insert(path)
+}
+
+func (fs *recordingFS) rewrite(path string, buf []byte) ([]byte, error) {
+	if !IsKustomizationPath(path) {
+		return buf, nil
+	}
+
+	rewritten, err := fs.remote.rewrite(filepath.Dir(path), buf)
+	if err != nil {
+		// kustomize doesn't report the details of read errors
+		fs.err = err
+		return nil, err
+	}
+	return rewritten, nil
+}
+
+// localChartFiles lists the files of the local charts that the `helmCharts` of a kustomization use.
+// Charts with a `repo` are downloaded by helm and aren't listed.
+func localChartFiles(dir string, buf []byte) []string {
+	var k types.Kustomization
+	if err := k8syaml.Unmarshal(buf, &k); err != nil {
+		return nil
+	}
+	k.FixKustomizationPostUnmarshalling()
+
+	chartHome := "charts"
+	if k.HelmGlobals != nil && k.HelmGlobals.ChartHome != "" {
+		chartHome = k.HelmGlobals.ChartHome
+	}
+	if !filepath.IsAbs(chartHome) {
+		chartHome = filepath.Join(dir, chartHome)
+	}
+
+	var files []string
+	for _, chart := range k.HelmCharts {
+		if chart.Repo != "" || chart.Name == "" {
+			continue
+		}
+		filepath.Walk(filepath.Join(chartHome, chart.Name), func(path string, info os.FileInfo, err error) error {
+			if err == nil && !info.IsDir() {
+				files = append(files, path)
+			}
+			return nil
+		})
+	}
+	return files
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kustomize
+
+import (
+	"testing"
+
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/api/types"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestBuildOptions(t *testing.T) {
+	tests := []struct {
+		description string
+		buildArgs   []string
+		expected    func(*krusty.Options)
+		shouldErr   bool
+	}{
+		{
+			description: "defaults",
+			expected:    func(*krusty.Options) {},
+		},
+		{
+			description: "load restrictor",
+			buildArgs:   []string{"--load-restrictor LoadRestrictionsNone"},
+			expected: func(opts *krusty.Options) {
+				opts.LoadRestrictions = types.LoadRestrictionsNone
+			},
+		},
+		{
+			description: "legacy flag names",
+			buildArgs:   []string{"--load_restrictor=none", "--enable_managedby_label"},
+			expected: func(opts *krusty.Options) {
+				opts.LoadRestrictions = types.LoadRestrictionsNone
+				opts.AddManagedbyLabel = true
+			},
+		},
+		{
+			description: "no reordering",
+			buildArgs:   []string{"--reorder", "none"},
+			expected: func(opts *krusty.Options) {
+				opts.DoLegacyResourceSort = false
+			},
+		},
+		{
+			description: "helm command",
+			buildArgs:   []string{"--enable-helm", "--helm-command=/usr/local/bin/helm3"},
+			expected: func(opts *krusty.Options) {
+				opts.PluginConfig.HelmConfig.Enabled = true
+				opts.PluginConfig.HelmConfig.Command = "/usr/local/bin/helm3"
+			},
+		},
+		{
+			description: "helm disabled",
+			buildArgs:   []string{"--enable-helm=false"},
+			expected:    func(*krusty.Options) {},
+		},
+		{
+			description: "invalid value",
+			buildArgs:   []string{"--reorder", "alphabetical"},
+			shouldErr:   true,
+		},
+		{
+			description: "missing value",
+			buildArgs:   []string{"--helm-command"},
+			shouldErr:   true,
+		},
+		{
+			description: "unsupported flag",
+			buildArgs:   []string{"--output", "dir"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			opts, err := buildOptions(test.buildArgs)
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				expected := krusty.MakeDefaultOptions()
+				expected.DoLegacyResourceSort = true
+				expected.PluginConfig.HelmConfig.Command = "helm"
+				test.expected(expected)
+				t.CheckDeepEqual(expected, opts)
+			}
+		})
+	}
+}
+
+func TestKustomizeBuild(t *testing.T) {
+	tests := []struct {
+		description   string
+		files         map[string]string
+		expected      string
+		expectedFiles []string
+		shouldErr     bool
+	}{
+		{
+			description: "components",
+			files: map[string]string{
+				"app/kustomization.yaml": `resources: [pod.yaml]
+components: [../debug]`,
+				"app/pod.yaml": `apiVersion: v1
+kind: Pod
+metadata:
+  name: web
+spec:
+  containers:
+  - name: web
+    image: web`,
+				"debug/kustomization.yaml": `apiVersion: kustomize.config.k8s.io/v1alpha1
+kind: Component
+commonLabels:
+  debug: "true"`,
+				"unused.yaml": "",
+			},
+			expected: `apiVersion: v1
+kind: Pod
+metadata:
+  labels:
+    debug: "true"
+  name: web
+spec:
+  containers:
+  - image: web
+    name: web
+`,
+			expectedFiles: []string{"app/kustomization.yaml", "app/pod.yaml", "debug/kustomization.yaml"},
+		},
+		{
+			description: "replacements",
+			files: map[string]string{
+				"app/kustomization.yaml": `resources: [pod.yaml]
+configMapGenerator:
+- name: images
+  literals: [web=web:v2]
+replacements:
+- path: replacement.yaml`,
+				"app/pod.yaml": `apiVersion: v1
+kind: Pod
+metadata:
+  name: web
+spec:
+  containers:
+  - name: web
+    image: web`,
+				"app/replacement.yaml": `source:
+  kind: ConfigMap
+  name: images
+  fieldPath: data.web
+targets:
+- select:
+    kind: Pod
+  fieldPaths:
+  - spec.containers.[name=web].image`,
+			},
+			expected: `apiVersion: v1
+data:
+  web: web:v2
+kind: ConfigMap
+metadata:
+  name: images-dgmkgbctmf
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web
+spec:
+  containers:
+  - image: web:v2
+    name: web
+`,
+			expectedFiles: []string{"app/kustomization.yaml", "app/pod.yaml", "app/replacement.yaml"},
+		},
+		{
+			description: "invalid kustomization",
+			files: map[string]string{
+				"app/kustomization.yaml": `patchesJson6902:
+- path: patch.json`,
+				"app/patch.json": "[]",
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().WriteFiles(test.files)
+			opts, err := buildOptions(nil)
+			t.RequireNoError(err)
+
+			out, files, err := kustomizeBuild(opts, newRemoteBases(&kustomizeConfig{}), tmpDir.Path("app"))
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, string(out))
+			if !test.shouldErr {
+				t.CheckDeepEqual(tmpDir.Paths(test.expectedFiles...), files)
+			}
+		})
+	}
+}
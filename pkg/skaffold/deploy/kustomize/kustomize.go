@@ -21,11 +21,12 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"os/exec"
 	"path/filepath"
+	"sync"
 
 	"github.com/segmentio/textio"
 	yamlv3 "gopkg.in/yaml.v3"
+	"sigs.k8s.io/kustomize/api/krusty"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
@@ -43,7 +44,6 @@ var (
 	DefaultKustomizePath = "."
 	kustomizeFilePaths   = []string{"kustomization.yaml", "kustomization.yml", "Kustomization"}
 	basePath             = "base"
-	kustomizeBinaryCheck = kustomizeBinaryExists // For testing
 )
 
 // kustomization is the content of a kustomization.yaml file.
@@ -57,6 +57,13 @@ type kustomization struct {
 	PatchesJSON6902       []patchJSON6902       `yaml:"patchesJson6902"`
 	ConfigMapGenerator    []configMapGenerator  `yaml:"configMapGenerator"`
 	SecretGenerator       []secretGenerator     `yaml:"secretGenerator"`
+	HelmCharts            []helmChart           `yaml:"helmCharts"`
+}
+
+// helmChart is a chart of the `helmCharts` generator. Charts with a `repo` are downloaded by helm.
+type helmChart struct {
+	Name string `yaml:"name"`
+	Repo string `yaml:"repo"`
 }
 
 type patchPath struct {
@@ -89,18 +96,36 @@ type secretGenerator struct {
 	Envs  []string `yaml:"envs"`
 }
 
-// Deployer deploys workflows using kustomize CLI.
+// Config contains the configuration needed by the kustomize Deployer.
+type Config interface {
+	kubectl.Config
+	RepoCacheDir() string
+	RemoteCacheOnly() bool
+}
+
+// Deployer deploys workflows using the kustomize API.
 type Deployer struct {
 	*latest.KustomizeDeploy
 
-	kubectl             kubectl.CLI
-	insecureRegistries  map[string]bool
-	labels              map[string]string
-	globalConfig        string
-	useKubectlKustomize bool
+	kubectl            kubectl.CLI
+	insecureRegistries map[string]bool
+	labels             map[string]string
+	globalConfig       string
+	buildOptions       *krusty.Options
+	remoteBases        *remoteBases
+	// transformer applies the transforms of the deploy config, if any.
+	transformer *manifest.Transformer
+
+	// validator checks the manifests before they are applied, if validation is configured.
+	validator *manifest.Validator
+
+	depsLock sync.Mutex
+	deps     map[string][]string // the files loaded by the last build of each kustomization
 }
 
-func NewDeployer(cfg kubectl.Config, labels map[string]string, d *latest.KustomizeDeploy) (*Deployer, error) {
+// NewDeployer returns a new Deployer for a KustomizeDeploy.
+// The manifests are transformed by `transformer` and checked by `validator` before they are applied, unless they're nil.
+func NewDeployer(cfg Config, labels map[string]string, d *latest.KustomizeDeploy, transformer *manifest.Transformer, validator *manifest.Validator) (*Deployer, error) {
 	defaultNamespace := ""
 	if d.DefaultNamespace != nil {
 		var err error
@@ -110,35 +135,23 @@ func NewDeployer(cfg kubectl.Config, labels map[string]string, d *latest.Kustomi
 		}
 	}
 
-	kubectl := kubectl.NewCLI(cfg, d.Flags, defaultNamespace)
-	// if user has kustomize binary, prioritize that over kubectl kustomize
-	useKubectlKustomize := !kustomizeBinaryCheck() && kubectlVersionCheck(kubectl)
-
-	return &Deployer{
-		KustomizeDeploy:     d,
-		kubectl:             kubectl,
-		insecureRegistries:  cfg.GetInsecureRegistries(),
-		globalConfig:        cfg.GlobalConfig(),
-		labels:              labels,
-		useKubectlKustomize: useKubectlKustomize,
-	}, nil
-}
-
-// Check for existence of kustomize binary in user's PATH
-func kustomizeBinaryExists() bool {
-	_, err := exec.LookPath("kustomize")
-
-	return err == nil
-}
-
-// Check that kubectl version is valid to use kubectl kustomize
-func kubectlVersionCheck(kubectl kubectl.CLI) bool {
-	gt, err := kubectl.CompareVersionTo(context.Background(), 1, 14)
+	opts, err := buildOptions(d.BuildArgs)
 	if err != nil {
-		return false
+		return nil, userErr(err)
 	}
 
-	return gt == 1
+	return &Deployer{
+		KustomizeDeploy:    d,
+		kubectl:            kubectl.NewCLI(cfg, d.Flags, defaultNamespace),
+		insecureRegistries: cfg.GetInsecureRegistries(),
+		globalConfig:       cfg.GlobalConfig(),
+		labels:             labels,
+		buildOptions:       opts,
+		remoteBases:        newRemoteBases(cfg),
+		transformer:        transformer,
+		validator:          validator,
+		deps:               map[string][]string{},
+	}, nil
 }
 
 // Deploy runs `kubectl apply` on the manifest generated by kustomize.
@@ -148,6 +161,12 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 		return nil, err
 	}
 
+	if k.validator != nil {
+		if err := k.validator.Validate(manifests); err != nil {
+			return nil, fmt.Errorf("validating manifests: %w", err)
+		}
+	}
+
 	if len(manifests) == 0 {
 		return nil, nil
 	}
@@ -180,7 +199,7 @@ func (k *Deployer) renderManifests(ctx context.Context, out io.Writer, builds []
 		return nil, deployerr.DebugHelperRetrieveErr(err)
 	}
 
-	manifests, err := k.readManifests(ctx)
+	manifests, err := k.readManifests()
 	if err != nil {
 		return nil, err
 	}
@@ -189,21 +208,21 @@ func (k *Deployer) renderManifests(ctx context.Context, out io.Writer, builds []
 		return nil, nil
 	}
 
-	manifests, err = manifests.ReplaceImages(builds)
+	manifests, err = manifests.ReplaceImages(builds, k.transformer.ResourceSelector())
 	if err != nil {
 		return nil, err
 	}
 
-	if manifests, err = manifest.ApplyTransforms(manifests, builds, k.insecureRegistries, debugHelpersRegistry); err != nil {
+	if manifests, err = manifest.ApplyTransforms(manifests, builds, k.transformer, k.insecureRegistries, debugHelpersRegistry); err != nil {
 		return nil, err
 	}
 
-	return manifests.SetLabels(k.labels)
+	return manifests.SetLabels(k.labels, k.transformer.ResourceSelector())
 }
 
 // Cleanup deletes what was deployed by calling Deploy.
 func (k *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
-	manifests, err := k.readManifests(ctx)
+	manifests, err := k.readManifests()
 	if err != nil {
 		return err
 	}
@@ -216,18 +235,47 @@ func (k *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
 }
 
 // Dependencies lists all the files that describe what needs to be deployed.
+// Those are the local files that kustomize loaded when it last built each kustomization.
+// Kustomizations that were never built are built first. If they fail to build,
+// the files they reference are listed instead, so that fixing them triggers a new deployment.
 func (k *Deployer) Dependencies() ([]string, error) {
 	deps := util.NewStringSet()
 	for _, kustomizePath := range k.KustomizePaths {
-		depsForKustomization, err := DependenciesForKustomization(kustomizePath)
-		if err != nil {
-			return nil, userErr(err)
+		depsForKustomization, found := k.loadedFiles(kustomizePath)
+		if !found {
+			var err error
+			if _, err = k.build(kustomizePath); err == nil {
+				depsForKustomization, _ = k.loadedFiles(kustomizePath)
+			} else if depsForKustomization, err = DependenciesForKustomization(kustomizePath); err != nil {
+				return nil, userErr(err)
+			}
 		}
 		deps.Insert(depsForKustomization...)
 	}
 	return deps.ToList(), nil
 }
 
+// build builds a kustomization and records the files that kustomize loaded.
+func (k *Deployer) build(kustomizePath string) ([]byte, error) {
+	out, files, err := kustomizeBuild(k.buildOptions, k.remoteBases, kustomizePath)
+	if err != nil {
+		return nil, err
+	}
+
+	k.depsLock.Lock()
+	k.deps[kustomizePath] = files
+	k.depsLock.Unlock()
+	return out, nil
+}
+
+func (k *Deployer) loadedFiles(kustomizePath string) ([]string, bool) {
+	k.depsLock.Lock()
+	defer k.depsLock.Unlock()
+
+	files, found := k.deps[kustomizePath]
+	return files, found
+}
+
 func (k *Deployer) Render(ctx context.Context, out io.Writer, builds []build.Artifact, offline bool, filepath string) error {
 	manifests, err := k.renderManifests(ctx, out, builds)
 	if err != nil {
@@ -236,6 +284,24 @@ func (k *Deployer) Render(ctx context.Context, out io.Writer, builds []build.Art
 	return manifest.Write(manifests.String(), filepath, out)
 }
 
+// CheckHermetic returns an error if kustomize would need to download bases, resources, components or helm charts.
+func CheckHermetic(d *latest.KustomizeDeploy) error {
+	for _, kustomizePath := range d.KustomizePaths {
+		if _, err := FindKustomizationConfig(kustomizePath); err != nil {
+			return fmt.Errorf("kustomization %q isn't available locally", kustomizePath)
+		}
+
+		remote, err := remoteReference(kustomizePath)
+		if err != nil {
+			return fmt.Errorf("reading kustomization in %q: %w", kustomizePath, err)
+		}
+		if remote != "" {
+			return fmt.Errorf("kustomization in %q references %q, which isn't available locally", kustomizePath, remote)
+		}
+	}
+	return nil
+}
+
 // Values of `patchesStrategicMerge` can be either:
 // + a file path, referenced as a plain string
 // + an inline patch referenced as a string literal
@@ -275,19 +341,10 @@ func pathExistsLocally(filename string, workingDir string) (bool, os.FileMode) {
 	return false, 0
 }
 
-func (k *Deployer) readManifests(ctx context.Context) (manifest.ManifestList, error) {
+func (k *Deployer) readManifests() (manifest.ManifestList, error) {
 	var manifests manifest.ManifestList
 	for _, kustomizePath := range k.KustomizePaths {
-		var out []byte
-		var err error
-
-		if k.useKubectlKustomize {
-			out, err = k.kubectl.Kustomize(ctx, BuildCommandArgs(k.BuildArgs, kustomizePath))
-		} else {
-			cmd := exec.CommandContext(ctx, "kustomize", append([]string{"build"}, BuildCommandArgs(k.BuildArgs, kustomizePath)...)...)
-			out, err = util.RunCmdOut(cmd)
-		}
-
+		out, err := k.build(kustomizePath)
 		if err != nil {
 			return nil, userErr(err)
 		}
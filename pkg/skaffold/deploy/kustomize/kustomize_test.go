@@ -38,12 +38,12 @@ func TestKustomizeDeploy(t *testing.T) {
 	tests := []struct {
 		description                 string
 		kustomize                   latest.KustomizeDeploy
+		files                       map[string]string
 		builds                      []build.Artifact
 		commands                    util.Command
 		shouldErr                   bool
 		forceDeploy                 bool
 		skipSkaffoldNamespaceOption bool
-		kustomizeCmdPresent         bool
 		envs                        map[string]string
 	}{
 		{
@@ -51,27 +51,27 @@ func TestKustomizeDeploy(t *testing.T) {
 			kustomize: latest.KustomizeDeploy{
 				KustomizePaths: []string{"."},
 			},
-			commands: testutil.
-				CmdRunOut("kubectl version --client -ojson", kubectl.KubectlVersion118).
-				AndRunOut("kustomize build .", ""),
-			kustomizeCmdPresent: true,
+			files:    map[string]string{"kustomization.yaml": ""},
+			commands: testutil.CmdRunOut("kubectl version --client -ojson", kubectl.KubectlVersion118),
 		},
 		{
 			description: "deploy success",
 			kustomize: latest.KustomizeDeploy{
 				KustomizePaths: []string{"."},
 			},
+			files: map[string]string{
+				"kustomization.yaml": "resources: [deployment.yaml]",
+				"deployment.yaml":    kubectl.DeploymentWebYAML,
+			},
 			commands: testutil.
 				CmdRunOut("kubectl version --client -ojson", kubectl.KubectlVersion118).
-				AndRunOut("kustomize build .", kubectl.DeploymentWebYAML).
 				AndRunInputOut("kubectl --context kubecontext --namespace testNamespace get -f - --ignore-not-found -ojson", kubectl.DeploymentWebYAMLv1, "").
 				AndRun("kubectl --context kubecontext --namespace testNamespace apply -f - --force --grace-period=0"),
 			builds: []build.Artifact{{
 				ImageName: "leeroy-web",
 				Tag:       "leeroy-web:v1",
 			}},
-			forceDeploy:         true,
-			kustomizeCmdPresent: true,
+			forceDeploy: true,
 		},
 		{
 			description: "deploy success (default namespace)",
@@ -79,9 +79,12 @@ func TestKustomizeDeploy(t *testing.T) {
 				KustomizePaths:   []string{"."},
 				DefaultNamespace: &kubectl.TestNamespace2,
 			},
+			files: map[string]string{
+				"kustomization.yaml": "resources: [deployment.yaml]",
+				"deployment.yaml":    kubectl.DeploymentWebYAML,
+			},
 			commands: testutil.
 				CmdRunOut("kubectl version --client -ojson", kubectl.KubectlVersion112).
-				AndRunOut("kustomize build .", kubectl.DeploymentWebYAML).
 				AndRunInputOut("kubectl --context kubecontext --namespace testNamespace2 get -f - --ignore-not-found -ojson", kubectl.DeploymentWebYAMLv1, "").
 				AndRun("kubectl --context kubecontext --namespace testNamespace2 apply -f - --force --grace-period=0"),
 			builds: []build.Artifact{{
@@ -90,7 +93,6 @@ func TestKustomizeDeploy(t *testing.T) {
 			}},
 			forceDeploy:                 true,
 			skipSkaffoldNamespaceOption: true,
-			kustomizeCmdPresent:         true,
 		},
 		{
 			description: "deploy success (default namespace with env template)",
@@ -98,9 +100,12 @@ func TestKustomizeDeploy(t *testing.T) {
 				KustomizePaths:   []string{"."},
 				DefaultNamespace: &kubectl.TestNamespace2FromEnvTemplate,
 			},
+			files: map[string]string{
+				"kustomization.yaml": "resources: [deployment.yaml]",
+				"deployment.yaml":    kubectl.DeploymentWebYAML,
+			},
 			commands: testutil.
 				CmdRunOut("kubectl version --client -ojson", kubectl.KubectlVersion112).
-				AndRunOut("kustomize build .", kubectl.DeploymentWebYAML).
 				AndRunInputOut("kubectl --context kubecontext --namespace testNamespace2 get -f - --ignore-not-found -ojson", kubectl.DeploymentWebYAMLv1, "").
 				AndRun("kubectl --context kubecontext --namespace testNamespace2 apply -f - --force --grace-period=0"),
 			builds: []build.Artifact{{
@@ -112,17 +117,20 @@ func TestKustomizeDeploy(t *testing.T) {
 			envs: map[string]string{
 				"MYENV": "Namesp",
 			},
-			kustomizeCmdPresent: true,
 		},
 		{
 			description: "deploy success with multiple kustomizations",
 			kustomize: latest.KustomizeDeploy{
 				KustomizePaths: []string{"a", "b"},
 			},
+			files: map[string]string{
+				"a/kustomization.yaml": "resources: [deployment.yaml]",
+				"a/deployment.yaml":    kubectl.DeploymentWebYAML,
+				"b/kustomization.yaml": "resources: [deployment.yaml]",
+				"b/deployment.yaml":    kubectl.DeploymentAppYAML,
+			},
 			commands: testutil.
 				CmdRunOut("kubectl version --client -ojson", kubectl.KubectlVersion118).
-				AndRunOut("kustomize build a", kubectl.DeploymentWebYAML).
-				AndRunOut("kustomize build b", kubectl.DeploymentAppYAML).
 				AndRunInputOut("kubectl --context kubecontext --namespace testNamespace get -f - --ignore-not-found -ojson", kubectl.DeploymentWebYAMLv1+"\n---\n"+kubectl.DeploymentAppYAMLv1, "").
 				AndRun("kubectl --context kubecontext --namespace testNamespace apply -f - --force --grace-period=0"),
 			builds: []build.Artifact{
@@ -135,31 +143,16 @@ func TestKustomizeDeploy(t *testing.T) {
 					Tag:       "leeroy-app:v1",
 				},
 			},
-			forceDeploy:         true,
-			kustomizeCmdPresent: true,
+			forceDeploy: true,
 		},
 		{
-			description: "built-in kubectl kustomize",
+			description: "invalid kustomization",
 			kustomize: latest.KustomizeDeploy{
-				KustomizePaths: []string{"a", "b"},
-			},
-			commands: testutil.
-				CmdRunOut("kubectl version --client -ojson", kubectl.KubectlVersion118).
-				AndRunOut("kubectl --context kubecontext --namespace testNamespace kustomize a", kubectl.DeploymentWebYAML).
-				AndRunOut("kubectl --context kubecontext --namespace testNamespace kustomize b", kubectl.DeploymentAppYAML).
-				AndRunInputOut("kubectl --context kubecontext --namespace testNamespace get -f - --ignore-not-found -ojson", kubectl.DeploymentWebYAMLv1+"\n---\n"+kubectl.DeploymentAppYAMLv1, "").
-				AndRun("kubectl --context kubecontext --namespace testNamespace apply -f - --force --grace-period=0"),
-			builds: []build.Artifact{
-				{
-					ImageName: "leeroy-web",
-					Tag:       "leeroy-web:v1",
-				},
-				{
-					ImageName: "leeroy-app",
-					Tag:       "leeroy-app:v1",
-				},
+				KustomizePaths: []string{"."},
 			},
-			forceDeploy: true,
+			files:     map[string]string{"kustomization.yaml": "resources: [missing.yaml]"},
+			commands:  testutil.CmdRunOut("kubectl version --client -ojson", kubectl.KubectlVersion118),
+			shouldErr: true,
 		},
 	}
 
@@ -167,8 +160,8 @@ func TestKustomizeDeploy(t *testing.T) {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.SetEnvs(test.envs)
 			t.Override(&util.DefaultExecCommand, test.commands)
-			t.Override(&kustomizeBinaryCheck, func() bool { return test.kustomizeCmdPresent })
 			t.NewTempDir().
+				WriteFiles(test.files).
 				Chdir()
 
 			skaffoldNamespaceOption := ""
@@ -186,7 +179,7 @@ func TestKustomizeDeploy(t *testing.T) {
 				},
 				RunContext: runcontext.RunContext{Opts: config.SkaffoldOptions{
 					Namespace: skaffoldNamespaceOption,
-				}}}, nil, &test.kustomize)
+				}}}, nil, &test.kustomize, nil, nil)
 			t.RequireNoError(err)
 			_, err = k.Deploy(context.Background(), ioutil.Discard, test.builds)
 
@@ -197,6 +190,15 @@ func TestKustomizeDeploy(t *testing.T) {
 
 func TestKustomizeCleanup(t *testing.T) {
 	tmpDir := testutil.NewTempDir(t)
+	tmpDir.WriteFiles(map[string]string{
+		"kustomization.yaml":         "resources: [deployment.yaml]",
+		"deployment.yaml":            kubectl.DeploymentWebYAML,
+		"a/kustomization.yaml":       "resources: [deployment.yaml]",
+		"a/deployment.yaml":          kubectl.DeploymentWebYAML,
+		"b/kustomization.yaml":       "resources: [deployment.yaml]",
+		"b/deployment.yaml":          kubectl.DeploymentAppYAML,
+		"invalid/kustomization.yaml": "resources: [missing.yaml]",
+	})
 
 	tests := []struct {
 		description string
@@ -210,8 +212,7 @@ func TestKustomizeCleanup(t *testing.T) {
 				KustomizePaths: []string{tmpDir.Root()},
 			},
 			commands: testutil.
-				CmdRunOut("kustomize build "+tmpDir.Root(), kubectl.DeploymentWebYAML).
-				AndRun("kubectl --context kubecontext --namespace testNamespace delete --ignore-not-found=true -f -"),
+				CmdRun("kubectl --context kubecontext --namespace testNamespace delete --ignore-not-found=true -f -"),
 		},
 		{
 			description: "cleanup success with multiple kustomizations",
@@ -219,9 +220,7 @@ func TestKustomizeCleanup(t *testing.T) {
 				KustomizePaths: tmpDir.Paths("a", "b"),
 			},
 			commands: testutil.
-				CmdRunOut("kustomize build "+tmpDir.Path("a"), kubectl.DeploymentWebYAML).
-				AndRunOut("kustomize build "+tmpDir.Path("b"), kubectl.DeploymentAppYAML).
-				AndRun("kubectl --context kubecontext --namespace testNamespace delete --ignore-not-found=true -f -"),
+				CmdRun("kubectl --context kubecontext --namespace testNamespace delete --ignore-not-found=true -f -"),
 		},
 		{
 			description: "cleanup error",
@@ -229,30 +228,26 @@ func TestKustomizeCleanup(t *testing.T) {
 				KustomizePaths: []string{tmpDir.Root()},
 			},
 			commands: testutil.
-				CmdRunOut("kustomize build "+tmpDir.Root(), kubectl.DeploymentWebYAML).
-				AndRunErr("kubectl --context kubecontext --namespace testNamespace delete --ignore-not-found=true -f -", errors.New("BUG")),
+				CmdRunErr("kubectl --context kubecontext --namespace testNamespace delete --ignore-not-found=true -f -", errors.New("BUG")),
 			shouldErr: true,
 		},
 		{
 			description: "fail to read manifests",
 			kustomize: latest.KustomizeDeploy{
-				KustomizePaths: []string{tmpDir.Root()},
+				KustomizePaths: []string{tmpDir.Path("invalid")},
 			},
-			commands: testutil.
-				CmdRunOutErr("kustomize build "+tmpDir.Root(), "", errors.New("BUG")),
 			shouldErr: true,
 		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.Override(&util.DefaultExecCommand, test.commands)
-			t.Override(&kustomizeBinaryCheck, func() bool { return true })
 
 			k, err := NewDeployer(&kustomizeConfig{
 				workingDir: tmpDir.Root(),
 				RunContext: runcontext.RunContext{Opts: config.SkaffoldOptions{
 					Namespace: kubectl.TestNamespace}},
-			}, nil, &test.kustomize)
+			}, nil, &test.kustomize, nil, nil)
 			t.RequireNoError(err)
 			err = k.Cleanup(context.Background(), ioutil.Discard)
 
@@ -435,6 +430,17 @@ func TestDependenciesForKustomization(t *testing.T) {
 				"base2/app.yaml":           "",
 			},
 		},
+		{
+			description: "transformer configurations loaded by kustomize",
+			kustomizations: map[string]string{"kustomization.yaml": `resources: [pod.yaml]
+configurations: [images.yaml]`},
+			expected: []string{"images.yaml", "kustomization.yaml", "pod.yaml"},
+			createFiles: map[string]string{
+				"pod.yaml":    "apiVersion: v1\nkind: Pod\nmetadata:\n  name: web",
+				"images.yaml": "images:\n- path: spec/image\n  kind: Custom",
+				"unused.yaml": "",
+			},
+		},
 		{
 			description: "remote or missing root kustomization config",
 			expected:    []string{},
@@ -454,7 +460,7 @@ func TestDependenciesForKustomization(t *testing.T) {
 				tmpDir.Write(path, contents)
 			}
 
-			k, err := NewDeployer(&kustomizeConfig{}, nil, &latest.KustomizeDeploy{KustomizePaths: kustomizePaths})
+			k, err := NewDeployer(&kustomizeConfig{}, nil, &latest.KustomizeDeploy{KustomizePaths: kustomizePaths}, nil, nil)
 			t.RequireNoError(err)
 
 			deps, err := k.Dependencies()
@@ -530,15 +536,15 @@ func TestKustomizeBuildCommandArgs(t *testing.T) {
 }
 
 func TestKustomizeRender(t *testing.T) {
-	type kustomizationCall struct {
-		folder      string
-		buildResult string
+	type kustomization struct {
+		folder    string
+		resources string
 	}
 	tests := []struct {
 		description    string
 		builds         []build.Artifact
 		labels         map[string]string
-		kustomizations []kustomizationCall
+		kustomizations []kustomization
 		expected       string
 		shouldErr      bool
 	}{
@@ -554,12 +560,13 @@ func TestKustomizeRender(t *testing.T) {
 					Tag:       "gcr.io/project/image2:tag2",
 				},
 			},
-			kustomizations: []kustomizationCall{
+			kustomizations: []kustomization{
 				{
 					folder: ".",
-					buildResult: `apiVersion: v1
+					resources: `apiVersion: v1
 kind: Pod
 metadata:
+  name: pod
   namespace: default
 spec:
   containers:
@@ -573,6 +580,7 @@ spec:
 			expected: `apiVersion: v1
 kind: Pod
 metadata:
+  name: pod
   namespace: default
 spec:
   containers:
@@ -595,12 +603,13 @@ spec:
 				},
 			},
 			labels: map[string]string{"user/label": "test"},
-			kustomizations: []kustomizationCall{
+			kustomizations: []kustomization{
 				{
 					folder: ".",
-					buildResult: `apiVersion: v1
+					resources: `apiVersion: v1
 kind: Pod
 metadata:
+  name: pod
   namespace: default
 spec:
   containers:
@@ -616,6 +625,7 @@ kind: Pod
 metadata:
   labels:
     user/label: test
+  name: pod
   namespace: default
 spec:
   containers:
@@ -637,12 +647,13 @@ spec:
 					Tag:       "gcr.io/project/image2:tag2",
 				},
 			},
-			kustomizations: []kustomizationCall{
+			kustomizations: []kustomization{
 				{
 					folder: "a",
-					buildResult: `apiVersion: v1
+					resources: `apiVersion: v1
 kind: Pod
 metadata:
+  name: pod
   namespace: default
 spec:
   containers:
@@ -652,9 +663,10 @@ spec:
 				},
 				{
 					folder: "b",
-					buildResult: `apiVersion: v1
+					resources: `apiVersion: v1
 kind: Pod
 metadata:
+  name: pod
   namespace: default
 spec:
   containers:
@@ -666,6 +678,7 @@ spec:
 			expected: `apiVersion: v1
 kind: Pod
 metadata:
+  name: pod
   namespace: default
 spec:
   containers:
@@ -675,6 +688,7 @@ spec:
 apiVersion: v1
 kind: Pod
 metadata:
+  name: pod
   namespace: default
 spec:
   containers:
@@ -686,21 +700,21 @@ spec:
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			var kustomizationPaths []string
-			fakeCmd := testutil.
-				CmdRunOut("kubectl version --client -ojson", kubectl.KubectlVersion112)
-			for _, kustomizationCall := range test.kustomizations {
-				fakeCmd.AndRunOut("kustomize build "+kustomizationCall.folder, kustomizationCall.buildResult)
-				kustomizationPaths = append(kustomizationPaths, kustomizationCall.folder)
+			tmpDir := t.NewTempDir()
+			for _, kustomization := range test.kustomizations {
+				tmpDir.Write(filepath.Join(kustomization.folder, "kustomization.yaml"), "resources: [pod.yaml]")
+				tmpDir.Write(filepath.Join(kustomization.folder, "pod.yaml"), kustomization.resources)
+				kustomizationPaths = append(kustomizationPaths, kustomization.folder)
 			}
-			t.Override(&util.DefaultExecCommand, fakeCmd)
-			t.NewTempDir().Chdir()
+			t.Override(&util.DefaultExecCommand, testutil.CmdRunOut("kubectl version --client -ojson", kubectl.KubectlVersion112))
+			tmpDir.Chdir()
 
 			k, err := NewDeployer(&kustomizeConfig{
 				workingDir: ".",
 				RunContext: runcontext.RunContext{Opts: config.SkaffoldOptions{Namespace: kubectl.TestNamespace}},
 			}, test.labels, &latest.KustomizeDeploy{
 				KustomizePaths: kustomizationPaths,
-			})
+			}, nil, nil)
 			t.RequireNoError(err)
 
 			var b bytes.Buffer
@@ -723,3 +737,66 @@ func (c *kustomizeConfig) WaitForDeletions() config.WaitForDeletions { return c.
 func (c *kustomizeConfig) WorkingDir() string                        { return c.workingDir }
 func (c *kustomizeConfig) GetKubeContext() string                    { return kubectl.TestKubeContext }
 func (c *kustomizeConfig) GetKubeNamespace() string                  { return c.Opts.Namespace }
+
+func TestCheckHermetic(t *testing.T) {
+	tests := []struct {
+		description string
+		files       map[string]string
+		shouldErr   bool
+	}{
+		{
+			description: "local bases and resources",
+			files: map[string]string{
+				"overlay/kustomization.yaml": "bases: [../base]\nresources: [service.yaml]",
+				"overlay/service.yaml":       "",
+				"base/kustomization.yaml":    "resources: [deployment.yaml]",
+				"base/deployment.yaml":       "",
+			},
+		},
+		{
+			description: "remote base",
+			files: map[string]string{
+				"overlay/kustomization.yaml": "bases: [github.com/example/config/base?ref=v1]",
+			},
+			shouldErr: true,
+		},
+		{
+			description: "remote resource in a local base",
+			files: map[string]string{
+				"overlay/kustomization.yaml": "resources: [../base]",
+				"base/kustomization.yaml":    "resources: [https://example.com/deployment.yaml]",
+			},
+			shouldErr: true,
+		},
+		{
+			description: "local helm chart",
+			files: map[string]string{
+				"overlay/kustomization.yaml":            "helmCharts:\n- name: app\n  releaseName: app",
+				"overlay/charts/app/Chart.yaml":         "name: app",
+				"overlay/charts/app/templates/app.yaml": "",
+			},
+		},
+		{
+			description: "helm chart from a repository",
+			files: map[string]string{
+				"overlay/kustomization.yaml": "resources: [../base]",
+				"base/kustomization.yaml":    "helmCharts:\n- name: minecraft\n  repo: https://kubernetes-charts.storage.googleapis.com",
+			},
+			shouldErr: true,
+		},
+		{
+			description: "missing kustomization",
+			files:       map[string]string{"overlay/deployment.yaml": ""},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().WriteFiles(test.files)
+
+			err := CheckHermetic(&latest.KustomizeDeploy{KustomizePaths: []string{tmpDir.Path("overlay")}})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kustomize
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/git"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// commitSHA matches full SHA-1 and SHA-256 git object names.
+var commitSHA = regexp.MustCompile(`^([0-9a-f]{40}|[0-9a-f]{64})$`)
+
+// knownGitHosts are the hosts of the `host/org/repo/path` shorthand understood by kustomize.
+var knownGitHosts = []string{"github.com", "gitlab.com", "bitbucket.org"}
+
+// remoteBase is a base, resource or component of a kustomization that lives in a git repository.
+type remoteBase struct {
+	repo string
+	ref  string
+	path string
+}
+
+// gitInfo describes the repository of a remote base like a remote config dependency.
+func (r remoteBase) gitInfo() latest.GitInfo {
+	if commitSHA.MatchString(r.ref) {
+		return latest.GitInfo{Repo: r.repo, Commit: r.ref}
+	}
+	return latest.GitInfo{Repo: r.repo, Ref: r.ref}
+}
+
+// parseRemoteBase parses the git references that kustomize understands, like
+// `github.com/org/repo/path?ref=v1`, `https://example.com/org/repo.git//path?ref=v1`
+// or `git@github.com:org/repo.git/path`.
+// Any other string, like a URL to a single file, isn't a remote base.
+func parseRemoteBase(s string) (remoteBase, bool) {
+	if filepath.IsAbs(s) {
+		return remoteBase{}, false
+	}
+
+	var ref string
+	if i := strings.Index(s, "?"); i >= 0 {
+		query, err := url.ParseQuery(s[i+1:])
+		if err != nil {
+			return remoteBase{}, false
+		}
+		ref = query.Get("ref")
+		if ref == "" {
+			ref = query.Get("version")
+		}
+		s = s[:i]
+	}
+	s = strings.TrimPrefix(s, "git::")
+
+	// prefix is the part of the repository url before its path, like `https://github.com/`.
+	var prefix, host, rest string
+	switch {
+	case strings.HasPrefix(s, "https://"), strings.HasPrefix(s, "http://"), strings.HasPrefix(s, "ssh://"):
+		scheme := s[:strings.Index(s, "://")+3]
+		parts := strings.SplitN(s[len(scheme):], "/", 2)
+		if len(parts) < 2 {
+			return remoteBase{}, false
+		}
+		host, rest = parts[0], parts[1]
+		prefix = scheme + host + "/"
+	case strings.HasPrefix(s, "git@"):
+		i := strings.Index(s, ":")
+		if i < 0 {
+			return remoteBase{}, false
+		}
+		host, rest = s[len("git@"):i], s[i+1:]
+		prefix = s[:i+1]
+	case strings.HasPrefix(s, "gh:"):
+		host, rest = "github.com", s[len("gh:"):]
+		prefix = "https://github.com/"
+	default:
+		parts := strings.SplitN(s, "/", 2)
+		if len(parts) < 2 || !isKnownGitHost(parts[0]) {
+			return remoteBase{}, false
+		}
+		host, rest = parts[0], parts[1]
+		prefix = "https://" + host + "/"
+	}
+
+	var repo, path string
+	switch {
+	case strings.Contains(rest, "//"):
+		parts := strings.SplitN(rest, "//", 2)
+		repo, path = parts[0], parts[1]
+	case strings.Contains(rest, "_git/"):
+		i := strings.Index(rest, "_git/") + len("_git/")
+		parts := strings.SplitN(rest[i:], "/", 2)
+		repo = rest[:i] + parts[0]
+		if len(parts) > 1 {
+			path = parts[1]
+		}
+	case strings.HasSuffix(rest, ".git") || strings.Contains(rest, ".git/"):
+		i := strings.Index(rest, ".git") + len(".git")
+		repo, path = rest[:i], rest[i:]
+	case isKnownGitHost(host) || strings.HasPrefix(prefix, "git@") || ref != "":
+		// Like kustomize, assume the `org/repo/path` layout.
+		parts := strings.SplitN(rest, "/", 3)
+		if len(parts) < 2 {
+			return remoteBase{}, false
+		}
+		repo = parts[0] + "/" + parts[1]
+		if len(parts) > 2 {
+			path = parts[2]
+		}
+	default:
+		// Most likely a url to a single file
+		return remoteBase{}, false
+	}
+
+	if repo == "" {
+		return remoteBase{}, false
+	}
+	if isKnownGitHost(host) && !strings.HasSuffix(repo, ".git") {
+		repo += ".git"
+	}
+
+	return remoteBase{repo: prefix + repo, ref: ref, path: strings.Trim(path, "/")}, true
+}
+
+func isKnownGitHost(host string) bool {
+	for _, known := range knownGitHosts {
+		if host == known {
+			return true
+		}
+	}
+	return false
+}
+
+// remoteBases syncs the remote bases of kustomizations into skaffold's repository cache,
+// the same one that remote config dependencies use, instead of letting kustomize clone them
+// into a temporary directory on every build.
+// Each repository is synced at most once per Deployer.
+type remoteBases struct {
+	opts config.SkaffoldOptions
+
+	mu    sync.Mutex
+	repos map[latest.GitInfo]string
+}
+
+func newRemoteBases(cfg Config) *remoteBases {
+	return &remoteBases{
+		opts: config.SkaffoldOptions{
+			RepoCacheDir:    cfg.RepoCacheDir(),
+			RemoteCacheOnly: cfg.RemoteCacheOnly(),
+		},
+		repos: map[latest.GitInfo]string{},
+	}
+}
+
+// sync returns the directory of the remote base in the cache.
+func (r *remoteBases) sync(base remoteBase) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	g := base.gitInfo()
+	dir, found := r.repos[g]
+	if !found {
+		var err error
+		if dir, err = git.SyncRepo(g, r.opts); err != nil {
+			return "", fmt.Errorf("fetching remote base %s: %w", base.repo, err)
+		}
+		r.repos[g] = dir
+	}
+	return filepath.Join(dir, filepath.FromSlash(base.path)), nil
+}
+
+// isCached returns true if the file is in the cache.
+func (r *remoteBases) isCached(path string) bool {
+	cacheDir, err := config.GetRepoCacheDir(r.opts)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(cacheDir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// rewrite replaces the remote bases, resources and components of the kustomization in `dir`
+// with relative paths to their copies in the cache.
+// The kustomization is returned unchanged if it doesn't reference any remote base.
+func (r *remoteBases) rewrite(dir string, buf []byte) ([]byte, error) {
+	var content map[string]interface{}
+	if err := k8syaml.Unmarshal(buf, &content); err != nil {
+		// Let kustomize report the error
+		return buf, nil
+	}
+
+	changed := false
+	for _, field := range []string{"bases", "resources", "components"} {
+		entries, ok := content[field].([]interface{})
+		if !ok {
+			continue
+		}
+		for i, entry := range entries {
+			s, ok := entry.(string)
+			if !ok {
+				continue
+			}
+			if local, _ := pathExistsLocally(s, dir); local {
+				continue
+			}
+			base, ok := parseRemoteBase(s)
+			if !ok {
+				continue
+			}
+			cached, err := r.sync(base)
+			if err != nil {
+				return nil, err
+			}
+			rel, err := filepath.Rel(dir, cached)
+			if err != nil {
+				// Let kustomize clone the base itself
+				continue
+			}
+			entries[i] = filepath.ToSlash(rel)
+			changed = true
+		}
+	}
+
+	if !changed {
+		return buf, nil
+	}
+	return k8syaml.Marshal(content)
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kustomize
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/git"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestParseRemoteBase(t *testing.T) {
+	tests := []struct {
+		description string
+		url         string
+		expected    remoteBase
+		notRemote   bool
+	}{
+		{
+			description: "github shorthand",
+			url:         "github.com/org/repo/config/base?ref=v1.0.0",
+			expected:    remoteBase{repo: "https://github.com/org/repo.git", ref: "v1.0.0", path: "config/base"},
+		},
+		{
+			description: "github shorthand without path",
+			url:         "github.com/org/repo",
+			expected:    remoteBase{repo: "https://github.com/org/repo.git"},
+		},
+		{
+			description: "https with subdirectory separator",
+			url:         "https://example.com/org/repo.git//config/base?ref=main",
+			expected:    remoteBase{repo: "https://example.com/org/repo.git", ref: "main", path: "config/base"},
+		},
+		{
+			description: "https with .git suffix",
+			url:         "https://example.com/org/repo.git/config/base",
+			expected:    remoteBase{repo: "https://example.com/org/repo.git", path: "config/base"},
+		},
+		{
+			description: "go-getter style",
+			url:         "git::https://gitlab.com/org/repo//base?version=v2",
+			expected:    remoteBase{repo: "https://gitlab.com/org/repo.git", ref: "v2", path: "base"},
+		},
+		{
+			description: "ssh",
+			url:         "git@github.com:org/repo.git/base",
+			expected:    remoteBase{repo: "git@github.com:org/repo.git", path: "base"},
+		},
+		{
+			description: "azure devops",
+			url:         "https://dev.azure.com/org/project/_git/repo/base",
+			expected:    remoteBase{repo: "https://dev.azure.com/org/project/_git/repo", path: "base"},
+		},
+		{
+			description: "single remote file",
+			url:         "https://raw.githubusercontent.com/org/repo/main/deployment.yaml",
+			notRemote:   true,
+		},
+		{
+			description: "local directory",
+			url:         "../base",
+			notRemote:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			base, ok := parseRemoteBase(test.url)
+
+			t.CheckDeepEqual(!test.notRemote, ok)
+			t.CheckDeepEqual(test.expected, base, cmp.AllowUnexported(remoteBase{}))
+		})
+	}
+}
+
+func TestRemoteBases(t *testing.T) {
+	tests := []struct {
+		description string
+		syncErr     error
+		shouldErr   bool
+	}{
+		{
+			description: "remote base is synced once and not listed as a dependency",
+		},
+		{
+			description: "sync error",
+			syncErr:     errors.New("BUG"),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().WriteFiles(map[string]string{
+				"app/kustomization.yaml":             "resources:\n- github.com/org/repo/base?ref=v1\nnamePrefix: dev-",
+				"cache/repo/base/kustomization.yaml": "resources: [pod.yaml]",
+				"cache/repo/base/pod.yaml": `apiVersion: v1
+kind: Pod
+metadata:
+  name: web`,
+			})
+			var synced []latest.GitInfo
+			t.Override(&git.SyncRepo, func(g latest.GitInfo, opts config.SkaffoldOptions) (string, error) {
+				t.CheckDeepEqual(tmpDir.Path("cache"), opts.RepoCacheDir)
+				synced = append(synced, g)
+				return tmpDir.Path("cache/repo"), test.syncErr
+			})
+
+			k, err := NewDeployer(&kustomizeConfig{
+				RunContext: runcontext.RunContext{Opts: config.SkaffoldOptions{RepoCacheDir: tmpDir.Path("cache")}},
+			}, nil, &latest.KustomizeDeploy{KustomizePaths: []string{tmpDir.Path("app")}}, nil, nil)
+			t.RequireNoError(err)
+
+			manifests, err := k.readManifests()
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				return
+			}
+			t.CheckDeepEqual("apiVersion: v1\nkind: Pod\nmetadata:\n  name: dev-web", manifests.String())
+			_, err = k.readManifests()
+			t.CheckNoError(err)
+			deps, err := k.Dependencies()
+			t.CheckNoError(err)
+
+			t.CheckDeepEqual([]latest.GitInfo{{Repo: "https://github.com/org/repo.git", Ref: "v1"}}, synced)
+			t.CheckDeepEqual([]string{tmpDir.Path("app/kustomization.yaml")}, deps)
+		})
+	}
+}
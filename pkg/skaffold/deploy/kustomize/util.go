@@ -114,6 +114,50 @@ func DependenciesForKustomization(dir string) ([]string, error) {
 	return deps, nil
 }
 
+// remoteReference returns the first base, resource, component or helm chart of a kustomization,
+// or of the kustomizations it references, that kustomize would need to download.
+func remoteReference(dir string) (string, error) {
+	path, err := FindKustomizationConfig(dir)
+	if err != nil {
+		return dir, nil
+	}
+
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	content := kustomization{}
+	if err := yaml.Unmarshal(buf, &content); err != nil {
+		return "", err
+	}
+
+	for _, chart := range content.HelmCharts {
+		if chart.Repo != "" {
+			return fmt.Sprintf("helm chart %s from %s", chart.Name, chart.Repo), nil
+		}
+	}
+
+	candidates := append(content.Bases, content.Resources...)
+	candidates = append(candidates, content.Components...)
+
+	for _, candidate := range candidates {
+		local, mode := pathExistsLocally(candidate, dir)
+		if !local {
+			return candidate, nil
+		}
+
+		if mode.IsDir() {
+			remote, err := remoteReference(filepath.Join(dir, candidate))
+			if remote != "" || err != nil {
+				return remote, err
+			}
+		}
+	}
+
+	return "", nil
+}
+
 // FindKustomizationConfig finds the kustomization config relative to the provided dir.
 // A Kustomization config must be at the root of the directory. Kustomize will
 // error if more than one of these files exists so order doesn't matter.
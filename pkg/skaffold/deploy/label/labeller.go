@@ -18,23 +18,50 @@ package label
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
 const (
 	K8sManagedByLabelKey = "app.kubernetes.io/managed-by"
 	RunIDLabel           = "skaffold.dev/run-id"
+
+	// UserLabel records the user who deployed a resource, which helps to find the owner of orphaned resources.
+	UserLabel = "skaffold.dev/user"
+
+	// TTLAnnotation is the duration after which a deployed resource can be garbage collected by another Skaffold run.
+	TTLAnnotation = "skaffold.dev/ttl"
+
+	// DeployedAtAnnotation records when a resource was last deployed. The TTL and the age of a resource start then.
+	DeployedAtAnnotation = "skaffold.dev/deployed-at"
+
+	// EphemeralNamespaceLabel marks the namespaces that Skaffold creates for a session.
+	EphemeralNamespaceLabel = "skaffold.dev/ephemeral-namespace"
 )
 
-var runID = uuid.New().String()
+const maxLabelValueLength = 63
+
+var (
+	runID = uuid.New().String()
+
+	invalidLabelValueChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+	// for testing
+	currentUser = util.CurrentUsername
+)
 
 // DefaultLabeller adds K8s style managed-by label and a run-specific UUID label
 type DefaultLabeller struct {
 	addSkaffoldLabels bool
 	customLabels      []string
 	runID             string
+	user              string
+	deployedAt        time.Time
 }
 
 func NewLabeller(addSkaffoldLabels bool, customLabels []string) *DefaultLabeller {
@@ -42,6 +69,7 @@ func NewLabeller(addSkaffoldLabels bool, customLabels []string) *DefaultLabeller
 		addSkaffoldLabels: addSkaffoldLabels,
 		customLabels:      customLabels,
 		runID:             runID,
+		user:              userLabelValue(),
 	}
 }
 
@@ -51,6 +79,9 @@ func (d *DefaultLabeller) Labels() map[string]string {
 	if d.addSkaffoldLabels {
 		labels[K8sManagedByLabelKey] = "skaffold"
 		labels[RunIDLabel] = d.runID
+		if d.user != "" {
+			labels[UserLabel] = d.user
+		}
 	}
 
 	for _, cl := range d.customLabels {
@@ -65,6 +96,20 @@ func (d *DefaultLabeller) Labels() map[string]string {
 	return labels
 }
 
+// StampDeployment records the time of the current deployment, which Annotations stamps on the deployed resources.
+func (d *DefaultLabeller) StampDeployment(t time.Time) {
+	d.deployedAt = t
+}
+
+// Annotations returns the annotations to add to the deployed resources: the time they were deployed at.
+// There are none until a deployment is stamped, so that rendering gives the same manifests each time.
+func (d *DefaultLabeller) Annotations() map[string]string {
+	if !d.addSkaffoldLabels || d.deployedAt.IsZero() {
+		return nil
+	}
+	return map[string]string{DeployedAtAnnotation: d.deployedAt.UTC().Format(time.RFC3339)}
+}
+
 func (d *DefaultLabeller) RunIDSelector() string {
 	return fmt.Sprintf("%s=%s", RunIDLabel, d.Labels()[RunIDLabel])
 }
@@ -72,3 +117,17 @@ func (d *DefaultLabeller) RunIDSelector() string {
 func (d *DefaultLabeller) GetRunID() string {
 	return d.runID
 }
+
+// userLabelValue turns the name of the current user into a valid label value.
+// It's empty if the user is unknown.
+func userLabelValue() string {
+	name, err := currentUser()
+	if err != nil {
+		return ""
+	}
+	name = invalidLabelValueChars.ReplaceAllString(name, "-")
+	if len(name) > maxLabelValueLength {
+		name = name[:maxLabelValueLength]
+	}
+	return strings.Trim(name, "-_.")
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package label
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestUserLabel(t *testing.T) {
+	tests := []struct {
+		description string
+		user        string
+		userErr     error
+		expected    string
+	}{
+		{
+			description: "plain user name",
+			user:        "jane",
+			expected:    "jane",
+		},
+		{
+			description: "invalid characters are replaced",
+			user:        "Jane Doe@corp",
+			expected:    "Jane-Doe-corp",
+		},
+		{
+			description: "value is trimmed",
+			user:        "_jane." + strings.Repeat("x", 70),
+			expected:    "jane." + strings.Repeat("x", 57),
+		},
+		{
+			description: "unknown user",
+			userErr:     errors.New("unable to find the current user"),
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&currentUser, func() (string, error) { return test.user, test.userErr })
+
+			labels := NewLabeller(true, nil).Labels()
+
+			user, found := labels[UserLabel]
+			t.CheckDeepEqual(test.expected != "", found)
+			t.CheckDeepEqual(test.expected, user)
+			t.CheckDeepEqual("skaffold", labels[K8sManagedByLabelKey])
+		})
+	}
+}
+
+func TestDeployedAtAnnotation(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		labeller := NewLabeller(true, nil)
+		t.CheckDeepEqual(map[string]string(nil), labeller.Annotations())
+
+		labeller.StampDeployment(time.Date(2021, 3, 1, 11, 0, 0, 0, time.FixedZone("CET", 3600)))
+		t.CheckDeepEqual(map[string]string{DeployedAtAnnotation: "2021-03-01T10:00:00Z"}, labeller.Annotations())
+
+		withoutSkaffoldLabels := NewLabeller(false, nil)
+		withoutSkaffoldLabels.StampDeployment(time.Now())
+		t.CheckDeepEqual(map[string]string(nil), withoutSkaffoldLabels.Annotations())
+	})
+}
@@ -42,19 +42,20 @@ const (
 	sleeptime = 300 * time.Millisecond
 )
 
-// Apply applies all provided labels to the created Kubernetes resources
-func Apply(ctx context.Context, labels map[string]string, results []deploy.Artifact) error {
-	if len(labels) == 0 {
+// Apply applies all provided labels and annotations to the created Kubernetes resources of the cluster of the given kube-context.
+// An empty kube-context stands for the active one.
+func Apply(ctx context.Context, kubeContext string, labels, annotations map[string]string, results []deploy.Artifact) error {
+	if len(labels) == 0 && len(annotations) == 0 {
 		return nil
 	}
 
 	// use the kubectl client to update all k8s objects with a skaffold watermark
-	dynClient, err := kubernetesclient.DynamicClient()
+	dynClient, err := kubernetesclient.DynamicClientForContext(kubeContext)
 	if err != nil {
 		return fmt.Errorf("error getting Kubernetes dynamic client: %w", err)
 	}
 
-	client, err := kubernetesclient.Client()
+	client, err := kubernetesclient.ClientForContext(kubeContext)
 	if err != nil {
 		return fmt.Errorf("error getting Kubernetes client: %w", err)
 	}
@@ -62,7 +63,7 @@ func Apply(ctx context.Context, labels map[string]string, results []deploy.Artif
 	for _, res := range results {
 		err = nil
 		for i := 0; i < tries; i++ {
-			if err = updateRuntimeObject(ctx, dynClient, client.Discovery(), labels, res); err == nil {
+			if err = updateRuntimeObject(ctx, kubeContext, dynClient, client.Discovery(), labels, annotations, res); err == nil {
 				break
 			}
 			time.Sleep(sleeptime)
@@ -84,7 +85,20 @@ func addLabels(labels map[string]string, accessor metav1.Object) {
 	accessor.SetLabels(kv)
 }
 
-func updateRuntimeObject(ctx context.Context, client dynamic.Interface, disco discovery.DiscoveryInterface, labels map[string]string, res deploy.Artifact) error {
+// addAnnotations overwrites the annotations of a previous deployment.
+func addAnnotations(annotations map[string]string, accessor metav1.Object) {
+	if len(annotations) == 0 {
+		return
+	}
+	kv := make(map[string]string)
+
+	copyMap(kv, accessor.GetAnnotations())
+	copyMap(kv, annotations)
+
+	accessor.SetAnnotations(kv)
+}
+
+func updateRuntimeObject(ctx context.Context, kubeContext string, client dynamic.Interface, disco discovery.DiscoveryInterface, labels, annotations map[string]string, res deploy.Artifact) error {
 	originalJSON, _ := json.Marshal(res.Obj)
 	modifiedObj := res.Obj.DeepCopyObject()
 	accessor, err := meta.Accessor(modifiedObj)
@@ -94,6 +108,7 @@ func updateRuntimeObject(ctx context.Context, client dynamic.Interface, disco di
 	name := accessor.GetName()
 
 	addLabels(labels, accessor)
+	addAnnotations(annotations, accessor)
 
 	modifiedJSON, _ := json.Marshal(modifiedObj)
 	p, _ := patch.CreateTwoWayMergePatch(originalJSON, modifiedJSON, modifiedObj)
@@ -111,7 +126,7 @@ func updateRuntimeObject(ctx context.Context, client dynamic.Interface, disco di
 			namespace = res.Namespace
 		}
 
-		ns, err := resolveNamespace(namespace)
+		ns, err := resolveNamespace(kubeContext, namespace)
 		if err != nil {
 			return fmt.Errorf("resolving namespace: %w", err)
 		}
@@ -130,7 +145,7 @@ func updateRuntimeObject(ctx context.Context, client dynamic.Interface, disco di
 	return nil
 }
 
-func resolveNamespace(ns string) (string, error) {
+func resolveNamespace(kubeContext, ns string) (string, error) {
 	if ns != "" {
 		return ns, nil
 	}
@@ -139,7 +154,10 @@ func resolveNamespace(ns string) (string, error) {
 		return "", fmt.Errorf("getting kubeconfig: %w", err)
 	}
 
-	current, present := cfg.Contexts[cfg.CurrentContext]
+	if kubeContext == "" {
+		kubeContext = cfg.CurrentContext
+	}
+	current, present := cfg.Contexts[kubeContext]
 	if present && current.Namespace != "" {
 		return current.Namespace, nil
 	}
@@ -108,7 +108,7 @@ func TestApplyLabels(t *testing.T) {
 			t.Override(&kubernetesclient.DynamicClient, mockDynamicClient(dynClient))
 
 			// Patch labels
-			Apply(context.Background(), test.appliedLabels, []types.Artifact{{Obj: dep}})
+			Apply(context.Background(), "", test.appliedLabels, nil, []types.Artifact{{Obj: dep}})
 
 			// Check modified value
 			modified, err := dynClient.Resource(schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}).Get(context.Background(), "foo", metav1.GetOptions{})
@@ -64,6 +64,7 @@ type Deployment struct {
 	deadline     time.Duration
 	pods         map[string]validator.Resource
 	podValidator diag.Diagnose
+	kubeContext  string
 }
 
 func (d *Deployment) Deadline() time.Duration {
@@ -100,6 +101,12 @@ func (d *Deployment) WithValidator(pd diag.Diagnose) *Deployment {
 	return d
 }
 
+// WithKubeContext sets the kube-context that events about the deployment are labelled with.
+func (d *Deployment) WithKubeContext(kubeContext string) *Deployment {
+	d.kubeContext = kubeContext
+	return d
+}
+
 func (d *Deployment) CheckStatus(ctx context.Context, cfg kubectl.Config) {
 	kubeCtl := kubectl.NewCLI(cfg, "")
 
@@ -274,9 +281,9 @@ func (d *Deployment) fetchPods(ctx context.Context) error {
 			switch p.ActionableError().ErrCode {
 			case proto.StatusCode_STATUSCHECK_CONTAINER_CREATING,
 				proto.StatusCode_STATUSCHECK_POD_INITIALIZING:
-				event.ResourceStatusCheckEventUpdated(p.String(), p.ActionableError())
+				event.ResourceStatusCheckEventUpdated(p.String(), p.ActionableError(), d.kubeContext)
 			default:
-				event.ResourceStatusCheckEventCompleted(p.String(), p.ActionableError())
+				event.ResourceStatusCheckEventCompleted(p.String(), p.ActionableError(), d.kubeContext)
 			}
 		}
 		newPods[p.String()] = p
@@ -81,15 +81,19 @@ type statusChecker struct {
 	labeller        *label.DefaultLabeller
 	deadlineSeconds int
 	muteLogs        bool
+	kubeContext     string
 }
 
 // NewStatusChecker returns a status checker which runs checks on deployments and pods.
-func NewStatusChecker(cfg Config, labeller *label.DefaultLabeller) Checker {
+// `kubeContext` is the kube-context of the cluster to check when Skaffold deploys to several clusters.
+// It's empty otherwise, and the active kube-context is used.
+func NewStatusChecker(cfg Config, labeller *label.DefaultLabeller, kubeContext string) Checker {
 	return statusChecker{
 		muteLogs:        cfg.Muted().MuteStatusCheck(),
 		cfg:             cfg,
 		labeller:        labeller,
 		deadlineSeconds: cfg.StatusCheckDeadlineSeconds(),
+		kubeContext:     kubeContext,
 	}
 }
 
@@ -102,7 +106,7 @@ func (s statusChecker) Check(ctx context.Context, out io.Writer) error {
 }
 
 func (s statusChecker) statusCheck(ctx context.Context, out io.Writer) (proto.StatusCode, error) {
-	client, err := kubernetesclient.Client()
+	client, err := kubernetesclient.ClientForContext(s.kubeContext)
 	if err != nil {
 		return proto.StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR, fmt.Errorf("getting Kubernetes client: %w", err)
 	}
@@ -114,6 +118,9 @@ func (s statusChecker) statusCheck(ctx context.Context, out io.Writer) (proto.St
 		if err != nil {
 			return proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR, fmt.Errorf("could not fetch deployments: %w", err)
 		}
+		for _, d := range newDeployments {
+			d.WithKubeContext(s.kubeContext)
+		}
 		deployments = append(deployments, newDeployments...)
 	}
 
@@ -245,7 +252,7 @@ func (s statusChecker) printStatusCheckSummary(out io.Writer, r *resource.Deploy
 		// another deployment failed
 		return
 	}
-	event.ResourceStatusCheckEventCompleted(r.String(), ae)
+	event.ResourceStatusCheckEventCompleted(r.String(), ae, s.kubeContext)
 	status := fmt.Sprintf("%s %s", tabHeader, r)
 	if ae.ErrCode != proto.StatusCode_STATUSCHECK_SUCCESS {
 		if str := r.ReportSinceLastUpdated(s.muteLogs); str != "" {
@@ -286,7 +293,7 @@ func (s statusChecker) printStatus(deployments []*resource.Deployment, out io.Wr
 		}
 		allDone = false
 		if str := r.ReportSinceLastUpdated(s.muteLogs); str != "" {
-			event.ResourceStatusCheckEventUpdated(r.String(), r.Status().ActionableError())
+			event.ResourceStatusCheckEventUpdated(r.String(), r.Status().ActionableError(), s.kubeContext)
 			fmt.Fprintln(out, trimNewLine(str))
 		}
 	}
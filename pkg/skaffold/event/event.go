@@ -19,8 +19,10 @@ package event
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"sync"
 
 	//nolint:golint,staticcheck
@@ -29,6 +31,7 @@ import (
 	"github.com/golang/protobuf/ptypes/timestamp"
 
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/instrumentation"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
@@ -48,11 +51,17 @@ const (
 	Canceled   = "Canceled"
 )
 
-var handler = newHandler()
+// listenerBufferSize is the number of entries queued for a client of the event API.
+const listenerBufferSize = 1000
 
-func newHandler() *eventHandler {
+var handler = newHandler(eventV2.HandleV1)
+
+// newHandler creates a handler that passes every event on to `handleV2`,
+// to record it in the version 2 of the API.
+func newHandler(handleV2 func(*proto.Event, *timestamp.Timestamp)) *eventHandler {
 	h := &eventHandler{
 		eventChan: make(chan firedEvent),
+		handleV2:  handleV2,
 	}
 	go func() {
 		for {
@@ -74,6 +83,7 @@ type eventHandler struct {
 	stateLock sync.Mutex
 	eventChan chan firedEvent
 	listeners []*listener
+	handleV2  func(*proto.Event, *timestamp.Timestamp)
 }
 
 type firedEvent struct {
@@ -81,10 +91,12 @@ type firedEvent struct {
 	ts    *timestamp.Timestamp
 }
 
+// listener receives the entries to send to a client of the event API.
+// Entries are queued so that a slow client doesn't block Skaffold.
 type listener struct {
-	callback func(*proto.LogEntry) error
-	errors   chan error
-	closed   bool
+	entries chan *proto.LogEntry
+	errors  chan error
+	closed  bool
 }
 
 func GetState() (*proto.State, error) {
@@ -118,25 +130,36 @@ func (ev *eventHandler) getState() proto.State {
 func (ev *eventHandler) logEvent(entry proto.LogEntry) {
 	ev.logLock.Lock()
 
+	ev.broadcast(&entry, false)
+	ev.eventLog = append(ev.eventLog, entry)
+
+	ev.logLock.Unlock()
+}
+
+// broadcast queues an entry for the current listeners. It must be called with the log lock held.
+// When a listener's queue is full, the entry is dropped if `droppable` is true.
+// Otherwise, the listener is disconnected: it would miss the entry.
+func (ev *eventHandler) broadcast(entry *proto.LogEntry, droppable bool) {
 	for _, listener := range ev.listeners {
 		if listener.closed {
 			continue
 		}
 
-		if err := listener.callback(&entry); err != nil {
-			listener.errors <- err
-			listener.closed = true
+		select {
+		case listener.entries <- entry:
+		default:
+			if !droppable {
+				listener.errors <- errors.New("the client is too slow to receive the events")
+				listener.closed = true
+			}
 		}
 	}
-	ev.eventLog = append(ev.eventLog, entry)
-
-	ev.logLock.Unlock()
 }
 
 func (ev *eventHandler) forEachEvent(callback func(*proto.LogEntry) error) error {
 	listener := &listener{
-		callback: callback,
-		errors:   make(chan error),
+		entries: make(chan *proto.LogEntry, listenerBufferSize),
+		errors:  make(chan error, 1),
 	}
 
 	ev.logLock.Lock()
@@ -147,14 +170,37 @@ func (ev *eventHandler) forEachEvent(callback func(*proto.LogEntry) error) error
 
 	ev.logLock.Unlock()
 
+	defer ev.removeListener(listener)
+
 	for i := range oldEvents {
 		if err := callback(&oldEvents[i]); err != nil {
-			// listener should maybe be closed
 			return err
 		}
 	}
 
-	return <-listener.errors
+	for {
+		select {
+		case entry := <-listener.entries:
+			if err := callback(entry); err != nil {
+				return err
+			}
+		case err := <-listener.errors:
+			return err
+		}
+	}
+}
+
+// removeListener stops sending entries to a listener.
+func (ev *eventHandler) removeListener(l *listener) {
+	ev.logLock.Lock()
+	defer ev.logLock.Unlock()
+
+	for i, listener := range ev.listeners {
+		if listener == l {
+			ev.listeners = append(ev.listeners[:i], ev.listeners[i+1:]...)
+			return
+		}
+	}
 }
 
 func emptyState(pipelines []latest.Pipeline, kubeContext string, autoBuild, autoDeploy, autoSync bool) proto.State {
@@ -262,40 +308,47 @@ func StatusCheckEventInProgress(s string) {
 	})
 }
 
-func ResourceStatusCheckEventCompleted(r string, ae proto.ActionableErr) {
+// ResourceStatusCheckEventCompleted notifies that the status check of a resource succeeded or failed.
+// `kubeContext` is only set when Skaffold deploys to several kube-contexts.
+func ResourceStatusCheckEventCompleted(r string, ae proto.ActionableErr, kubeContext string) {
 	if ae.ErrCode != proto.StatusCode_STATUSCHECK_SUCCESS {
-		resourceStatusCheckEventFailed(r, ae)
+		resourceStatusCheckEventFailed(r, ae, kubeContext)
 		return
 	}
-	resourceStatusCheckEventSucceeded(r)
+	resourceStatusCheckEventSucceeded(r, kubeContext)
 }
 
-func resourceStatusCheckEventSucceeded(r string) {
+func resourceStatusCheckEventSucceeded(r string, kubeContext string) {
 	handler.handleResourceStatusCheckEvent(&proto.ResourceStatusCheckEvent{
-		Resource:   r,
-		Status:     Succeeded,
-		Message:    Succeeded,
-		StatusCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+		Resource:    r,
+		Status:      Succeeded,
+		Message:     Succeeded,
+		StatusCode:  proto.StatusCode_STATUSCHECK_SUCCESS,
+		KubeContext: kubeContext,
 	})
 }
 
-func resourceStatusCheckEventFailed(r string, ae proto.ActionableErr) {
+func resourceStatusCheckEventFailed(r string, ae proto.ActionableErr, kubeContext string) {
 	handler.handleResourceStatusCheckEvent(&proto.ResourceStatusCheckEvent{
 		Resource:      r,
 		Status:        Failed,
 		Err:           ae.Message,
 		StatusCode:    ae.ErrCode,
 		ActionableErr: &ae,
+		KubeContext:   kubeContext,
 	})
 }
 
-func ResourceStatusCheckEventUpdated(r string, ae proto.ActionableErr) {
+// ResourceStatusCheckEventUpdated notifies that the status of a resource changed while it's checked.
+// `kubeContext` is only set when Skaffold deploys to several kube-contexts.
+func ResourceStatusCheckEventUpdated(r string, ae proto.ActionableErr, kubeContext string) {
 	handler.handleResourceStatusCheckEvent(&proto.ResourceStatusCheckEvent{
 		Resource:      r,
 		Status:        InProgress,
 		Message:       ae.Message,
 		StatusCode:    ae.ErrCode,
 		ActionableErr: &ae,
+		KubeContext:   kubeContext,
 	})
 }
 
@@ -394,7 +447,8 @@ func FileSyncSucceeded(fileCount int, image string) {
 }
 
 // PortForwarded notifies that a remote port has been forwarded locally.
-func PortForwarded(localPort int32, remotePort util.IntOrString, podName, containerName, namespace string, portName string, resourceType, resourceName, address string) {
+// `kubeContext` is only set when Skaffold deploys to several kube-contexts.
+func PortForwarded(localPort int32, remotePort util.IntOrString, podName, containerName, namespace string, portName string, resourceType, resourceName, address, kubeContext string) {
 	event := proto.PortEvent{
 		LocalPort:     localPort,
 		PodName:       podName,
@@ -404,6 +458,7 @@ func PortForwarded(localPort int32, remotePort util.IntOrString, podName, contai
 		ResourceType:  resourceType,
 		ResourceName:  resourceName,
 		Address:       address,
+		KubeContext:   kubeContext,
 		TargetPort: &proto.IntOrString{
 			Type:   int32(remotePort.Type),
 			IntVal: int32(remotePort.IntVal),
@@ -456,6 +511,40 @@ func DebuggingContainerTerminated(podName, containerName, namespace, artifact, r
 	})
 }
 
+// KubernetesEventReceived notifies that a warning event was reported, or that a container terminated abnormally,
+// for a resource deployed by Skaffold.
+func KubernetesEventReceived(event *proto.KubernetesEvent) {
+	handler.handle(&proto.Event{
+		EventType: &proto.Event_KubernetesEvent{
+			KubernetesEvent: event,
+		},
+	})
+}
+
+// ApplicationLogReceived notifies the current listeners of a log line of a deployed container.
+// Log lines aren't kept in the event log, and are dropped for the listeners that are too slow to receive them.
+// `kubeContext` is only set when Skaffold deploys to several kube-contexts.
+func ApplicationLogReceived(podName, containerName, namespace, message, kubeContext string) {
+	handler.logLock.Lock()
+	defer handler.logLock.Unlock()
+
+	handler.broadcast(&proto.LogEntry{
+		Timestamp: ptypes.TimestampNow(),
+		Event: &proto.Event{
+			EventType: &proto.Event_ApplicationLogEvent{
+				ApplicationLogEvent: &proto.ApplicationLogEvent{
+					PodName:       podName,
+					ContainerName: containerName,
+					Namespace:     namespace,
+					Message:       message,
+					KubeContext:   kubeContext,
+				},
+			},
+		},
+		Entry: message,
+	}, true)
+}
+
 func (ev *eventHandler) setState(state proto.State) {
 	ev.stateLock.Lock()
 	ev.state = state
@@ -600,7 +689,7 @@ func (ev *eventHandler) handleExec(f firedEvent) {
 		}
 	case *proto.Event_ResourceStatusCheckEvent:
 		rse := e.ResourceStatusCheckEvent
-		rseName := rse.Resource
+		rseName := qualifiedName(rse.KubeContext, rse.Resource)
 		ev.stateLock.Lock()
 		ev.state.StatusCheckState.Resources[rseName] = rse.Status
 		ev.stateLock.Unlock()
@@ -652,6 +741,9 @@ func (ev *eventHandler) handleExec(f firedEvent) {
 		case Terminated:
 			logEntry.Entry = fmt.Sprintf("Debuggable container terminated pod/%s:%s (%s)", de.PodName, de.ContainerName, de.Namespace)
 		}
+	case *proto.Event_KubernetesEvent:
+		ke := e.KubernetesEvent
+		logEntry.Entry = fmt.Sprintf("Kubernetes event %s for %s (%s): %s", ke.Reason, qualifiedName(ke.KubeContext, strings.ToLower(ke.Kind)+"/"+ke.Name), ke.Namespace, ke.Message)
 	case *proto.Event_DevLoopEvent:
 		de := e.DevLoopEvent
 		switch de.Status {
@@ -664,6 +756,15 @@ func (ev *eventHandler) handleExec(f firedEvent) {
 		}
 	}
 	ev.logEvent(*logEntry)
+	ev.handleV2(f.event, f.ts)
+}
+
+// qualifiedName prefixes the name of a resource with its kube-context, when there's one.
+func qualifiedName(kubeContext, name string) string {
+	if kubeContext == "" {
+		return name
+	}
+	return kubeContext + "/" + name
 }
 
 // ResetStateOnBuild resets the build, deploy and sync state
@@ -674,6 +775,8 @@ func ResetStateOnBuild() {
 	}
 	autoBuild, autoDeploy, autoSync := handler.getState().BuildState.AutoTrigger, handler.getState().DeployState.AutoTrigger, handler.getState().FileSyncState.AutoTrigger
 	newState := emptyStateWithArtifacts(builds, handler.getState().Metadata, autoBuild, autoDeploy, autoSync)
+	newState.Modules = handler.getState().Modules
+	newState.Deployers = handler.getState().Deployers
 	handler.setState(newState)
 }
 
@@ -706,6 +809,26 @@ func UpdateStateAutoSyncTrigger(t bool) {
 	handler.setState(newState)
 }
 
+// InitializeModules records the artifacts of the named config modules, and the names of the deployers.
+// The artifacts of all the modules are initially watched.
+func InitializeModules(modules map[string][]string, deployers []string) {
+	newState := handler.getState()
+	newState.Modules = map[string]*proto.ModuleState{}
+	for name, artifacts := range modules {
+		newState.Modules[name] = &proto.ModuleState{Watched: true, Artifacts: artifacts}
+	}
+	newState.Deployers = deployers
+	handler.setState(newState)
+}
+
+func UpdateStateModuleWatch(module string, watched bool) {
+	newState := handler.getState()
+	if m, found := newState.Modules[module]; found {
+		m.Watched = watched
+	}
+	handler.setState(newState)
+}
+
 func emptyStatusCheckState() *proto.StatusCheckState {
 	return &proto.StatusCheckState{
 		Status:     NotStarted,
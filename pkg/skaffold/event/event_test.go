@@ -31,6 +31,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
 	"github.com/GoogleContainerTools/skaffold/proto/v1"
@@ -39,9 +40,14 @@ import (
 
 var targetPort = proto.IntOrString{Type: 0, IntVal: 2001}
 
+// newTestHandler creates a handler that records the v2 events in its own log, so that tests don't share the global one.
+func newTestHandler() *eventHandler {
+	return newHandler(eventV2.NewV1Handler())
+}
+
 func TestGetLogEvents(t *testing.T) {
 	for step := 0; step < 1000; step++ {
-		ev := newHandler()
+		ev := newTestHandler()
 
 		ev.logEvent(proto.LogEntry{Entry: "OLD"})
 		go func() {
@@ -66,7 +72,7 @@ func TestGetLogEvents(t *testing.T) {
 }
 
 func TestGetState(t *testing.T) {
-	ev := newHandler()
+	ev := newTestHandler()
 	ev.state = emptyState([]latest.Pipeline{{}}, "test", true, true, true)
 
 	ev.stateLock.Lock()
@@ -79,9 +85,9 @@ func TestGetState(t *testing.T) {
 }
 
 func TestDeployInProgress(t *testing.T) {
-	defer func() { handler = newHandler() }()
+	defer func() { handler = newTestHandler() }()
 
-	handler = newHandler()
+	handler = newTestHandler()
 	handler.state = emptyState([]latest.Pipeline{{}}, "test", true, true, true)
 
 	wait(t, func() bool { return handler.getState().DeployState.Status == NotStarted })
@@ -90,9 +96,9 @@ func TestDeployInProgress(t *testing.T) {
 }
 
 func TestDeployFailed(t *testing.T) {
-	defer func() { handler = newHandler() }()
+	defer func() { handler = newTestHandler() }()
 
-	handler = newHandler()
+	handler = newTestHandler()
 	handler.state = emptyState([]latest.Pipeline{{}}, "test", true, true, true)
 
 	wait(t, func() bool { return handler.getState().DeployState.Status == NotStarted })
@@ -104,9 +110,9 @@ func TestDeployFailed(t *testing.T) {
 }
 
 func TestDeployComplete(t *testing.T) {
-	defer func() { handler = newHandler() }()
+	defer func() { handler = newTestHandler() }()
 
-	handler = newHandler()
+	handler = newTestHandler()
 	handler.state = emptyState([]latest.Pipeline{{}}, "test", true, true, true)
 
 	wait(t, func() bool { return handler.getState().DeployState.Status == NotStarted })
@@ -118,9 +124,9 @@ func TestDeployComplete(t *testing.T) {
 }
 
 func TestBuildInProgress(t *testing.T) {
-	defer func() { handler = newHandler() }()
+	defer func() { handler = newTestHandler() }()
 
-	handler = newHandler()
+	handler = newTestHandler()
 	handler.state = emptyState([]latest.Pipeline{{Build: latest.BuildConfig{
 		Artifacts: []*latest.Artifact{{
 			ImageName: "img",
@@ -133,9 +139,9 @@ func TestBuildInProgress(t *testing.T) {
 }
 
 func TestBuildFailed(t *testing.T) {
-	defer func() { handler = newHandler() }()
+	defer func() { handler = newTestHandler() }()
 
-	handler = newHandler()
+	handler = newTestHandler()
 	handler.state = emptyState([]latest.Pipeline{{Build: latest.BuildConfig{
 		Artifacts: []*latest.Artifact{{
 			ImageName: "img",
@@ -151,9 +157,9 @@ func TestBuildFailed(t *testing.T) {
 }
 
 func TestBuildComplete(t *testing.T) {
-	defer func() { handler = newHandler() }()
+	defer func() { handler = newTestHandler() }()
 
-	handler = newHandler()
+	handler = newTestHandler()
 	handler.state = emptyState([]latest.Pipeline{{Build: latest.BuildConfig{
 		Artifacts: []*latest.Artifact{{
 			ImageName: "img",
@@ -166,26 +172,26 @@ func TestBuildComplete(t *testing.T) {
 }
 
 func TestPortForwarded(t *testing.T) {
-	defer func() { handler = newHandler() }()
+	defer func() { handler = newTestHandler() }()
 
-	handler = newHandler()
+	handler = newTestHandler()
 	handler.state = emptyState([]latest.Pipeline{{}}, "test", true, true, true)
 
 	wait(t, func() bool { return handler.getState().ForwardedPorts[8080] == nil })
-	PortForwarded(8080, schemautil.FromInt(8888), "pod", "container", "ns", "portname", "resourceType", "resourceName", "127.0.0.1")
+	PortForwarded(8080, schemautil.FromInt(8888), "pod", "container", "ns", "portname", "resourceType", "resourceName", "127.0.0.1", "")
 	wait(t, func() bool {
 		return handler.getState().ForwardedPorts[8080] != nil && handler.getState().ForwardedPorts[8080].RemotePort == 8888
 	})
 
 	wait(t, func() bool { return handler.getState().ForwardedPorts[8081] == nil })
-	PortForwarded(8081, schemautil.FromString("http"), "pod", "container", "ns", "portname", "resourceType", "resourceName", "127.0.0.1")
+	PortForwarded(8081, schemautil.FromString("http"), "pod", "container", "ns", "portname", "resourceType", "resourceName", "127.0.0.1", "")
 	wait(t, func() bool { return handler.getState().ForwardedPorts[8081] != nil })
 }
 
 func TestStatusCheckEventStarted(t *testing.T) {
-	defer func() { handler = newHandler() }()
+	defer func() { handler = newTestHandler() }()
 
-	handler = newHandler()
+	handler = newTestHandler()
 	handler.state = emptyState([]latest.Pipeline{{}}, "test", true, true, true)
 
 	wait(t, func() bool { return handler.getState().StatusCheckState.Status == NotStarted })
@@ -194,9 +200,9 @@ func TestStatusCheckEventStarted(t *testing.T) {
 }
 
 func TestStatusCheckEventInProgress(t *testing.T) {
-	defer func() { handler = newHandler() }()
+	defer func() { handler = newTestHandler() }()
 
-	handler = newHandler()
+	handler = newTestHandler()
 	handler.state = emptyState([]latest.Pipeline{{}}, "test", true, true, true)
 
 	wait(t, func() bool { return handler.getState().StatusCheckState.Status == NotStarted })
@@ -205,9 +211,9 @@ func TestStatusCheckEventInProgress(t *testing.T) {
 }
 
 func TestStatusCheckEventSucceeded(t *testing.T) {
-	defer func() { handler = newHandler() }()
+	defer func() { handler = newTestHandler() }()
 
-	handler = newHandler()
+	handler = newTestHandler()
 	handler.state = emptyState([]latest.Pipeline{{}}, "test", true, true, true)
 
 	wait(t, func() bool { return handler.getState().StatusCheckState.Status == NotStarted })
@@ -216,9 +222,9 @@ func TestStatusCheckEventSucceeded(t *testing.T) {
 }
 
 func TestStatusCheckEventFailed(t *testing.T) {
-	defer func() { handler = newHandler() }()
+	defer func() { handler = newTestHandler() }()
 
-	handler = newHandler()
+	handler = newTestHandler()
 	handler.state = emptyState([]latest.Pipeline{{}}, "test", true, true, true)
 
 	wait(t, func() bool { return handler.getState().StatusCheckState.Status == NotStarted })
@@ -230,48 +236,55 @@ func TestStatusCheckEventFailed(t *testing.T) {
 }
 
 func TestResourceStatusCheckEventUpdated(t *testing.T) {
-	defer func() { handler = newHandler() }()
+	defer func() { handler = newTestHandler() }()
 
-	handler = newHandler()
+	handler = newTestHandler()
 	handler.state = emptyState([]latest.Pipeline{{}}, "test", true, true, true)
 
 	wait(t, func() bool { return handler.getState().StatusCheckState.Status == NotStarted })
 	ResourceStatusCheckEventUpdated("ns:pod/foo", proto.ActionableErr{
 		ErrCode: 509,
 		Message: "image pull error",
-	})
+	}, "")
 	wait(t, func() bool { return handler.getState().StatusCheckState.Resources["ns:pod/foo"] == InProgress })
+
+	// resources of different clusters are tracked separately
+	ResourceStatusCheckEventUpdated("ns:pod/foo", proto.ActionableErr{
+		ErrCode: 509,
+		Message: "image pull error",
+	}, "other")
+	wait(t, func() bool { return handler.getState().StatusCheckState.Resources["other/ns:pod/foo"] == InProgress })
 }
 
 func TestResourceStatusCheckEventSucceeded(t *testing.T) {
-	defer func() { handler = newHandler() }()
+	defer func() { handler = newTestHandler() }()
 
-	handler = newHandler()
+	handler = newTestHandler()
 	handler.state = emptyState([]latest.Pipeline{{}}, "test", true, true, true)
 
 	wait(t, func() bool { return handler.getState().StatusCheckState.Status == NotStarted })
-	resourceStatusCheckEventSucceeded("ns:pod/foo")
+	resourceStatusCheckEventSucceeded("ns:pod/foo", "")
 	wait(t, func() bool { return handler.getState().StatusCheckState.Resources["ns:pod/foo"] == Succeeded })
 }
 
 func TestResourceStatusCheckEventFailed(t *testing.T) {
-	defer func() { handler = newHandler() }()
+	defer func() { handler = newTestHandler() }()
 
-	handler = newHandler()
+	handler = newTestHandler()
 	handler.state = emptyState([]latest.Pipeline{{}}, "test", true, true, true)
 
 	wait(t, func() bool { return handler.getState().StatusCheckState.Status == NotStarted })
 	resourceStatusCheckEventFailed("ns:pod/foo", proto.ActionableErr{
 		ErrCode: 309,
 		Message: "one or more deployments failed",
-	})
+	}, "")
 	wait(t, func() bool { return handler.getState().StatusCheckState.Resources["ns:pod/foo"] == Failed })
 }
 
 func TestFileSyncInProgress(t *testing.T) {
-	defer func() { handler = newHandler() }()
+	defer func() { handler = newTestHandler() }()
 
-	handler = newHandler()
+	handler = newTestHandler()
 	handler.state = emptyState([]latest.Pipeline{{}}, "test", true, true, true)
 
 	wait(t, func() bool { return handler.getState().FileSyncState.Status == NotStarted })
@@ -280,9 +293,9 @@ func TestFileSyncInProgress(t *testing.T) {
 }
 
 func TestFileSyncFailed(t *testing.T) {
-	defer func() { handler = newHandler() }()
+	defer func() { handler = newTestHandler() }()
 
-	handler = newHandler()
+	handler = newTestHandler()
 	handler.state = emptyState([]latest.Pipeline{{}}, "test", true, true, true)
 
 	wait(t, func() bool { return handler.getState().FileSyncState.Status == NotStarted })
@@ -291,9 +304,9 @@ func TestFileSyncFailed(t *testing.T) {
 }
 
 func TestFileSyncSucceeded(t *testing.T) {
-	defer func() { handler = newHandler() }()
+	defer func() { handler = newTestHandler() }()
 
-	handler = newHandler()
+	handler = newTestHandler()
 	handler.state = emptyState([]latest.Pipeline{{}}, "test", true, true, true)
 
 	wait(t, func() bool { return handler.getState().FileSyncState.Status == NotStarted })
@@ -302,9 +315,9 @@ func TestFileSyncSucceeded(t *testing.T) {
 }
 
 func TestDebuggingContainer(t *testing.T) {
-	defer func() { handler = newHandler() }()
+	defer func() { handler = newTestHandler() }()
 
-	handler = newHandler()
+	handler = newTestHandler()
 	handler.state = emptyState([]latest.Pipeline{{}}, "test", true, true, true)
 
 	found := func() bool {
@@ -344,8 +357,8 @@ func wait(t *testing.T, condition func() bool) {
 }
 
 func TestResetStateOnBuild(t *testing.T) {
-	defer func() { handler = newHandler() }()
-	handler = newHandler()
+	defer func() { handler = newTestHandler() }()
+	handler = newTestHandler()
 	handler.state = proto.State{
 		BuildState: &proto.BuildState{
 			Artifacts: map[string]string{
@@ -380,8 +393,8 @@ func TestResetStateOnBuild(t *testing.T) {
 }
 
 func TestResetStateOnDeploy(t *testing.T) {
-	defer func() { handler = newHandler() }()
-	handler = newHandler()
+	defer func() { handler = newTestHandler() }()
+	handler = newTestHandler()
 	handler.state = proto.State{
 		BuildState: &proto.BuildState{
 			Artifacts: map[string]string{
@@ -423,8 +436,8 @@ func TestEmptyStateCheckState(t *testing.T) {
 }
 
 func TestUpdateStateAutoTriggers(t *testing.T) {
-	defer func() { handler = newHandler() }()
-	handler = newHandler()
+	defer func() { handler = newTestHandler() }()
+	handler = newTestHandler()
 	handler.state = proto.State{
 		BuildState: &proto.BuildState{
 			Artifacts: map[string]string{
@@ -598,3 +611,97 @@ func TestSaveEventsToFile(t *testing.T) {
 	testutil.CheckDeepEqual(t, 1, buildCompleteEvent)
 	testutil.CheckDeepEqual(t, 1, devLoopCompleteEvent)
 }
+
+func TestKubernetesEventReceived(t *testing.T) {
+	defer func() { handler = newTestHandler() }()
+
+	handler = newTestHandler()
+	handler.state = emptyState([]latest.Pipeline{{}}, "test", true, true, true)
+
+	KubernetesEventReceived(&proto.KubernetesEvent{
+		Type:      "Warning",
+		Reason:    "FailedScheduling",
+		Message:   "0/1 nodes are available: 1 Insufficient cpu.",
+		Kind:      "Pod",
+		Name:      "leeroy-web",
+		Namespace: "default",
+		Count:     1,
+	})
+	wait(t, func() bool {
+		handler.logLock.Lock()
+		defer handler.logLock.Unlock()
+		if len(handler.eventLog) == 0 {
+			return false
+		}
+		logEntry := handler.eventLog[len(handler.eventLog)-1]
+		return logEntry.Entry == "Kubernetes event FailedScheduling for pod/leeroy-web (default): 0/1 nodes are available: 1 Insufficient cpu."
+	})
+}
+
+func TestModules(t *testing.T) {
+	defer func() { handler = newTestHandler() }()
+
+	handler = newTestHandler()
+	handler.state = emptyState([]latest.Pipeline{{}}, "test", true, true, true)
+
+	InitializeModules(map[string][]string{"frontend": {"web"}, "backend": {"api", "db"}}, []string{"frontend/kubectl", "backend/helm"})
+	UpdateStateModuleWatch("backend", false)
+	ResetStateOnBuild()
+
+	state := handler.getState()
+	testutil.CheckDeepEqual(t, map[string]*proto.ModuleState{
+		"frontend": {Watched: true, Artifacts: []string{"web"}},
+		"backend":  {Watched: false, Artifacts: []string{"api", "db"}},
+	}, state.Modules)
+	testutil.CheckDeepEqual(t, []string{"frontend/kubectl", "backend/helm"}, state.Deployers)
+}
+
+func TestApplicationLogReceived(t *testing.T) {
+	defer func() { handler = newTestHandler() }()
+
+	handler = newTestHandler()
+	handler.state = emptyState([]latest.Pipeline{{}}, "test", true, true, true)
+
+	l := &listener{entries: make(chan *proto.LogEntry, 2)}
+	handler.listeners = append(handler.listeners, l)
+
+	ApplicationLogReceived("leeroy-web-1", "leeroy-web", "default", "listening on 8080\n", "")
+	ApplicationLogReceived("leeroy-web-1", "leeroy-web", "default", "GET /\n", "kind-other")
+	// the listener's queue is full: the line is dropped
+	ApplicationLogReceived("leeroy-web-1", "leeroy-web", "default", "GET /favicon.ico\n", "")
+
+	close(l.entries)
+	var received []*proto.ApplicationLogEvent
+	for entry := range l.entries {
+		received = append(received, entry.Event.GetApplicationLogEvent())
+	}
+	testutil.CheckDeepEqual(t, false, l.closed)
+
+	testutil.CheckDeepEqual(t, 2, len(received))
+	testutil.CheckDeepEqual(t, "leeroy-web", received[0].ContainerName)
+	testutil.CheckDeepEqual(t, "listening on 8080\n", received[0].Message)
+	testutil.CheckDeepEqual(t, "GET /\n", received[1].Message)
+	testutil.CheckDeepEqual(t, "", received[0].KubeContext)
+	testutil.CheckDeepEqual(t, "kind-other", received[1].KubeContext)
+	// log lines aren't replayed to new listeners
+	testutil.CheckDeepEqual(t, 0, len(handler.eventLog))
+}
+
+func TestSlowListener(t *testing.T) {
+	ev := newTestHandler()
+
+	l := &listener{
+		entries: make(chan *proto.LogEntry, 1),
+		errors:  make(chan error, 1),
+	}
+	ev.listeners = append(ev.listeners, l)
+
+	ev.logEvent(proto.LogEntry{Entry: "FIRST"})
+	ev.logEvent(proto.LogEntry{Entry: "SECOND"})
+	ev.logEvent(proto.LogEntry{Entry: "THIRD"})
+
+	// the listener is disconnected instead of missing events
+	testutil.CheckDeepEqual(t, true, l.closed)
+	testutil.CheckError(t, true, <-l.errors)
+	testutil.CheckDeepEqual(t, "FIRST", (<-l.entries).Entry)
+}
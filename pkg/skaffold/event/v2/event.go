@@ -0,0 +1,301 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	//nolint:golint,staticcheck
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+
+	protoV1 "github.com/GoogleContainerTools/skaffold/proto/v1"
+	proto "github.com/GoogleContainerTools/skaffold/proto/v2"
+)
+
+// Statuses of the version 1 events.
+const (
+	notStarted = "Not Started"
+	inProgress = "In Progress"
+	complete   = "Complete"
+	failed     = "Failed"
+	started    = "Started"
+	succeeded  = "Succeeded"
+	canceled   = "Canceled"
+)
+
+var handler = newHandler()
+
+func newHandler() *eventHandler {
+	return &eventHandler{
+		tasks: map[taskKey]*task{},
+	}
+}
+
+type eventHandler struct {
+	eventLog  []*proto.Event
+	logLock   sync.Mutex
+	listeners []*listener
+
+	// taskLock guards the state of the running tasks.
+	taskLock    sync.Mutex
+	iteration   int32
+	devLoopTask string
+	tasks       map[taskKey]*task
+	lastTaskID  int
+}
+
+type listener struct {
+	callback func(*proto.Event) error
+	errors   chan error
+	closed   bool
+}
+
+// taskKey identifies a running task.
+type taskKey struct {
+	taskType proto.TaskType
+	name     string
+}
+
+type task struct {
+	id     string
+	parent string
+	start  time.Time
+}
+
+// ForEachEvent calls `callback` with all the events since the start, then with every new event,
+// until the callback returns an error.
+func ForEachEvent(callback func(*proto.Event) error) error {
+	return handler.forEachEvent(callback)
+}
+
+// HandleV1 records the task lifecycle events that correspond to an event of the version 1 of the API.
+func HandleV1(event *protoV1.Event, ts *timestamp.Timestamp) {
+	handler.handleV1(event, ts)
+}
+
+// NewV1Handler returns a function that records the task lifecycle events of version 1 events
+// into a separate event log, instead of the one served by the API.
+func NewV1Handler() func(*protoV1.Event, *timestamp.Timestamp) {
+	return newHandler().handleV1
+}
+
+// SaveEventsToFile saves the events as JSON, one per line, to the given file.
+func SaveEventsToFile(fp string) error {
+	handler.logLock.Lock()
+	defer handler.logLock.Unlock()
+
+	f, err := os.OpenFile(fp, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0600)
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", fp, err)
+	}
+	defer f.Close()
+
+	marshaller := jsonpb.Marshaler{}
+	for _, ev := range handler.eventLog {
+		contents := bytes.NewBuffer([]byte{})
+		if err := marshaller.Marshal(contents, ev); err != nil {
+			return fmt.Errorf("marshalling event: %w", err)
+		}
+		if _, err := f.WriteString(contents.String() + "\n"); err != nil {
+			return fmt.Errorf("writing string: %w", err)
+		}
+	}
+	return nil
+}
+
+func (ev *eventHandler) forEachEvent(callback func(*proto.Event) error) error {
+	listener := &listener{
+		callback: callback,
+		errors:   make(chan error),
+	}
+
+	ev.logLock.Lock()
+	oldEvents := make([]*proto.Event, len(ev.eventLog))
+	copy(oldEvents, ev.eventLog)
+	ev.listeners = append(ev.listeners, listener)
+	ev.logLock.Unlock()
+
+	for i := range oldEvents {
+		if err := callback(oldEvents[i]); err != nil {
+			// listener should maybe be closed
+			return err
+		}
+	}
+
+	return <-listener.errors
+}
+
+func (ev *eventHandler) logEvent(event *proto.Event) {
+	ev.logLock.Lock()
+	defer ev.logLock.Unlock()
+
+	for _, listener := range ev.listeners {
+		if listener.closed {
+			continue
+		}
+
+		if err := listener.callback(event); err != nil {
+			listener.errors <- err
+			listener.closed = true
+		}
+	}
+	ev.eventLog = append(ev.eventLog, event)
+}
+
+func (ev *eventHandler) handleV1(event *protoV1.Event, ts *timestamp.Timestamp) {
+	ev.taskLock.Lock()
+	defer ev.taskLock.Unlock()
+
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		t = time.Now()
+	}
+
+	switch e := event.GetEventType().(type) {
+	case *protoV1.Event_DevLoopEvent:
+		de := e.DevLoopEvent
+		ev.iteration = de.Iteration
+		switch de.Status {
+		case inProgress:
+			ev.devLoopTask = ev.start(proto.TaskType_DEV_LOOP, "", "", t, fmt.Sprintf("Dev loop iteration %d started", de.Iteration))
+		case succeeded:
+			ev.end(proto.TaskType_DEV_LOOP, "", proto.TaskStatus_SUCCEEDED, t, fmt.Sprintf("Dev loop iteration %d succeeded", de.Iteration), de.Err)
+		case failed:
+			ev.end(proto.TaskType_DEV_LOOP, "", proto.TaskStatus_FAILED, t, fmt.Sprintf("Dev loop iteration %d failed", de.Iteration), de.Err)
+		}
+	case *protoV1.Event_BuildEvent:
+		be := e.BuildEvent
+		ev.lifecycle(proto.TaskType_BUILD, be.Artifact, ev.devLoopTask, be.Status, t, "Build of "+be.Artifact, be.ActionableErr)
+	case *protoV1.Event_TestEvent:
+		te := e.TestEvent
+		ev.lifecycle(proto.TaskType_TEST, "", ev.devLoopTask, te.Status, t, "Tests", te.ActionableErr)
+	case *protoV1.Event_DeployEvent:
+		de := e.DeployEvent
+		ev.lifecycle(proto.TaskType_DEPLOY, "", ev.devLoopTask, de.Status, t, "Deploy", de.ActionableErr)
+	case *protoV1.Event_StatusCheckEvent:
+		se := e.StatusCheckEvent
+		if se.Status == inProgress {
+			ev.progress(proto.TaskType_STATUS_CHECK, "", ev.devLoopTask, t, se.Message)
+			return
+		}
+		ev.lifecycle(proto.TaskType_STATUS_CHECK, "", ev.devLoopTask, se.Status, t, "Status check", se.ActionableErr)
+	case *protoV1.Event_ResourceStatusCheckEvent:
+		re := e.ResourceStatusCheckEvent
+		parent := ev.taskID(proto.TaskType_STATUS_CHECK, "")
+		// Resources of different clusters may have the same name
+		name := re.Resource
+		if re.KubeContext != "" {
+			name = re.KubeContext + "/" + re.Resource
+		}
+		if re.Status == inProgress {
+			ev.progress(proto.TaskType_RESOURCE_STATUS_CHECK, name, parent, t, re.Message)
+			return
+		}
+		ev.lifecycle(proto.TaskType_RESOURCE_STATUS_CHECK, name, parent, re.Status, t, "Status check of "+name, re.ActionableErr)
+	case *protoV1.Event_FileSyncEvent:
+		fe := e.FileSyncEvent
+		ev.lifecycle(proto.TaskType_FILE_SYNC, fe.Image, ev.devLoopTask, fe.Status, t, fmt.Sprintf("File sync of %d files for %s", fe.FileCount, fe.Image), fe.ActionableErr)
+	}
+}
+
+// lifecycle records the start or the end of a task, from the status of a version 1 event.
+func (ev *eventHandler) lifecycle(taskType proto.TaskType, name, parent, status string, t time.Time, description string, aiErr *protoV1.ActionableErr) {
+	switch status {
+	case inProgress, started:
+		ev.start(taskType, name, parent, t, description+" started")
+	case complete, succeeded:
+		ev.end(taskType, name, proto.TaskStatus_SUCCEEDED, t, description+" succeeded", aiErr)
+	case failed:
+		ev.end(taskType, name, proto.TaskStatus_FAILED, t, description+" failed", aiErr)
+	case canceled:
+		ev.end(taskType, name, proto.TaskStatus_CANCELED, t, description+" canceled", aiErr)
+	}
+}
+
+func (ev *eventHandler) start(taskType proto.TaskType, name, parent string, t time.Time, message string) string {
+	ev.lastTaskID++
+	tsk := &task{
+		id:     fmt.Sprintf("%s-%d", taskType, ev.lastTaskID),
+		parent: parent,
+		start:  t,
+	}
+	ev.tasks[taskKey{taskType, name}] = tsk
+
+	ev.logEvent(ev.newEvent(taskType, name, tsk, proto.TaskStatus_STARTED, t, message))
+	return tsk.id
+}
+
+// progress records that a task made progress, starting it if it's not running.
+func (ev *eventHandler) progress(taskType proto.TaskType, name, parent string, t time.Time, message string) {
+	tsk, found := ev.tasks[taskKey{taskType, name}]
+	if !found {
+		ev.start(taskType, name, parent, t, message)
+		return
+	}
+
+	ev.logEvent(ev.newEvent(taskType, name, tsk, proto.TaskStatus_IN_PROGRESS, t, message))
+}
+
+// end records that a task ended. A task that isn't known to be running is ended with a zero duration.
+func (ev *eventHandler) end(taskType proto.TaskType, name string, status proto.TaskStatus, t time.Time, message string, aiErr *protoV1.ActionableErr) {
+	key := taskKey{taskType, name}
+	tsk, found := ev.tasks[key]
+	if !found {
+		ev.lastTaskID++
+		tsk = &task{id: fmt.Sprintf("%s-%d", taskType, ev.lastTaskID), start: t}
+		if taskType != proto.TaskType_DEV_LOOP {
+			tsk.parent = ev.devLoopTask
+		}
+	}
+	delete(ev.tasks, key)
+
+	event := ev.newEvent(taskType, name, tsk, status, t, message)
+	event.Duration = ptypes.DurationProto(t.Sub(tsk.start))
+	if status == proto.TaskStatus_FAILED && aiErr != nil {
+		event.Error = aiErr.Message
+		event.ErrorCode = aiErr.ErrCode.String()
+	}
+	ev.logEvent(event)
+}
+
+// taskID returns the identifier of a running task, or an empty string.
+func (ev *eventHandler) taskID(taskType proto.TaskType, name string) string {
+	if tsk, found := ev.tasks[taskKey{taskType, name}]; found {
+		return tsk.id
+	}
+	return ""
+}
+
+func (ev *eventHandler) newEvent(taskType proto.TaskType, name string, tsk *task, status proto.TaskStatus, t time.Time, message string) *proto.Event {
+	ts, _ := ptypes.TimestampProto(t)
+	return &proto.Event{
+		Timestamp:    ts,
+		Iteration:    ev.iteration,
+		TaskId:       tsk.id,
+		ParentTaskId: tsk.parent,
+		TaskType:     taskType,
+		Name:         name,
+		Status:       status,
+		Message:      message,
+	}
+}
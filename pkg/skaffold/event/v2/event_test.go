@@ -0,0 +1,142 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	protoV1 "github.com/GoogleContainerTools/skaffold/proto/v1"
+	proto "github.com/GoogleContainerTools/skaffold/proto/v2"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+// summary is the part of an event that doesn't depend on time.
+type summary struct {
+	Iteration int32
+	TaskID    string
+	Parent    string
+	Status    proto.TaskStatus
+	Name      string
+	Duration  time.Duration
+	Error     string
+	ErrorCode string
+}
+
+func summarize(events []*proto.Event) []summary {
+	var summaries []summary
+	for _, e := range events {
+		d, _ := ptypes.Duration(e.Duration)
+		summaries = append(summaries, summary{e.Iteration, e.TaskId, e.ParentTaskId, e.Status, e.Name, d, e.Error, e.ErrorCode})
+	}
+	return summaries
+}
+
+func TestHandleV1(t *testing.T) {
+	start := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	events := []*protoV1.Event{
+		{EventType: &protoV1.Event_DevLoopEvent{DevLoopEvent: &protoV1.DevLoopEvent{Iteration: 1, Status: inProgress}}},
+		{EventType: &protoV1.Event_BuildEvent{BuildEvent: &protoV1.BuildEvent{Artifact: "web", Status: inProgress}}},
+		{EventType: &protoV1.Event_BuildEvent{BuildEvent: &protoV1.BuildEvent{Artifact: "web", Status: complete}}},
+		{EventType: &protoV1.Event_DeployEvent{DeployEvent: &protoV1.DeployEvent{Status: inProgress}}},
+		{EventType: &protoV1.Event_DeployEvent{DeployEvent: &protoV1.DeployEvent{Status: complete}}},
+		{EventType: &protoV1.Event_StatusCheckEvent{StatusCheckEvent: &protoV1.StatusCheckEvent{Status: started}}},
+		{EventType: &protoV1.Event_ResourceStatusCheckEvent{ResourceStatusCheckEvent: &protoV1.ResourceStatusCheckEvent{Resource: "deployment/web", Status: inProgress, Message: "waiting"}}},
+		{EventType: &protoV1.Event_ResourceStatusCheckEvent{ResourceStatusCheckEvent: &protoV1.ResourceStatusCheckEvent{Resource: "deployment/web", Status: inProgress, Message: "still waiting"}}},
+		{EventType: &protoV1.Event_ResourceStatusCheckEvent{ResourceStatusCheckEvent: &protoV1.ResourceStatusCheckEvent{Resource: "deployment/web", Status: failed, ActionableErr: &protoV1.ActionableErr{ErrCode: protoV1.StatusCode_STATUSCHECK_UNHEALTHY, Message: "crashing"}}}},
+		{EventType: &protoV1.Event_StatusCheckEvent{StatusCheckEvent: &protoV1.StatusCheckEvent{Status: failed, ActionableErr: &protoV1.ActionableErr{ErrCode: protoV1.StatusCode_STATUSCHECK_UNHEALTHY, Message: "1/1 deployment(s) failed"}}}},
+		{EventType: &protoV1.Event_DevLoopEvent{DevLoopEvent: &protoV1.DevLoopEvent{Iteration: 1, Status: failed}}},
+		// the end of a task that wasn't started
+		{EventType: &protoV1.Event_FileSyncEvent{FileSyncEvent: &protoV1.FileSyncEvent{Image: "web", Status: succeeded}}},
+		// ignored
+		{EventType: &protoV1.Event_MetaEvent{MetaEvent: &protoV1.MetaEvent{Entry: "Starting Skaffold"}}},
+	}
+
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&handler, newHandler())
+		for i, e := range events {
+			ts, _ := ptypes.TimestampProto(start.Add(time.Duration(i) * time.Second))
+			HandleV1(e, ts)
+		}
+
+		t.CheckDeepEqual([]summary{
+			{1, "DEV_LOOP-1", "", proto.TaskStatus_STARTED, "", 0, "", ""},
+			{1, "BUILD-2", "DEV_LOOP-1", proto.TaskStatus_STARTED, "web", 0, "", ""},
+			{1, "BUILD-2", "DEV_LOOP-1", proto.TaskStatus_SUCCEEDED, "web", time.Second, "", ""},
+			{1, "DEPLOY-3", "DEV_LOOP-1", proto.TaskStatus_STARTED, "", 0, "", ""},
+			{1, "DEPLOY-3", "DEV_LOOP-1", proto.TaskStatus_SUCCEEDED, "", time.Second, "", ""},
+			{1, "STATUS_CHECK-4", "DEV_LOOP-1", proto.TaskStatus_STARTED, "", 0, "", ""},
+			{1, "RESOURCE_STATUS_CHECK-5", "STATUS_CHECK-4", proto.TaskStatus_STARTED, "deployment/web", 0, "", ""},
+			{1, "RESOURCE_STATUS_CHECK-5", "STATUS_CHECK-4", proto.TaskStatus_IN_PROGRESS, "deployment/web", 0, "", ""},
+			{1, "RESOURCE_STATUS_CHECK-5", "STATUS_CHECK-4", proto.TaskStatus_FAILED, "deployment/web", 2 * time.Second, "crashing", "STATUSCHECK_UNHEALTHY"},
+			{1, "STATUS_CHECK-4", "DEV_LOOP-1", proto.TaskStatus_FAILED, "", 4 * time.Second, "1/1 deployment(s) failed", "STATUSCHECK_UNHEALTHY"},
+			{1, "DEV_LOOP-1", "", proto.TaskStatus_FAILED, "", 10 * time.Second, "", ""},
+			{1, "FILE_SYNC-6", "DEV_LOOP-1", proto.TaskStatus_SUCCEEDED, "web", 0, "", ""},
+		}, summarize(handler.eventLog))
+	})
+}
+
+func TestHandleV1Concurrently(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&handler, newHandler())
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			artifact := fmt.Sprintf("image%d", i)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				HandleV1(&protoV1.Event{EventType: &protoV1.Event_BuildEvent{BuildEvent: &protoV1.BuildEvent{Artifact: artifact, Status: inProgress}}}, ptypes.TimestampNow())
+				HandleV1(&protoV1.Event{EventType: &protoV1.Event_BuildEvent{BuildEvent: &protoV1.BuildEvent{Artifact: artifact, Status: complete}}}, ptypes.TimestampNow())
+			}()
+		}
+		wg.Wait()
+
+		ids := map[string]bool{}
+		for _, e := range handler.eventLog {
+			ids[e.TaskId] = true
+		}
+		t.CheckDeepEqual(20, len(handler.eventLog))
+		t.CheckDeepEqual(10, len(ids))
+	})
+}
+
+func TestSaveEventsToFile(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&handler, newHandler())
+		HandleV1(&protoV1.Event{EventType: &protoV1.Event_BuildEvent{BuildEvent: &protoV1.BuildEvent{Artifact: "web", Status: inProgress}}}, ptypes.TimestampNow())
+		HandleV1(&protoV1.Event{EventType: &protoV1.Event_BuildEvent{BuildEvent: &protoV1.BuildEvent{Artifact: "web", Status: complete}}}, ptypes.TimestampNow())
+		file := t.NewTempDir().Path("events.json")
+
+		err := SaveEventsToFile(file)
+		t.CheckNoError(err)
+
+		contents, err := ioutil.ReadFile(file)
+		t.CheckNoError(err)
+		lines := strings.Split(strings.TrimSpace(string(contents)), "\n")
+		t.CheckDeepEqual(2, len(lines))
+		t.CheckContains(`"taskId":"BUILD-1"`, lines[0])
+		t.CheckContains(`"status":"STARTED"`, lines[0])
+		t.CheckContains(`"status":"SUCCEEDED"`, lines[1])
+	})
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	//nolint:golint,staticcheck
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
+
+	proto "github.com/GoogleContainerTools/skaffold/proto/v2"
+)
+
+// LoadEventsFromFile reads the events saved by `SaveEventsToFile`.
+func LoadEventsFromFile(fp string) ([]*proto.Event, error) {
+	f, err := os.Open(fp)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %w", fp, err)
+	}
+	defer f.Close()
+
+	var events []*proto.Event
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 10*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		event := &proto.Event{}
+		if err := jsonpb.UnmarshalString(text, event); err != nil {
+			return nil, fmt.Errorf("parsing event at %s:%d: %w", fp, line, err)
+		}
+		events = append(events, event)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", fp, err)
+	}
+	return events, nil
+}
+
+// Replay sends recorded events to the listeners of the event API, as if they were happening now.
+// With `realtime`, the events are sent with the same delays as in the recorded session.
+func Replay(ctx context.Context, events []*proto.Event, realtime bool) error {
+	return handler.replay(ctx, events, realtime)
+}
+
+func (ev *eventHandler) replay(ctx context.Context, events []*proto.Event, realtime bool) error {
+	var previous time.Time
+	for _, e := range events {
+		t, err := ptypes.Timestamp(e.Timestamp)
+		if realtime && err == nil {
+			if !previous.IsZero() && t.After(previous) {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(t.Sub(previous)):
+				}
+			}
+			previous = t
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		ev.logEvent(e)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	protoV1 "github.com/GoogleContainerTools/skaffold/proto/v1"
+	proto "github.com/GoogleContainerTools/skaffold/proto/v2"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestLoadEventsFromFile(t *testing.T) {
+	testutil.Run(t, "round trip", func(t *testutil.T) {
+		t.Override(&handler, newHandler())
+		HandleV1(&protoV1.Event{EventType: &protoV1.Event_BuildEvent{BuildEvent: &protoV1.BuildEvent{Artifact: "web", Status: inProgress}}}, ptypes.TimestampNow())
+		HandleV1(&protoV1.Event{EventType: &protoV1.Event_BuildEvent{BuildEvent: &protoV1.BuildEvent{Artifact: "web", Status: failed, ActionableErr: &protoV1.ActionableErr{ErrCode: protoV1.StatusCode_BUILD_USER_ERROR, Message: "no Dockerfile"}}}}, ptypes.TimestampNow())
+		file := t.NewTempDir().Path("events.json")
+		t.CheckNoError(SaveEventsToFile(file))
+
+		events, err := LoadEventsFromFile(file)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(summarize(handler.eventLog), summarize(events))
+	})
+
+	testutil.Run(t, "invalid event", func(t *testutil.T) {
+		file := t.NewTempDir().Write("events.json", "{\"taskId\":\"BUILD-1\"}\n\n{invalid\n").Path("events.json")
+
+		_, err := LoadEventsFromFile(file)
+
+		t.CheckErrorContains("events.json:3", err)
+	})
+
+	testutil.Run(t, "missing file", func(t *testutil.T) {
+		_, err := LoadEventsFromFile(t.NewTempDir().Path("missing.json"))
+
+		t.CheckError(true, err)
+	})
+}
+
+func TestReplay(t *testing.T) {
+	start := time.Now()
+	ts := func(d time.Duration) *proto.Event {
+		timestamp, _ := ptypes.TimestampProto(start.Add(d))
+		return &proto.Event{Timestamp: timestamp, TaskId: d.String()}
+	}
+	events := []*proto.Event{ts(0), ts(20 * time.Millisecond), ts(40 * time.Millisecond)}
+
+	testutil.Run(t, "realtime", func(t *testutil.T) {
+		t.Override(&handler, newHandler())
+
+		before := time.Now()
+		err := Replay(context.Background(), events, true)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(summarize(events), summarize(handler.eventLog))
+		t.CheckTrue(time.Since(before) >= 40*time.Millisecond)
+	})
+
+	testutil.Run(t, "cancelled", func(t *testutil.T) {
+		t.Override(&handler, newHandler())
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := Replay(ctx, events, true)
+
+		t.CheckError(true, err)
+		t.CheckDeepEqual(0, len(handler.eventLog))
+	})
+}
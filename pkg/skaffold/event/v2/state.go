@@ -0,0 +1,128 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	//nolint:golint,staticcheck
+	protobuf "github.com/golang/protobuf/proto"
+
+	protoV1 "github.com/GoogleContainerTools/skaffold/proto/v1"
+	proto "github.com/GoogleContainerTools/skaffold/proto/v2"
+)
+
+// StateChange is the state of a session right after an event.
+type StateChange struct {
+	Event *proto.Event
+	State *protoV1.State
+}
+
+// StateTimeline reconstructs the state returned by the version 1 of the API after each of the events.
+// Only the events that change the state are part of the timeline.
+func StateTimeline(events []*proto.Event) []StateChange {
+	state := initialState(events)
+
+	var timeline []StateChange
+	for _, e := range events {
+		if !applyEvent(state, e) {
+			continue
+		}
+		timeline = append(timeline, StateChange{
+			Event: e,
+			State: protobuf.Clone(state).(*protoV1.State),
+		})
+	}
+	return timeline
+}
+
+// initialState is the state before any event: all the artifacts that were built during the session are not started.
+func initialState(events []*proto.Event) *protoV1.State {
+	state := &protoV1.State{
+		BuildState:     &protoV1.BuildState{Artifacts: map[string]string{}},
+		ForwardedPorts: map[int32]*protoV1.PortEvent{},
+	}
+	for _, e := range events {
+		if e.TaskType == proto.TaskType_BUILD {
+			state.BuildState.Artifacts[e.Name] = notStarted
+		}
+	}
+	resetState(state)
+	return state
+}
+
+// resetState resets the state at the start of a dev loop iteration.
+func resetState(state *protoV1.State) {
+	for artifact := range state.BuildState.Artifacts {
+		state.BuildState.Artifacts[artifact] = notStarted
+	}
+	state.BuildState.StatusCode = protoV1.StatusCode_OK
+	state.TestState = &protoV1.TestState{Status: notStarted, StatusCode: protoV1.StatusCode_OK}
+	state.DeployState = &protoV1.DeployState{Status: notStarted, StatusCode: protoV1.StatusCode_OK}
+	state.StatusCheckState = &protoV1.StatusCheckState{Status: notStarted, Resources: map[string]string{}, StatusCode: protoV1.StatusCode_OK}
+	state.FileSyncState = &protoV1.FileSyncState{Status: notStarted}
+}
+
+// applyEvent updates the state with an event. Returns false if the event doesn't change the state.
+func applyEvent(state *protoV1.State, e *proto.Event) bool {
+	code := protoV1.StatusCode_OK
+	if e.Status == proto.TaskStatus_FAILED {
+		code = protoV1.StatusCode(protoV1.StatusCode_value[e.ErrorCode])
+	}
+
+	switch e.TaskType {
+	case proto.TaskType_DEV_LOOP:
+		if e.Status != proto.TaskStatus_STARTED {
+			return false
+		}
+		resetState(state)
+	case proto.TaskType_BUILD:
+		state.BuildState.Artifacts[e.Name] = v1Status(e.Status, inProgress, complete)
+		state.BuildState.StatusCode = code
+	case proto.TaskType_TEST:
+		state.TestState.Status = v1Status(e.Status, inProgress, complete)
+		state.TestState.StatusCode = code
+	case proto.TaskType_DEPLOY:
+		state.DeployState.Status = v1Status(e.Status, inProgress, complete)
+		state.DeployState.StatusCode = code
+	case proto.TaskType_STATUS_CHECK:
+		state.StatusCheckState.Status = v1Status(e.Status, started, succeeded)
+		state.StatusCheckState.StatusCode = code
+	case proto.TaskType_RESOURCE_STATUS_CHECK:
+		state.StatusCheckState.Resources[e.Name] = v1Status(e.Status, inProgress, succeeded)
+	case proto.TaskType_FILE_SYNC:
+		state.FileSyncState.Status = v1Status(e.Status, inProgress, succeeded)
+	default:
+		return false
+	}
+	return true
+}
+
+// v1Status converts the status of a task to the status used by the version 1 of the API,
+// which depends on the kind of task.
+func v1Status(status proto.TaskStatus, startedStatus, succeededStatus string) string {
+	switch status {
+	case proto.TaskStatus_STARTED:
+		return startedStatus
+	case proto.TaskStatus_SUCCEEDED:
+		return succeededStatus
+	case proto.TaskStatus_FAILED:
+		return failed
+	case proto.TaskStatus_CANCELED:
+		return canceled
+	default:
+		return inProgress
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+
+	protoV1 "github.com/GoogleContainerTools/skaffold/proto/v1"
+	proto "github.com/GoogleContainerTools/skaffold/proto/v2"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+// recordedSession is a dev session with a failed first iteration, and a successful second one.
+func recordedSession() []*proto.Event {
+	d := ptypes.DurationProto
+	return []*proto.Event{
+		{Iteration: 0, TaskId: "DEV_LOOP-1", TaskType: proto.TaskType_DEV_LOOP, Status: proto.TaskStatus_STARTED},
+		{Iteration: 0, TaskId: "BUILD-2", ParentTaskId: "DEV_LOOP-1", TaskType: proto.TaskType_BUILD, Name: "web", Status: proto.TaskStatus_STARTED},
+		{Iteration: 0, TaskId: "BUILD-3", ParentTaskId: "DEV_LOOP-1", TaskType: proto.TaskType_BUILD, Name: "db", Status: proto.TaskStatus_STARTED},
+		{Iteration: 0, TaskId: "BUILD-2", ParentTaskId: "DEV_LOOP-1", TaskType: proto.TaskType_BUILD, Name: "web", Status: proto.TaskStatus_SUCCEEDED, Duration: d(3e9)},
+		{Iteration: 0, TaskId: "BUILD-3", ParentTaskId: "DEV_LOOP-1", TaskType: proto.TaskType_BUILD, Name: "db", Status: proto.TaskStatus_FAILED, Duration: d(4e9), Error: "no Dockerfile", ErrorCode: "BUILD_USER_ERROR"},
+		{Iteration: 0, TaskId: "DEV_LOOP-1", TaskType: proto.TaskType_DEV_LOOP, Status: proto.TaskStatus_FAILED, Duration: d(5e9)},
+		{Iteration: 1, TaskId: "DEV_LOOP-4", TaskType: proto.TaskType_DEV_LOOP, Status: proto.TaskStatus_STARTED},
+		{Iteration: 1, TaskId: "BUILD-5", ParentTaskId: "DEV_LOOP-4", TaskType: proto.TaskType_BUILD, Name: "db", Status: proto.TaskStatus_STARTED},
+		{Iteration: 1, TaskId: "BUILD-5", ParentTaskId: "DEV_LOOP-4", TaskType: proto.TaskType_BUILD, Name: "db", Status: proto.TaskStatus_SUCCEEDED, Duration: d(2e9)},
+		{Iteration: 1, TaskId: "DEPLOY-6", ParentTaskId: "DEV_LOOP-4", TaskType: proto.TaskType_DEPLOY, Status: proto.TaskStatus_STARTED},
+		{Iteration: 1, TaskId: "DEPLOY-6", ParentTaskId: "DEV_LOOP-4", TaskType: proto.TaskType_DEPLOY, Status: proto.TaskStatus_SUCCEEDED, Duration: d(1e9)},
+		{Iteration: 1, TaskId: "STATUS_CHECK-7", ParentTaskId: "DEV_LOOP-4", TaskType: proto.TaskType_STATUS_CHECK, Status: proto.TaskStatus_STARTED},
+		{Iteration: 1, TaskId: "RESOURCE_STATUS_CHECK-8", ParentTaskId: "STATUS_CHECK-7", TaskType: proto.TaskType_RESOURCE_STATUS_CHECK, Name: "deployment/db", Status: proto.TaskStatus_STARTED},
+		{Iteration: 1, TaskId: "RESOURCE_STATUS_CHECK-8", ParentTaskId: "STATUS_CHECK-7", TaskType: proto.TaskType_RESOURCE_STATUS_CHECK, Name: "deployment/db", Status: proto.TaskStatus_SUCCEEDED, Duration: d(2e9)},
+		{Iteration: 1, TaskId: "STATUS_CHECK-7", ParentTaskId: "DEV_LOOP-4", TaskType: proto.TaskType_STATUS_CHECK, Status: proto.TaskStatus_SUCCEEDED, Duration: d(2e9)},
+		{Iteration: 1, TaskId: "DEV_LOOP-4", TaskType: proto.TaskType_DEV_LOOP, Status: proto.TaskStatus_SUCCEEDED, Duration: d(6e9)},
+	}
+}
+
+func TestStateTimeline(t *testing.T) {
+	timeline := StateTimeline(recordedSession())
+
+	// the end of the dev loops doesn't change the state
+	testutil.CheckDeepEqual(t, 14, len(timeline))
+
+	afterBuildFailure := timeline[4].State
+	testutil.CheckDeepEqual(t, map[string]string{"web": complete, "db": failed}, afterBuildFailure.BuildState.Artifacts)
+	testutil.CheckDeepEqual(t, protoV1.StatusCode_BUILD_USER_ERROR, afterBuildFailure.BuildState.StatusCode)
+	testutil.CheckDeepEqual(t, notStarted, afterBuildFailure.DeployState.Status)
+
+	secondIteration := timeline[5].State
+	testutil.CheckDeepEqual(t, "DEV_LOOP-4", timeline[5].Event.TaskId)
+	testutil.CheckDeepEqual(t, map[string]string{"web": notStarted, "db": notStarted}, secondIteration.BuildState.Artifacts)
+	testutil.CheckDeepEqual(t, protoV1.StatusCode_OK, secondIteration.BuildState.StatusCode)
+
+	statusCheckStarted := timeline[10].State
+	testutil.CheckDeepEqual(t, started, statusCheckStarted.StatusCheckState.Status)
+	testutil.CheckDeepEqual(t, complete, statusCheckStarted.DeployState.Status)
+
+	final := timeline[13].State
+	testutil.CheckDeepEqual(t, map[string]string{"web": notStarted, "db": complete}, final.BuildState.Artifacts)
+	testutil.CheckDeepEqual(t, succeeded, final.StatusCheckState.Status)
+	testutil.CheckDeepEqual(t, map[string]string{"deployment/db": succeeded}, final.StatusCheckState.Resources)
+
+	// the snapshots are independent
+	testutil.CheckDeepEqual(t, inProgress, timeline[1].State.BuildState.Artifacts["web"])
+}
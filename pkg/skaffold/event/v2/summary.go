@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	proto "github.com/GoogleContainerTools/skaffold/proto/v2"
+)
+
+// IterationSummary describes what happened during a dev loop iteration.
+// The status is the last known status of the iteration.
+type IterationSummary struct {
+	Iteration int32
+	Status    string
+	Duration  time.Duration
+	Tasks     []*TaskSummary
+}
+
+// TaskSummary describes a task of an iteration, like the build of an artifact.
+// The status is the last known status of the task.
+type TaskSummary struct {
+	ID        string
+	Parent    string
+	Type      string
+	Name      string
+	Status    string
+	Duration  time.Duration
+	Error     string
+	ErrorCode string
+}
+
+// Summarize groups the tasks by dev loop iteration, in the order they were started.
+func Summarize(events []*proto.Event) []*IterationSummary {
+	var iterations []*IterationSummary
+	byIteration := map[int32]*IterationSummary{}
+	tasks := map[string]*TaskSummary{}
+
+	for _, e := range events {
+		it, found := byIteration[e.Iteration]
+		if !found {
+			it = &IterationSummary{Iteration: e.Iteration, Status: proto.TaskStatus_UNKNOWN_TASK_STATUS.String()}
+			byIteration[e.Iteration] = it
+			iterations = append(iterations, it)
+		}
+
+		var duration time.Duration
+		if e.Duration != nil {
+			duration, _ = ptypes.Duration(e.Duration)
+		}
+
+		if e.TaskType == proto.TaskType_DEV_LOOP {
+			it.Status = e.Status.String()
+			it.Duration = duration
+			continue
+		}
+
+		task, found := tasks[e.TaskId]
+		if !found {
+			task = &TaskSummary{ID: e.TaskId, Parent: e.ParentTaskId, Type: e.TaskType.String(), Name: e.Name}
+			tasks[e.TaskId] = task
+			it.Tasks = append(it.Tasks, task)
+		}
+		task.Status = e.Status.String()
+		task.Duration = duration
+		task.Error = e.Error
+		task.ErrorCode = e.ErrorCode
+	}
+
+	return iterations
+}
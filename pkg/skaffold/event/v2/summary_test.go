@@ -0,0 +1,51 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestSummarize(t *testing.T) {
+	summaries := Summarize(recordedSession())
+
+	testutil.CheckDeepEqual(t, []*IterationSummary{
+		{
+			Iteration: 0,
+			Status:    "FAILED",
+			Duration:  5 * time.Second,
+			Tasks: []*TaskSummary{
+				{ID: "BUILD-2", Parent: "DEV_LOOP-1", Type: "BUILD", Name: "web", Status: "SUCCEEDED", Duration: 3 * time.Second},
+				{ID: "BUILD-3", Parent: "DEV_LOOP-1", Type: "BUILD", Name: "db", Status: "FAILED", Duration: 4 * time.Second, Error: "no Dockerfile", ErrorCode: "BUILD_USER_ERROR"},
+			},
+		},
+		{
+			Iteration: 1,
+			Status:    "SUCCEEDED",
+			Duration:  6 * time.Second,
+			Tasks: []*TaskSummary{
+				{ID: "BUILD-5", Parent: "DEV_LOOP-4", Type: "BUILD", Name: "db", Status: "SUCCEEDED", Duration: 2 * time.Second},
+				{ID: "DEPLOY-6", Parent: "DEV_LOOP-4", Type: "DEPLOY", Status: "SUCCEEDED", Duration: time.Second},
+				{ID: "STATUS_CHECK-7", Parent: "DEV_LOOP-4", Type: "STATUS_CHECK", Status: "SUCCEEDED", Duration: 2 * time.Second},
+				{ID: "RESOURCE_STATUS_CHECK-8", Parent: "STATUS_CHECK-7", Type: "RESOURCE_STATUS_CHECK", Name: "deployment/db", Status: "SUCCEEDED", Duration: 2 * time.Second},
+			},
+		},
+	}, summaries)
+}
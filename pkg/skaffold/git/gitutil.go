@@ -24,30 +24,46 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/mitchellh/go-homedir"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
 // SyncRepo syncs the target git repository with skaffold's local cache and returns the path to the repository root directory.
 var SyncRepo = syncRepo
+
+// HeadCommit returns the commit checked out in the repository in `dir`.
+var HeadCommit = headCommit
+
+// CurrentBranch returns the branch checked out in the git repository containing `dir`, or `HEAD` if it's detached.
+var CurrentBranch = currentBranch
+
 var findGit = func() (string, error) { return exec.LookPath("git") }
 
+// commitSHA matches full SHA-1 and SHA-256 git object names.
+var commitSHA = regexp.MustCompile(`^([0-9a-f]{40}|[0-9a-f]{64})$`)
+
+// envVarName matches the names of environment variables that can be safely referenced from a shell.
+var envVarName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// usernameEnv passes the username of `auth.tokenEnv` to the credential helper.
+const usernameEnv = "SKAFFOLD_GIT_USERNAME"
+
 // defaultRef returns the default ref as "master" if master branch exists in
 // remote repository, falls back to "main" if master branch doesn't exist
-func defaultRef(repo string) (string, error) {
+func defaultRef(repo string, auth *latest.GitAuth) (string, error) {
 	masterRef := "master"
 	mainRef := "main"
-	masterExists, err := branchExists(repo, masterRef)
+	masterExists, err := branchExists(repo, masterRef, auth)
 	if err != nil {
 		return "", err
 	}
-	mainExists, err := branchExists(repo, mainRef)
+	mainExists, err := branchExists(repo, mainRef, auth)
 	if err != nil {
 		return "", err
 	}
@@ -60,12 +76,12 @@ func defaultRef(repo string) (string, error) {
 }
 
 // BranchExists checks if branch is present in the input repo
-func branchExists(repo, branch string) (bool, error) {
-	gitProgram, err := findGit()
+func branchExists(repo, branch string, auth *latest.GitAuth) (bool, error) {
+	r, err := newGitCmd("", auth)
 	if err != nil {
 		return false, err
 	}
-	out, err := util.RunCmdOut(exec.Command(gitProgram, "ls-remote", repo, branch))
+	out, err := r.Run("ls-remote", repo, branch)
 	if err != nil {
 		// stdErr contains the error message for os related errors, git permission errors
 		// and if repo doesn't exist
@@ -82,6 +98,12 @@ func branchExists(repo, branch string) (bool, error) {
 // getRepoDir returns the cache directory name for a remote repo
 func getRepoDir(g latest.GitInfo) (string, error) {
 	inputs := []string{g.Repo, g.Ref}
+	if g.Commit != "" {
+		inputs = append(inputs, g.Commit)
+	}
+	if g.Sparse {
+		inputs = append(inputs, sparseDir(g))
+	}
 	hasher := sha256.New()
 	enc := json.NewEncoder(hasher)
 	if err := enc.Encode(inputs); err != nil {
@@ -90,47 +112,90 @@ func getRepoDir(g latest.GitInfo) (string, error) {
 	return base64.URLEncoding.EncodeToString(hasher.Sum(nil))[:32], nil
 }
 
-func getRepoCacheDir(opts config.SkaffoldOptions) (string, error) {
-	if opts.RepoCacheDir != "" {
-		return opts.RepoCacheDir, nil
+// sparseDir returns the repository directory to check out when `sparse` is set.
+// `path` can reference either a skaffold configuration file or the directory containing it.
+func sparseDir(g latest.GitInfo) string {
+	dir := filepath.ToSlash(g.Path)
+	if ext := filepath.Ext(dir); ext == ".yaml" || ext == ".yml" {
+		dir = filepath.ToSlash(filepath.Dir(dir))
 	}
-
-	// cache location unspecified, use ~/.skaffold/repos
-	home, err := homedir.Dir()
-	if err != nil {
-		return "", fmt.Errorf("retrieving home directory: %w", err)
+	if dir == "" || dir == "." {
+		return "/"
 	}
-	return filepath.Join(home, constants.DefaultSkaffoldDir, "repos"), nil
+	return dir
 }
 
 func syncRepo(g latest.GitInfo, opts config.SkaffoldOptions) (string, error) {
-	skaffoldCacheDir, err := getRepoCacheDir(opts)
-	r := gitCmd{Dir: skaffoldCacheDir}
+	skaffoldCacheDir, err := config.GetRepoCacheDir(opts)
+	if err != nil {
+		return "", fmt.Errorf("failed to clone repo %s: %w", g.Repo, err)
+	}
+	r, err := newGitCmd(skaffoldCacheDir, g.Auth)
 	if err != nil {
 		return "", fmt.Errorf("failed to clone repo %s: %w", g.Repo, err)
 	}
+	if g.Commit != "" && !commitSHA.MatchString(g.Commit) {
+		return "", fmt.Errorf("failed to clone repo %s: commit %q is not a full commit SHA", g.Repo, g.Commit)
+	}
+
+	hash, err := getRepoDir(g)
+	if err != nil {
+		return "", fmt.Errorf("failed to clone git repo: unable to create directory name: %w", err)
+	}
+	repoCacheDir := filepath.Join(skaffoldCacheDir, hash)
+	_, statErr := os.Stat(repoCacheDir)
+
+	if opts.RemoteCacheOnly {
+		if os.IsNotExist(statErr) {
+			return "", fmt.Errorf("failed to clone repo %s: repository is not available in the cache directory %s and `--remote-cache-only` is set; run once without `--remote-cache-only` to populate the cache", g.Repo, skaffoldCacheDir)
+		}
+		r.Dir = repoCacheDir
+		if g.Commit != "" {
+			if err := verifyCommit(r, g); err != nil {
+				return "", err
+			}
+		}
+		return repoCacheDir, nil
+	}
+
 	if err := os.MkdirAll(skaffoldCacheDir, 0700); err != nil {
 		return "", fmt.Errorf(
 			"failed to clone repo %s: trouble creating cache directory: %w", g.Repo, err)
 	}
 
 	ref := g.Ref
-	if ref == "" {
-		ref, err = defaultRef(g.Repo)
+	if ref == "" && g.Commit == "" {
+		ref, err = defaultRef(g.Repo, g.Auth)
 		if err != nil {
 			return "", fmt.Errorf("failed to clone repo %s: trouble getting default branch: %w", g.Repo, err)
 		}
 	}
 
-	hash, err := getRepoDir(g)
-	if err != nil {
-		return "", fmt.Errorf("failed to clone git repo: unable to create directory name: %w", err)
-	}
-	repoCacheDir := filepath.Join(skaffoldCacheDir, hash)
-	if _, err := os.Stat(repoCacheDir); os.IsNotExist(err) {
-		if _, err := r.Run("clone", g.Repo, hash, "--branch", ref, "--depth", "1"); err != nil {
+	if os.IsNotExist(statErr) {
+		args := []string{"clone", g.Repo, hash}
+		if g.Commit != "" {
+			args = append(args, "--no-checkout")
+		} else {
+			args = append(args, "--branch", ref)
+		}
+		args = append(args, "--depth", "1")
+		if g.Sparse {
+			args = append(args, "--filter=blob:none", "--sparse")
+		}
+		if _, err := r.Run(args...); err != nil {
 			return "", fmt.Errorf("failed to clone repo: %w", err)
 		}
+		r.Dir = repoCacheDir
+		if g.Sparse {
+			if _, err := r.Run("sparse-checkout", "set", sparseDir(g)); err != nil {
+				return "", fmt.Errorf("failed to clone repo %s: trouble setting sparse checkout directory %s: %w", g.Repo, sparseDir(g), err)
+			}
+		}
+		if g.Commit != "" {
+			if err := checkoutCommit(r, g); err != nil {
+				return "", err
+			}
+		}
 	} else {
 		r.Dir = repoCacheDir
 		// check remote is defined
@@ -145,6 +210,18 @@ func syncRepo(g latest.GitInfo, opts config.SkaffoldOptions) (string, error) {
 			return repoCacheDir, nil
 		}
 
+		if g.Commit != "" {
+			if changes, err := r.Run("diff", "--name-only", "--ignore-submodules", "HEAD"); err != nil {
+				return "", fmt.Errorf("failed to clone repo %s: unable to check for uncommitted changes; run 'git clone <REPO>; stat <DIR/SUBDIR>' to verify credentials: %w", g.Repo, err)
+			} else if len(changes) > 0 {
+				return "", fmt.Errorf("failed to clone repo %s: there are uncommitted changes in the target directory %s; either set the repository `sync` property to false in the skaffold config, or revert the local changes", g.Repo, repoCacheDir)
+			}
+			if err := checkoutCommit(r, g); err != nil {
+				return "", err
+			}
+			return repoCacheDir, nil
+		}
+
 		if _, err = r.Run("fetch", "origin", ref); err != nil {
 			return "", fmt.Errorf("failed to clone repo %s: unable to find any matching refs %s; run 'git clone <REPO>; stat <DIR/SUBDIR>' to verify credentials: %w", g.Repo, ref, err)
 		}
@@ -171,10 +248,135 @@ func syncRepo(g latest.GitInfo, opts config.SkaffoldOptions) (string, error) {
 	return repoCacheDir, nil
 }
 
+// CachedRepo describes a git repository in skaffold's remote config cache.
+type CachedRepo struct {
+	// Repo is the remote url the repository was cloned from.
+	Repo string `json:"repo"`
+	// Commit is the commit currently checked out.
+	Commit string `json:"commit"`
+	// Dir is the cache directory of the repository.
+	Dir string `json:"dir"`
+}
+
+// CachedRepos returns the git repositories in skaffold's remote config cache.
+func CachedRepos(opts config.SkaffoldOptions) ([]CachedRepo, error) {
+	skaffoldCacheDir, err := config.GetRepoCacheDir(opts)
+	if err != nil {
+		return nil, err
+	}
+	dirs, err := filepath.Glob(filepath.Join(skaffoldCacheDir, "*", ".git"))
+	if err != nil {
+		return nil, err
+	}
+	var repos []CachedRepo
+	for _, d := range dirs {
+		r := gitCmd{Dir: filepath.Dir(d)}
+		url, err := r.Run("config", "--get", "remote.origin.url")
+		if err != nil {
+			return nil, fmt.Errorf("reading remote of cached repo %s: %w", r.Dir, err)
+		}
+		head, err := headCommit(r.Dir)
+		if err != nil {
+			return nil, err
+		}
+		repos = append(repos, CachedRepo{Repo: strings.TrimSpace(string(url)), Commit: head, Dir: r.Dir})
+	}
+	return repos, nil
+}
+
+func headCommit(dir string) (string, error) {
+	r := gitCmd{Dir: dir}
+	head, err := r.Run("rev-parse", "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("reading commit of repo %s: %w", dir, err)
+	}
+	return strings.TrimSpace(string(head)), nil
+}
+
+func currentBranch(dir string) (string, error) {
+	r := gitCmd{Dir: dir}
+	branch, err := r.Run("rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("reading branch of repo %s: %w", dir, err)
+	}
+	return strings.TrimSpace(string(branch)), nil
+}
+
+// checkoutCommit fetches the pinned commit if it isn't already checked out and resets the working tree to it.
+func checkoutCommit(r gitCmd, g latest.GitInfo) error {
+	if head, err := r.Run("rev-parse", "HEAD"); err != nil || strings.TrimSpace(string(head)) != g.Commit {
+		if _, err := r.Run("fetch", "--depth", "1", "origin", g.Commit); err != nil {
+			return fmt.Errorf("failed to clone repo %s: unable to fetch commit %s; run 'git clone <REPO>; stat <DIR/SUBDIR>' to verify credentials: %w", g.Repo, g.Commit, err)
+		}
+	}
+	if _, err := r.Run("reset", "--hard", g.Commit); err != nil {
+		return fmt.Errorf("failed to clone repo %s: trouble resetting to commit %s: %w", g.Repo, g.Commit, err)
+	}
+	return verifyCommit(r, g)
+}
+
+// verifyCommit checks that the cached repository is checked out at the pinned commit.
+func verifyCommit(r gitCmd, g latest.GitInfo) error {
+	head, err := r.Run("rev-parse", "HEAD")
+	if err != nil {
+		return fmt.Errorf("failed to verify repo %s: unable to read the checked out commit: %w", g.Repo, err)
+	}
+	if actual := strings.TrimSpace(string(head)); actual != g.Commit {
+		return fmt.Errorf("failed to verify repo %s: expected commit %s but found %s in the target directory %s", g.Repo, g.Commit, actual, r.Dir)
+	}
+	return nil
+}
+
 // gitCmd runs git commands in a git repo.
 type gitCmd struct {
 	// Dir is the directory the commands are run in.
 	Dir string
+	// Config holds `-c` configuration overrides passed to every command.
+	Config []string
+	// Env holds additional environment variables set for every command.
+	Env []string
+}
+
+// newGitCmd returns a gitCmd configured with the credentials described by `auth`.
+// Values coming from the configuration are never interpolated into the shell commands that git runs.
+func newGitCmd(dir string, auth *latest.GitAuth) (gitCmd, error) {
+	r := gitCmd{Dir: dir}
+	if auth == nil {
+		return r, nil
+	}
+	if auth.CredentialHelper != "" || auth.TokenEnv != "" {
+		// an empty helper resets the list of helpers inherited from the user's git config.
+		r.Config = append(r.Config, "credential.helper=")
+	}
+	if auth.CredentialHelper != "" {
+		r.Config = append(r.Config, "credential.helper="+auth.CredentialHelper)
+	}
+	if auth.TokenEnv != "" {
+		if !envVarName.MatchString(auth.TokenEnv) {
+			return gitCmd{}, fmt.Errorf("invalid environment variable name %q for `tokenEnv`", auth.TokenEnv)
+		}
+		username := auth.Username
+		if username == "" {
+			username = "git"
+		}
+		// the helper only references environment variables so that the token doesn't show up in logs or process listings
+		// and so that the username is never parsed by the shell.
+		r.Config = append(r.Config, "credential.helper="+fmt.Sprintf(`!f() { test "$1" = get && echo "username=${%s}" && echo "password=${%s}"; }; f`, usernameEnv, auth.TokenEnv))
+		r.Env = append(r.Env, "GIT_TERMINAL_PROMPT=0", usernameEnv+"="+username)
+	}
+	if auth.SSHKey != "" {
+		key, err := homedir.Expand(auth.SSHKey)
+		if err != nil {
+			key = auth.SSHKey
+		}
+		r.Env = append(r.Env, fmt.Sprintf("GIT_SSH_COMMAND=ssh -i %s -o IdentitiesOnly=yes", shellQuote(key)))
+	}
+	return r, nil
+}
+
+// shellQuote quotes a value for a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
 }
 
 // Run runs a git command.
@@ -185,7 +387,14 @@ func (g *gitCmd) Run(args ...string) ([]byte, error) {
 		return nil, fmt.Errorf("no 'git' program on path: %w", err)
 	}
 
-	cmd := exec.Command(p, args...)
+	var cmdArgs []string
+	for _, c := range g.Config {
+		cmdArgs = append(cmdArgs, "-c", c)
+	}
+	cmd := exec.Command(p, append(cmdArgs, args...)...)
 	cmd.Dir = g.Dir
+	if len(g.Env) > 0 {
+		cmd.Env = append(os.Environ(), g.Env...)
+	}
 	return util.RunCmdOut(cmd)
 }
@@ -66,7 +66,7 @@ func TestDefaultRef(t *testing.T) {
 			}
 			t.Override(&findGit, func() (string, error) { return "git", nil })
 			t.Override(&util.DefaultExecCommand, f)
-			ref, err := defaultRef("https://github.com/foo.git")
+			ref, err := defaultRef("https://github.com/foo.git", nil)
 			t.CheckErrorAndDeepEqual(test.err != nil, err, test.expected, ref)
 		})
 	}
@@ -78,6 +78,7 @@ func TestSyncRepo(t *testing.T) {
 		g           latest.GitInfo
 		cmds        []cmdResponse
 		existing    bool
+		remoteOnly  bool
 		shouldErr   bool
 		expected    string
 	}{
@@ -198,15 +199,107 @@ func TestSyncRepo(t *testing.T) {
 			},
 			shouldErr: true,
 		},
+		{
+			description: "first time sparse repo clone succeeds",
+			g:           latest.GitInfo{Repo: "http://github.com/foo.git", Path: "bar/skaffold.yaml", Ref: "master", Sparse: true},
+			cmds: []cmdResponse{
+				{cmd: "git clone http://github.com/foo.git go6JhWWtSSfa_EVt_ym1YOFXH4QQacT8 --branch master --depth 1 --filter=blob:none --sparse"},
+				{cmd: "git sparse-checkout set bar"},
+			},
+			expected: "go6JhWWtSSfa_EVt_ym1YOFXH4QQacT8",
+		},
+		{
+			description: "first time pinned commit clone succeeds",
+			g:           latest.GitInfo{Repo: "http://github.com/foo.git", Path: "bar/skaffold.yaml", Commit: "8be3f718c015a5fe190bebf356079a25afe0ca57"},
+			cmds: []cmdResponse{
+				{cmd: "git clone http://github.com/foo.git iTl0ZwsSDIeFJZn5zubrnvkoox2AGrXf --no-checkout --depth 1"},
+				{cmd: "git rev-parse HEAD", out: "8be3f718c015a5fe190bebf356079a25afe0ca58"},
+				{cmd: "git fetch --depth 1 origin 8be3f718c015a5fe190bebf356079a25afe0ca57"},
+				{cmd: "git reset --hard 8be3f718c015a5fe190bebf356079a25afe0ca57"},
+				{cmd: "git rev-parse HEAD", out: "8be3f718c015a5fe190bebf356079a25afe0ca57"},
+			},
+			expected: "iTl0ZwsSDIeFJZn5zubrnvkoox2AGrXf",
+		},
+		{
+			description: "pinned commit verification fails",
+			g:           latest.GitInfo{Repo: "http://github.com/foo.git", Path: "bar/skaffold.yaml", Commit: "8be3f718c015a5fe190bebf356079a25afe0ca57"},
+			cmds: []cmdResponse{
+				{cmd: "git clone http://github.com/foo.git iTl0ZwsSDIeFJZn5zubrnvkoox2AGrXf --no-checkout --depth 1"},
+				{cmd: "git rev-parse HEAD", out: "8be3f718c015a5fe190bebf356079a25afe0ca57"},
+				{cmd: "git reset --hard 8be3f718c015a5fe190bebf356079a25afe0ca57"},
+				{cmd: "git rev-parse HEAD", out: "8be3f718c015a5fe190bebf356079a25afe0ca58"},
+			},
+			shouldErr: true,
+		},
+		{
+			description: "abbreviated commit fails",
+			g:           latest.GitInfo{Repo: "http://github.com/foo.git", Path: "bar/skaffold.yaml", Commit: "8be3f71"},
+			shouldErr:   true,
+		},
+		{
+			description: "existing pinned commit repo skips fetch",
+			g:           latest.GitInfo{Repo: "http://github.com/foo.git", Path: "bar/skaffold.yaml", Commit: "8be3f718c015a5fe190bebf356079a25afe0ca57"},
+			existing:    true,
+			cmds: []cmdResponse{
+				{cmd: "git remote -v", out: "origin git@github.com/foo.git"},
+				{cmd: "git diff --name-only --ignore-submodules HEAD"},
+				{cmd: "git rev-parse HEAD", out: "8be3f718c015a5fe190bebf356079a25afe0ca57"},
+				{cmd: "git reset --hard 8be3f718c015a5fe190bebf356079a25afe0ca57"},
+				{cmd: "git rev-parse HEAD", out: "8be3f718c015a5fe190bebf356079a25afe0ca57"},
+			},
+			expected: "iTl0ZwsSDIeFJZn5zubrnvkoox2AGrXf",
+		},
+		{
+			description: "remote cache only with existing repo succeeds without network",
+			g:           latest.GitInfo{Repo: "http://github.com/foo.git", Path: "bar/skaffold.yaml", Ref: "master"},
+			existing:    true,
+			remoteOnly:  true,
+			expected:    "iSEL5rQfK5EJ2yLhnW8tUgcVOvDC8Wjl",
+		},
+		{
+			description: "remote cache only with pinned commit verifies checkout",
+			g:           latest.GitInfo{Repo: "http://github.com/foo.git", Path: "bar/skaffold.yaml", Commit: "8be3f718c015a5fe190bebf356079a25afe0ca57"},
+			existing:    true,
+			remoteOnly:  true,
+			cmds: []cmdResponse{
+				{cmd: "git rev-parse HEAD", out: "8be3f718c015a5fe190bebf356079a25afe0ca58"},
+			},
+			shouldErr: true,
+		},
+		{
+			description: "remote cache only without cached repo fails",
+			g:           latest.GitInfo{Repo: "http://github.com/foo.git", Path: "bar/skaffold.yaml", Ref: "master"},
+			remoteOnly:  true,
+			shouldErr:   true,
+		},
+		{
+			description: "clone with credentials",
+			g: latest.GitInfo{Repo: "http://github.com/foo.git", Path: "bar/skaffold.yaml", Ref: "master", Auth: &latest.GitAuth{
+				TokenEnv: "GITHUB_TOKEN",
+				Username: "x-access-token",
+			}},
+			cmds: []cmdResponse{
+				{cmd: `git -c credential.helper= -c credential.helper=!f() { test "$1" = get && echo "username=${SKAFFOLD_GIT_USERNAME}" && echo "password=${GITHUB_TOKEN}"; }; f clone http://github.com/foo.git iSEL5rQfK5EJ2yLhnW8tUgcVOvDC8Wjl --branch master --depth 1`},
+			},
+			expected: "iSEL5rQfK5EJ2yLhnW8tUgcVOvDC8Wjl",
+		},
+		{
+			description: "token env must be a plain variable name",
+			g: latest.GitInfo{Repo: "http://github.com/foo.git", Path: "bar/skaffold.yaml", Ref: "master", Auth: &latest.GitAuth{
+				TokenEnv: "X}; touch /tmp/pwned; : ${X",
+			}},
+			shouldErr: true,
+		},
 	}
 
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			td := t.NewTempDir()
 			if test.existing {
-				td.Touch("iSEL5rQfK5EJ2yLhnW8tUgcVOvDC8Wjl/.git/")
+				hash, _ := getRepoDir(test.g)
+				td.Touch(hash + "/.git/")
 			}
-			opts := config.SkaffoldOptions{RepoCacheDir: td.Root()}
+			opts := config.SkaffoldOptions{RepoCacheDir: td.Root(), RemoteCacheOnly: test.remoteOnly}
 			var f *testutil.FakeCmd
 			for _, v := range test.cmds {
 				if f == nil {
@@ -232,3 +325,66 @@ type cmdResponse struct {
 	out string
 	err error
 }
+
+func TestCachedRepos(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		td := t.NewTempDir()
+		td.Touch("iSEL5rQfK5EJ2yLhnW8tUgcVOvDC8Wjl/.git/HEAD")
+		td.Touch("oci/KSvUvCmP4YqHL6lZqGBLrjHv9rYZFzBw.json")
+		t.Override(&findGit, func() (string, error) { return "git", nil })
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRunOut("git config --get remote.origin.url", "http://github.com/foo.git\n").
+			AndRunOut("git rev-parse HEAD", "8be3f718c015a5fe190bebf356079a25afe0ca57\n"))
+
+		repos, err := CachedRepos(config.SkaffoldOptions{RepoCacheDir: td.Root()})
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]CachedRepo{{
+			Repo:   "http://github.com/foo.git",
+			Commit: "8be3f718c015a5fe190bebf356079a25afe0ca57",
+			Dir:    td.Path("iSEL5rQfK5EJ2yLhnW8tUgcVOvDC8Wjl"),
+		}}, repos)
+	})
+}
+
+func TestNewGitCmd(t *testing.T) {
+	tests := []struct {
+		description string
+		auth        *latest.GitAuth
+		expected    gitCmd
+		shouldErr   bool
+	}{
+		{
+			description: "no auth",
+			expected:    gitCmd{Dir: "dir"},
+		},
+		{
+			description: "username is passed through the environment",
+			auth:        &latest.GitAuth{TokenEnv: "TOKEN", Username: `"; rm -rf ~; echo "`},
+			expected: gitCmd{
+				Dir:    "dir",
+				Config: []string{"credential.helper=", `credential.helper=!f() { test "$1" = get && echo "username=${SKAFFOLD_GIT_USERNAME}" && echo "password=${TOKEN}"; }; f`},
+				Env:    []string{"GIT_TERMINAL_PROMPT=0", `SKAFFOLD_GIT_USERNAME="; rm -rf ~; echo "`},
+			},
+		},
+		{
+			description: "ssh key is quoted",
+			auth:        &latest.GitAuth{SSHKey: "/keys/it's $(id)"},
+			expected: gitCmd{
+				Dir: "dir",
+				Env: []string{`GIT_SSH_COMMAND=ssh -i '/keys/it'\''s $(id)' -o IdentitiesOnly=yes`},
+			},
+		},
+		{
+			description: "invalid token env",
+			auth:        &latest.GitAuth{TokenEnv: "$(id)"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			r, err := newGitCmd("dir", test.auth)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, r)
+		})
+	}
+}
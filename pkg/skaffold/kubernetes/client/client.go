@@ -30,8 +30,11 @@ import (
 
 // for tests
 var (
-	Client        = getClientset
-	DynamicClient = getDynamicClient
+	Client           = getClientset
+	DynamicClient    = getDynamicClient
+	ClientForContext = getClientsetForContext
+
+	DynamicClientForContext = getDynamicClientForContext
 )
 
 func getClientset() (kubernetes.Interface, error) {
@@ -49,3 +52,31 @@ func getDynamicClient() (dynamic.Interface, error) {
 	}
 	return dynamic.NewForConfig(config)
 }
+
+// getClientsetForContext returns a client for the cluster of the given kube-context.
+// An empty kube-context stands for the active one.
+func getClientsetForContext(kubeContext string) (kubernetes.Interface, error) {
+	if kubeContext == "" {
+		return Client()
+	}
+
+	config, err := context.GetRestClientConfigForContext(kubeContext)
+	if err != nil {
+		return nil, fmt.Errorf("getting client config for Kubernetes client of kube-context %q: %w", kubeContext, err)
+	}
+	return kubernetes.NewForConfig(config)
+}
+
+// getDynamicClientForContext returns a dynamic client for the cluster of the given kube-context.
+// An empty kube-context stands for the active one.
+func getDynamicClientForContext(kubeContext string) (dynamic.Interface, error) {
+	if kubeContext == "" {
+		return DynamicClient()
+	}
+
+	config, err := context.GetRestClientConfigForContext(kubeContext)
+	if err != nil {
+		return nil, fmt.Errorf("getting client config for dynamic client of kube-context %q: %w", kubeContext, err)
+	}
+	return dynamic.NewForConfig(config)
+}
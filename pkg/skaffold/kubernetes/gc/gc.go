@@ -0,0 +1,297 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+)
+
+// Resource is a Kubernetes resource deployed by Skaffold.
+type Resource struct {
+	GVR       schema.GroupVersionResource
+	Kind      string
+	Namespace string
+	Name      string
+	RunID     string
+	User      string
+
+	// Deployed is read from the `skaffold.dev/deployed-at` annotation. It's the creation time
+	// of the resources deployed by versions of Skaffold that didn't stamp it.
+	Deployed time.Time
+
+	// TTL is read from the `skaffold.dev/ttl` annotation. It's zero if the resource doesn't expire.
+	// It starts when the resource was last deployed.
+	TTL time.Duration
+}
+
+// String formats the resource like kubectl does, eg. `deployment.apps/web`.
+func (r Resource) String() string {
+	kind := strings.ToLower(r.Kind)
+	if r.GVR.Group != "" {
+		kind += "." + r.GVR.Group
+	}
+	return kind + "/" + r.Name
+}
+
+// Expired tells whether the resource has outlived its TTL.
+func (r Resource) Expired(now time.Time) bool {
+	return r.TTL > 0 && now.Sub(r.Deployed) > r.TTL
+}
+
+// Session groups the resources deployed by one Skaffold run.
+type Session struct {
+	RunID string
+	User  string
+
+	// LastDeployed is the deployment time of the most recently deployed resource of the session.
+	LastDeployed time.Time
+	Resources    []Resource
+}
+
+// Sessions groups resources by run-id. The oldest sessions come first.
+func Sessions(resources []Resource) []Session {
+	byRunID := map[string]*Session{}
+	var runIDs []string
+	for _, r := range resources {
+		s, found := byRunID[r.RunID]
+		if !found {
+			s = &Session{RunID: r.RunID, User: r.User}
+			byRunID[r.RunID] = s
+			runIDs = append(runIDs, r.RunID)
+		}
+		s.Resources = append(s.Resources, r)
+		if r.Deployed.After(s.LastDeployed) {
+			s.LastDeployed = r.Deployed
+		}
+		if s.User == "" {
+			s.User = r.User
+		}
+	}
+
+	sessions := make([]Session, 0, len(runIDs))
+	for _, runID := range runIDs {
+		sessions = append(sessions, *byRunID[runID])
+	}
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].LastDeployed.Before(sessions[j].LastDeployed)
+	})
+	return sessions
+}
+
+// List lists the resources managed by Skaffold in the cluster of the given kube-context.
+// An empty kube-context stands for the active one.
+// Resources are searched in the given namespaces, "" standing for the default namespace,
+// or in all the namespaces and at the cluster scope if no namespace is given.
+// Resources with an owner, like the pods of a deployment, are left out since they are deleted along with their owner.
+func List(ctx context.Context, kubeContext string, namespaces []string) ([]Resource, error) {
+	client, err := kubernetesclient.ClientForContext(kubeContext)
+	if err != nil {
+		return nil, fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+	dynClient, err := kubernetesclient.DynamicClientForContext(kubeContext)
+	if err != nil {
+		return nil, fmt.Errorf("getting Kubernetes dynamic client: %w", err)
+	}
+
+	apiResources, err := listableResources(client.Discovery())
+	if err != nil {
+		return nil, err
+	}
+
+	selector := fmt.Sprintf("%s=skaffold", label.K8sManagedByLabelKey)
+	var resources []Resource
+	for _, apiResource := range apiResources {
+		var clients []dynamic.ResourceInterface
+		switch {
+		case !apiResource.Namespaced && len(namespaces) > 0:
+			continue
+		case !apiResource.Namespaced:
+			clients = append(clients, dynClient.Resource(apiResource.gvr))
+		case len(namespaces) == 0:
+			clients = append(clients, dynClient.Resource(apiResource.gvr).Namespace(metav1.NamespaceAll))
+		default:
+			for _, ns := range namespaces {
+				if ns == "" {
+					ns = metav1.NamespaceDefault
+				}
+				clients = append(clients, dynClient.Resource(apiResource.gvr).Namespace(ns))
+			}
+		}
+
+		for _, c := range clients {
+			list, err := c.List(ctx, metav1.ListOptions{LabelSelector: selector})
+			if err != nil {
+				if apierrors.IsForbidden(err) || apierrors.IsNotFound(err) || apierrors.IsMethodNotSupported(err) {
+					logrus.Debugf("Skipping %s: %v", apiResource.gvr, err)
+					continue
+				}
+				return nil, fmt.Errorf("listing %s: %w", apiResource.gvr.Resource, err)
+			}
+
+			for _, item := range list.Items {
+				if len(item.GetOwnerReferences()) > 0 {
+					continue
+				}
+
+				resources = append(resources, newResource(apiResource, item))
+			}
+		}
+	}
+
+	return resources, nil
+}
+
+// ListEphemeralNamespaces lists the ephemeral namespaces created by Skaffold sessions
+// in the cluster of the given kube-context. An empty kube-context stands for the active one.
+func ListEphemeralNamespaces(ctx context.Context, kubeContext string) ([]Resource, error) {
+	dynClient, err := kubernetesclient.DynamicClientForContext(kubeContext)
+	if err != nil {
+		return nil, fmt.Errorf("getting Kubernetes dynamic client: %w", err)
+	}
+
+	namespaces := apiResource{
+		APIResource: metav1.APIResource{Name: "namespaces", Kind: "Namespace"},
+		gvr:         schema.GroupVersionResource{Version: "v1", Resource: "namespaces"},
+	}
+	selector := fmt.Sprintf("%s=skaffold,%s=true", label.K8sManagedByLabelKey, label.EphemeralNamespaceLabel)
+	list, err := dynClient.Resource(namespaces.gvr).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("listing namespaces: %w", err)
+	}
+
+	var resources []Resource
+	for _, item := range list.Items {
+		resources = append(resources, newResource(namespaces, item))
+	}
+	return resources, nil
+}
+
+// newResource reads the Skaffold labels and annotations of a listed resource.
+func newResource(apiResource apiResource, item unstructured.Unstructured) Resource {
+	r := Resource{
+		GVR:       apiResource.gvr,
+		Kind:      item.GetKind(),
+		Namespace: item.GetNamespace(),
+		Name:      item.GetName(),
+		RunID:     item.GetLabels()[label.RunIDLabel],
+		User:      item.GetLabels()[label.UserLabel],
+		Deployed:  item.GetCreationTimestamp().Time,
+	}
+	if r.Kind == "" {
+		r.Kind = apiResource.Kind
+	}
+
+	annotations := item.GetAnnotations()
+	if deployed, found := annotations[label.DeployedAtAnnotation]; found {
+		t, err := time.Parse(time.RFC3339, deployed)
+		if err != nil {
+			logrus.Warnf("Ignoring invalid %s annotation %q on %s", label.DeployedAtAnnotation, deployed, r)
+		} else {
+			r.Deployed = t
+		}
+	}
+	if ttl, found := annotations[label.TTLAnnotation]; found {
+		var err error
+		if r.TTL, err = time.ParseDuration(ttl); err != nil {
+			logrus.Warnf("Ignoring invalid %s annotation %q on %s", label.TTLAnnotation, ttl, r)
+		}
+	}
+	return r
+}
+
+// Delete deletes the given resources from the cluster of the given kube-context.
+// Resources that are already gone are ignored.
+func Delete(ctx context.Context, kubeContext string, resources []Resource) error {
+	dynClient, err := kubernetesclient.DynamicClientForContext(kubeContext)
+	if err != nil {
+		return fmt.Errorf("getting Kubernetes dynamic client: %w", err)
+	}
+
+	propagation := metav1.DeletePropagationBackground
+	for _, r := range resources {
+		var c dynamic.ResourceInterface = dynClient.Resource(r.GVR)
+		if r.Namespace != "" {
+			c = dynClient.Resource(r.GVR).Namespace(r.Namespace)
+		}
+
+		err := c.Delete(ctx, r.Name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("deleting %s: %w", r, err)
+		}
+	}
+	return nil
+}
+
+type apiResource struct {
+	metav1.APIResource
+	gvr schema.GroupVersionResource
+}
+
+// listableResources lists the kinds of resources that can be both listed and deleted,
+// in the version preferred by the server.
+func listableResources(disco discovery.DiscoveryInterface) ([]apiResource, error) {
+	groups, lists, err := disco.ServerGroupsAndResources()
+	if err != nil {
+		if !discovery.IsGroupDiscoveryFailedError(err) {
+			return nil, fmt.Errorf("discovering server resources: %w", err)
+		}
+		// Some aggregated APIs may be unavailable
+		logrus.Debugf("Ignoring partial discovery failure: %v", err)
+	}
+
+	preferred := map[string]bool{}
+	for _, g := range groups {
+		preferred[g.PreferredVersion.GroupVersion] = true
+	}
+
+	var resources []apiResource
+	for _, list := range discovery.FilteredBy(discovery.SupportsAllVerbs{Verbs: []string{"list", "delete"}}, lists) {
+		if !preferred[list.GroupVersion] {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, r := range list.APIResources {
+			// Skip subresources
+			if strings.Contains(r.Name, "/") {
+				continue
+			}
+			resources = append(resources, apiResource{
+				APIResource: r,
+				gvr:         gv.WithResource(r.Name),
+			})
+		}
+	}
+	return resources, nil
+}
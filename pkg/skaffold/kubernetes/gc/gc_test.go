@@ -0,0 +1,201 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	fakedynclient "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
+	fakeclient "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+var (
+	deployments = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	configMaps  = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	namespaces  = schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+
+	created    = time.Date(2021, 4, 1, 12, 0, 0, 0, time.UTC)
+	redeployed = time.Date(2021, 4, 3, 9, 30, 0, 0, time.UTC)
+)
+
+func fakeClients(t *testutil.T, objs ...runtime.Object) dynamic.Interface {
+	client := fakeclient.NewSimpleClientset()
+	client.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "configmaps", Kind: "ConfigMap", Namespaced: true, Verbs: []string{"list", "delete"}},
+				{Name: "namespaces", Kind: "Namespace", Verbs: []string{"list", "delete"}},
+				{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"list", "delete"}},
+				{Name: "pods/log", Kind: "Pod", Namespaced: true, Verbs: []string{"get"}},
+				{Name: "bindings", Kind: "Binding", Namespaced: true, Verbs: []string{"create"}},
+			},
+		},
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", Kind: "Deployment", Namespaced: true, Verbs: []string{"list", "delete"}},
+			},
+		},
+	}
+	dynClient := fakedynclient.NewSimpleDynamicClient(scheme.Scheme, objs...)
+
+	t.Override(&kubernetesclient.ClientForContext, func(string) (kubernetes.Interface, error) { return client, nil })
+	t.Override(&kubernetesclient.DynamicClientForContext, func(string) (dynamic.Interface, error) { return dynClient, nil })
+	return dynClient
+}
+
+func objectMeta(namespace, name, runID string, annotations map[string]string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Namespace:         namespace,
+		Name:              name,
+		CreationTimestamp: metav1.NewTime(created),
+		Labels: map[string]string{
+			"app.kubernetes.io/managed-by": "skaffold",
+			"skaffold.dev/run-id":          runID,
+			"skaffold.dev/user":            "jane",
+		},
+		Annotations: annotations,
+	}
+}
+
+func TestList(t *testing.T) {
+	objs := []runtime.Object{
+		&appsv1.Deployment{TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"}, ObjectMeta: objectMeta("default", "web", "run1", nil)},
+		&v1.ConfigMap{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"}, ObjectMeta: objectMeta("dev", "cfg", "run2", map[string]string{"skaffold.dev/ttl": "1h", "skaffold.dev/deployed-at": "2021-04-03T09:30:00Z"})},
+		&v1.ConfigMap{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"}, ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "not-managed"}},
+		&v1.Namespace{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"}, ObjectMeta: objectMeta("", "skaffold-jane", "run2", nil)},
+	}
+	owned := &v1.Pod{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"}, ObjectMeta: objectMeta("default", "web-1234", "run1", nil)}
+	owned.OwnerReferences = []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "web"}}
+	objs = append(objs, owned)
+
+	web := Resource{GVR: deployments, Kind: "Deployment", Namespace: "default", Name: "web", RunID: "run1", User: "jane", Deployed: created}
+	cfg := Resource{GVR: configMaps, Kind: "ConfigMap", Namespace: "dev", Name: "cfg", RunID: "run2", User: "jane", Deployed: redeployed, TTL: time.Hour}
+	ns := Resource{GVR: namespaces, Kind: "Namespace", Name: "skaffold-jane", RunID: "run2", User: "jane", Deployed: created}
+
+	tests := []struct {
+		description string
+		namespaces  []string
+		expected    []Resource
+	}{
+		{
+			description: "all namespaces",
+			expected:    []Resource{cfg, ns, web},
+		},
+		{
+			description: "given namespaces",
+			namespaces:  []string{"dev"},
+			expected:    []Resource{cfg},
+		},
+		{
+			description: "empty namespace stands for default",
+			namespaces:  []string{""},
+			expected:    []Resource{web},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			fakeClients(t, objs...)
+
+			resources, err := List(context.Background(), "", test.namespaces)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, resources)
+		})
+	}
+}
+
+func TestListEphemeralNamespaces(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		ephemeral := objectMeta("", "skaffold-jane", "run1", map[string]string{"skaffold.dev/ttl": "1h"})
+		ephemeral.Labels["skaffold.dev/ephemeral-namespace"] = "true"
+		fakeClients(t,
+			&v1.Namespace{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"}, ObjectMeta: ephemeral},
+			&v1.Namespace{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"}, ObjectMeta: objectMeta("", "deployed", "run1", nil)},
+		)
+
+		resources, err := ListEphemeralNamespaces(context.Background(), "")
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]Resource{{GVR: namespaces, Kind: "Namespace", Name: "skaffold-jane", RunID: "run1", User: "jane", Deployed: created, TTL: time.Hour}}, resources)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		dynClient := fakeClients(t,
+			&v1.ConfigMap{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"}, ObjectMeta: objectMeta("dev", "cfg", "run1", nil)},
+			&v1.Namespace{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"}, ObjectMeta: objectMeta("", "skaffold-jane", "run1", nil)},
+		)
+
+		err := Delete(context.Background(), "", []Resource{
+			{GVR: configMaps, Namespace: "dev", Name: "cfg"},
+			{GVR: namespaces, Name: "skaffold-jane"},
+			{GVR: configMaps, Namespace: "dev", Name: "already-deleted"},
+		})
+		t.CheckNoError(err)
+
+		cms, err := dynClient.Resource(configMaps).Namespace("dev").List(context.Background(), metav1.ListOptions{})
+		t.CheckNoError(err)
+		t.CheckDeepEqual(0, len(cms.Items))
+		nss, err := dynClient.Resource(namespaces).List(context.Background(), metav1.ListOptions{})
+		t.CheckNoError(err)
+		t.CheckDeepEqual(0, len(nss.Items))
+	})
+}
+
+func TestSessions(t *testing.T) {
+	older := created.Add(-time.Hour)
+	resources := []Resource{
+		{Name: "web", RunID: "run1", User: "jane", Deployed: created},
+		{Name: "db", RunID: "run2", Deployed: older},
+		{Name: "cfg", RunID: "run1", User: "jane", Deployed: older},
+		{Name: "cache", RunID: "run2", User: "john", Deployed: older},
+	}
+
+	sessions := Sessions(resources)
+
+	testutil.CheckDeepEqual(t, []Session{
+		{RunID: "run2", User: "john", LastDeployed: older, Resources: []Resource{resources[1], resources[3]}},
+		{RunID: "run1", User: "jane", LastDeployed: created, Resources: []Resource{resources[0], resources[2]}},
+	}, sessions)
+}
+
+func TestResource(t *testing.T) {
+	r := Resource{GVR: deployments, Kind: "Deployment", Name: "web", Deployed: created}
+	testutil.CheckDeepEqual(t, "deployment.apps/web", r.String())
+	testutil.CheckDeepEqual(t, "configmap/cfg", Resource{GVR: configMaps, Kind: "ConfigMap", Name: "cfg"}.String())
+
+	testutil.CheckDeepEqual(t, false, r.Expired(created.Add(48*time.Hour)))
+	r.TTL = time.Hour
+	testutil.CheckDeepEqual(t, false, r.Expired(created.Add(time.Minute)))
+	testutil.CheckDeepEqual(t, true, r.Expired(created.Add(2*time.Hour)))
+}
@@ -17,51 +17,80 @@ limitations under the License.
 package kubernetes
 
 import (
-	"bufio"
 	"context"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
 
+// logBufferSize is the number of log lines buffered before the log streams are paused because the output is too slow.
+const logBufferSize = 1000
+
 // LogAggregator aggregates the logs for all the deployed pods.
 type LogAggregator struct {
 	output      io.Writer
-	kubectlcli  *kubectl.CLI
 	config      Config
 	podWatcher  PodWatcher
 	colorPicker ColorPicker
+	namespaces  []string
+	runID       string
+	kubeContext string
 
 	muted             int32
 	sinceTime         time.Time
 	events            chan PodEvent
+	lines             chan logLine
+	stop              chan struct{}
+	printed           chan struct{}
 	trackedContainers trackedContainers
+	tracked           trackedResources
 	outputLock        sync.Mutex
 }
 
+// logLine is a log line waiting to be printed.
+type logLine struct {
+	headerColor color.Color
+	prefix      string
+	text        string
+}
+
 type Config interface {
 	PipelineForImage(imageName string) (latest.Pipeline, bool)
 	DefaultPipeline() latest.Pipeline
+	Logs() config.LogsOptions
 }
 
 // NewLogAggregator creates a new LogAggregator for a given output.
-func NewLogAggregator(out io.Writer, cli *kubectl.CLI, imageNames []string, podSelector PodSelector, namespaces []string, config Config) *LogAggregator {
+// Kubernetes events are shown for the pods labelled with `runID`, or for all the selected pods if it's empty.
+// `kubeContext` is the kube-context of the cluster to read logs from when Skaffold deploys to several clusters.
+// Log lines are then prefixed with it. It's empty otherwise, and the active kube-context is used.
+func NewLogAggregator(out io.Writer, imageNames []string, podSelector PodSelector, namespaces []string, runID string, config Config, kubeContext string) *LogAggregator {
 	return &LogAggregator{
 		output:      out,
-		kubectlcli:  cli,
 		config:      config,
-		podWatcher:  NewPodWatcher(podSelector, namespaces),
+		podWatcher:  NewPodWatcher(podSelector, namespaces, kubeContext),
 		colorPicker: NewColorPicker(imageNames),
+		namespaces:  namespaces,
+		runID:       runID,
+		kubeContext: kubeContext,
 		events:      make(chan PodEvent),
+		lines:       make(chan logLine, logBufferSize),
+		stop:        make(chan struct{}),
 	}
 }
 
@@ -82,12 +111,26 @@ func (a *LogAggregator) Start(ctx context.Context) error {
 		return nil
 	}
 
+	// fail early on invalid filters
+	if _, err := newLogFormatter(mergeLogsConfig(a.config.DefaultPipeline().Deploy.Logs, a.config.Logs())); err != nil {
+		return err
+	}
+
+	kubeclient, err := client.ClientForContext(a.kubeContext)
+	if err != nil {
+		return fmt.Errorf("getting k8s client: %w", err)
+	}
+
 	a.podWatcher.Register(a.events)
 	stopWatcher, err := a.podWatcher.Start()
 	if err != nil {
 		return err
 	}
 
+	a.printed = make(chan struct{})
+	go a.printLogLines(ctx)
+	a.watchEvents(ctx, kubeclient)
+
 	go func() {
 		defer stopWatcher()
 
@@ -100,9 +143,11 @@ func (a *LogAggregator) Start(ctx context.Context) error {
 					return
 				}
 
-				// TODO(dgageot): Add EphemeralContainerStatuses
 				pod := evt.Pod
-				for _, c := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+				a.trackPod(ctx, kubeclient, pod)
+				a.reportTerminations(pod)
+
+				for _, c := range allContainerStatuses(pod) {
 					if c.ContainerID == "" {
 						if c.State.Waiting != nil && c.State.Waiting.Message != "" {
 							color.Red.Fprintln(a.output, c.State.Waiting.Message)
@@ -111,7 +156,7 @@ func (a *LogAggregator) Start(ctx context.Context) error {
 					}
 
 					if !a.trackedContainers.add(c.ContainerID) {
-						go a.streamContainerLogs(ctx, pod, c)
+						go a.streamContainerLogs(ctx, kubeclient, pod, c)
 					}
 				}
 			}
@@ -121,7 +166,7 @@ func (a *LogAggregator) Start(ctx context.Context) error {
 	return nil
 }
 
-// Stop stops the logger.
+// Stop stops the logger. It returns once the queued log lines are printed.
 func (a *LogAggregator) Stop() {
 	if a == nil {
 		// Logs are not activated.
@@ -129,6 +174,10 @@ func (a *LogAggregator) Stop() {
 	}
 
 	close(a.events)
+	close(a.stop)
+	if a.printed != nil {
+		<-a.printed
+	}
 }
 
 func sinceSeconds(d time.Duration) int64 {
@@ -141,33 +190,110 @@ func sinceSeconds(d time.Duration) int64 {
 	return 1
 }
 
-func (a *LogAggregator) streamContainerLogs(ctx context.Context, pod *v1.Pod, container v1.ContainerStatus) {
+func (a *LogAggregator) streamContainerLogs(ctx context.Context, kubeclient kubernetes.Interface, pod *v1.Pod, container v1.ContainerStatus) {
+	c := a.logsConfig(pod)
+	formatter, err := newLogFormatter(c)
+	if err != nil {
+		logrus.Errorf("filtering logs from pod: %s container: %s: %v", pod.Name, container.Name, err)
+		return
+	}
+
+	var file io.Writer
+	if c.Dir != "" {
+		f, err := openLogFile(c.Dir, pod, container)
+		if err != nil {
+			logrus.Warnf("unable to write logs of pod: %s container: %s to %s: %v", pod.Name, container.Name, c.Dir, err)
+		} else {
+			defer f.Close()
+			file = f
+		}
+	}
+
+	show := selectsContainer(c.Filter, pod, container)
+	if !show && file == nil {
+		logrus.Debugf("Not streaming logs from pod: %s container: %s: filtered out", pod.Name, container.Name)
+		return
+	}
+
 	logrus.Infof("Streaming logs from pod: %s container: %s", pod.Name, container.Name)
 
-	// In theory, it's more precise to use --since-time='' but there can be a time
+	// In theory, it's more precise to use sinceTime but there can be a time
 	// difference between the user's machine and the server.
-	// So we use --since=Xs and round up to the nearest second to not lose any log.
-	sinceSeconds := fmt.Sprintf("--since=%ds", sinceSeconds(time.Since(a.sinceTime)))
-
-	tr, tw := io.Pipe()
-	go func() {
-		if err := a.kubectlcli.Run(ctx, nil, tw, "logs", sinceSeconds, "-f", pod.Name, "-c", container.Name, "--namespace", pod.Namespace); err != nil {
-			// Don't print errors if the user interrupted the logs
-			// or if the logs were interrupted because of a configuration change
-			if ctx.Err() != context.Canceled {
-				logrus.Warn(err)
-			}
-		}
-		_ = tw.Close()
-	}()
+	// So we use sinceSeconds and round up to the nearest second to not lose any log.
+	stream := newContainerLogStream(kubeclient, pod, container, sinceSeconds(time.Since(a.sinceTime)))
 
 	headerColor := a.colorPicker.Pick(pod)
 	prefix := a.prefix(pod, container)
-	if err := a.streamRequest(ctx, headerColor, prefix, tr); err != nil {
-		logrus.Errorf("streaming request %s", err)
+	err = stream.follow(ctx, func(line string) {
+		if file != nil {
+			fmt.Fprint(file, line)
+		}
+		if !show {
+			return
+		}
+		if text, shown := formatter.format(line); shown {
+			a.enqueueLogLine(headerColor, prefix, text)
+			event.ApplicationLogReceived(pod.Name, container.Name, pod.Namespace, line, a.kubeContext)
+		}
+	})
+	if err != nil {
+		logrus.Warn(err)
 	}
 }
 
+// enqueueLogLine queues a log line to be printed. It blocks while too many lines are already queued,
+// which pauses the log stream until the output catches up.
+func (a *LogAggregator) enqueueLogLine(headerColor color.Color, prefix, text string) {
+	if a.kubeContext != "" {
+		prefix = strings.TrimSpace(fmt.Sprintf("[%s] %s", a.kubeContext, prefix))
+	}
+
+	select {
+	case a.lines <- logLine{headerColor: headerColor, prefix: prefix, text: text}:
+	case <-a.printed:
+		// Nothing is printed anymore.
+	}
+}
+
+// printLogLines prints the queued log lines until the logger is stopped or the context is cancelled.
+// The lines that are still queued are then printed before it returns.
+func (a *LogAggregator) printLogLines(ctx context.Context) {
+	defer close(a.printed)
+
+	for {
+		select {
+		case l := <-a.lines:
+			a.printLogLine(l.headerColor, l.prefix, l.text)
+		case <-ctx.Done():
+			a.flushLogLines()
+			return
+		case <-a.stop:
+			a.flushLogLines()
+			return
+		}
+	}
+}
+
+// flushLogLines prints the log lines that are queued.
+func (a *LogAggregator) flushLogLines() {
+	for {
+		select {
+		case l := <-a.lines:
+			a.printLogLine(l.headerColor, l.prefix, l.text)
+		default:
+			return
+		}
+	}
+}
+
+// openLogFile opens, for appending, the file in `dir` where the logs of a container are written.
+func openLogFile(dir string, pod *v1.Pod, container v1.ContainerStatus) (*os.File, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return os.OpenFile(filepath.Join(dir, fmt.Sprintf("%s_%s.log", pod.Name, container.Name)), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+}
+
 func (a *LogAggregator) printLogLine(headerColor color.Color, prefix, text string) {
 	if !a.IsMuted() {
 		a.outputLock.Lock()
@@ -179,7 +305,8 @@ func (a *LogAggregator) printLogLine(headerColor color.Color, prefix, text strin
 	}
 }
 
-func (a *LogAggregator) prefix(pod *v1.Pod, container v1.ContainerStatus) string {
+// logsConfig returns the logs configuration of the pipeline that deployed a pod, overridden by the command line options.
+func (a *LogAggregator) logsConfig(pod *v1.Pod) latest.LogsConfig {
 	var c latest.Pipeline
 	var present bool
 	for _, container := range pod.Spec.Containers {
@@ -190,7 +317,12 @@ func (a *LogAggregator) prefix(pod *v1.Pod, container v1.ContainerStatus) string
 	if !present {
 		c = a.config.DefaultPipeline()
 	}
-	switch c.Deploy.Logs.Prefix {
+	return mergeLogsConfig(c.Deploy.Logs, a.config.Logs())
+}
+
+func (a *LogAggregator) prefix(pod *v1.Pod, container v1.ContainerStatus) string {
+	c := a.logsConfig(pod)
+	switch c.Prefix {
 	case "auto":
 		if pod.Name != container.Name {
 			return podAndContainerPrefix(pod, container)
@@ -203,7 +335,7 @@ func (a *LogAggregator) prefix(pod *v1.Pod, container v1.ContainerStatus) string
 	case "none":
 		return ""
 	default:
-		panic("unsupported prefix: " + c.Deploy.Logs.Prefix)
+		panic("unsupported prefix: " + c.Prefix)
 	}
 }
 
@@ -222,28 +354,6 @@ func podAndContainerPrefix(pod *v1.Pod, container v1.ContainerStatus) string {
 	return fmt.Sprintf("[%s %s]", pod.Name, container.Name)
 }
 
-func (a *LogAggregator) streamRequest(ctx context.Context, headerColor color.Color, prefix string, rc io.Reader) error {
-	r := bufio.NewReader(rc)
-	for {
-		select {
-		case <-ctx.Done():
-			logrus.Infof("%s interrupted", prefix)
-			return nil
-		default:
-			// Read up to newline
-			line, err := r.ReadString('\n')
-			if err == io.EOF {
-				return nil
-			}
-			if err != nil {
-				return fmt.Errorf("reading bytes from log stream: %w", err)
-			}
-
-			a.printLogLine(headerColor, prefix, line)
-		}
-	}
-}
-
 // Mute mutes the logs.
 func (a *LogAggregator) Mute() {
 	if a == nil {
@@ -0,0 +1,374 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+// maxPendingEvents is the number of recent warning events of untracked resources that are kept,
+// in case the pod they concern is seen later.
+const maxPendingEvents = 100
+
+// trackedResources are the pods, and their owners, whose events are shown with the logs.
+type trackedResources struct {
+	sync.Mutex
+	uids         map[types.UID]bool
+	terminations map[string]bool
+	pending      []*v1.Event
+}
+
+// trackPod starts showing the events of a pod and of its owners, up to the top-level workload.
+// Only the pods labelled with the current run id are tracked, if there's one.
+// The events of the newly tracked resources that were received before the pod are shown then.
+func (a *LogAggregator) trackPod(ctx context.Context, kubeclient kubernetes.Interface, pod *v1.Pod) {
+	if a.runID != "" && pod.Labels[label.RunIDLabel] != a.runID {
+		return
+	}
+	if a.isTracked(pod.UID) {
+		return
+	}
+
+	uids := append([]types.UID{pod.UID}, ownerUIDs(ctx, kubeclient, pod)...)
+
+	a.tracked.Lock()
+	if a.tracked.uids == nil {
+		a.tracked.uids = map[types.UID]bool{}
+	}
+	for _, uid := range uids {
+		a.tracked.uids[uid] = true
+	}
+	var received []*v1.Event
+	pending := a.tracked.pending[:0]
+	for _, e := range a.tracked.pending {
+		if a.tracked.uids[e.InvolvedObject.UID] {
+			received = append(received, e)
+		} else {
+			pending = append(pending, e)
+		}
+	}
+	a.tracked.pending = pending
+	a.tracked.Unlock()
+
+	for _, e := range received {
+		a.showEvent(e)
+	}
+}
+
+// ownerUIDs returns the UIDs of the owners of a pod, following the owner references up to the top-level workloads.
+func ownerUIDs(ctx context.Context, kubeclient kubernetes.Interface, pod *v1.Pod) []types.UID {
+	var uids []types.UID
+	seen := map[types.UID]bool{}
+	owners := append([]metav1.OwnerReference{}, pod.OwnerReferences...)
+	for len(owners) > 0 {
+		owner := owners[0]
+		owners = owners[1:]
+		if seen[owner.UID] {
+			continue
+		}
+		seen[owner.UID] = true
+		uids = append(uids, owner.UID)
+
+		obj, err := getOwner(ctx, kubeclient, pod.Namespace, owner)
+		if err != nil {
+			logrus.Debugf("Unable to get the owners of %s %q: %v", owner.Kind, owner.Name, err)
+			continue
+		}
+		owners = append(owners, obj.GetOwnerReferences()...)
+	}
+	return uids
+}
+
+func (a *LogAggregator) isTracked(uid types.UID) bool {
+	a.tracked.Lock()
+	defer a.tracked.Unlock()
+
+	return a.tracked.uids[uid]
+}
+
+// reportTerminations shows the containers of a tracked pod that were killed or exited with an error.
+// Each termination is reported once.
+func (a *LogAggregator) reportTerminations(pod *v1.Pod) {
+	if !a.isTracked(pod.UID) {
+		return
+	}
+
+	for _, c := range allContainerStatuses(pod) {
+		for _, terminated := range []*v1.ContainerStateTerminated{c.State.Terminated, c.LastTerminationState.Terminated} {
+			if terminated == nil || (terminated.ExitCode == 0 && terminated.Reason != "OOMKilled") {
+				continue
+			}
+			if !terminated.FinishedAt.IsZero() && terminated.FinishedAt.Time.Before(a.sinceTime) {
+				continue
+			}
+			if !a.addTermination(pod, c, terminated) {
+				continue
+			}
+
+			reason := terminated.Reason
+			if reason == "" {
+				reason = "Error"
+			}
+			message := fmt.Sprintf("Container %s terminated: %s (exit code %d)", c.Name, reason, terminated.ExitCode)
+			if c.RestartCount > 0 {
+				message += fmt.Sprintf(", restarted %d times", c.RestartCount)
+			}
+
+			a.enqueueLogLine(color.Red, fmt.Sprintf("[pod/%s]", pod.Name), message+"\n")
+			event.KubernetesEventReceived(&proto.KubernetesEvent{
+				Type:          v1.EventTypeWarning,
+				Reason:        reason,
+				Message:       message,
+				Kind:          "Pod",
+				Name:          pod.Name,
+				Namespace:     pod.Namespace,
+				ContainerName: c.Name,
+				ExitCode:      terminated.ExitCode,
+				RestartCount:  c.RestartCount,
+				Count:         1,
+				KubeContext:   a.kubeContext,
+			})
+		}
+	}
+}
+
+// addTermination records a container termination. Returns false if it was already recorded.
+func (a *LogAggregator) addTermination(pod *v1.Pod, c v1.ContainerStatus, terminated *v1.ContainerStateTerminated) bool {
+	id := terminated.ContainerID
+	if id == "" {
+		id = fmt.Sprintf("%s/%s/%s/%d", pod.UID, c.Name, terminated.FinishedAt.Format(time.RFC3339), terminated.ExitCode)
+	}
+
+	a.tracked.Lock()
+	defer a.tracked.Unlock()
+
+	if a.tracked.terminations == nil {
+		a.tracked.terminations = map[string]bool{}
+	}
+	if a.tracked.terminations[id] {
+		return false
+	}
+	a.tracked.terminations[id] = true
+	return true
+}
+
+var (
+	// eventWatchBackoff is the delay before watching events again after the watch was closed,
+	// multiplied by the number of consecutive attempts.
+	eventWatchBackoff = time.Second
+
+	// maxEventWatchBackoff is the maximum delay before watching events again.
+	maxEventWatchBackoff = 30 * time.Second
+)
+
+// watchEvents shows the warning events of the tracked resources until the context is cancelled.
+func (a *LogAggregator) watchEvents(ctx context.Context, kubeclient kubernetes.Interface) {
+	for _, ns := range a.namespaces {
+		events := kubeclient.CoreV1().Events(ns)
+
+		watcher, err := watchWarningEvents(ctx, events, "")
+		if err != nil {
+			logrus.Warnf("unable to watch Kubernetes events in %q: %v", ns, err)
+			continue
+		}
+
+		go a.followEvents(ctx, events, ns, watcher)
+	}
+}
+
+// followEvents handles the events of a watch until the context is cancelled.
+// When the API server closes the watch, it's re-established from the last seen resourceVersion.
+func (a *LogAggregator) followEvents(ctx context.Context, events corev1.EventInterface, ns string, watcher watch.Interface) {
+	var resourceVersion string
+	retries := 0
+
+	for {
+		received := a.handleEvents(ctx, watcher, &resourceVersion)
+		watcher.Stop()
+		if ctx.Err() != nil {
+			return
+		}
+		if received {
+			retries = 0
+		}
+
+		for {
+			retries++
+			delay := eventWatchBackoff * time.Duration(retries)
+			if delay > maxEventWatchBackoff {
+				delay = maxEventWatchBackoff
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+
+			var err error
+			watcher, err = watchWarningEvents(ctx, events, resourceVersion)
+			if err == nil {
+				break
+			}
+			if apierrors.IsGone(err) || apierrors.IsResourceExpired(err) {
+				// The resourceVersion is too old: watch from the current state.
+				resourceVersion = ""
+			}
+			logrus.Debugf("Watching Kubernetes events in %q again (attempt %d): %v", ns, retries, err)
+		}
+	}
+}
+
+// handleEvents handles the events of a watch until it's closed or the context is cancelled.
+// It records the resourceVersion of the last event and returns true if any event was received.
+func (a *LogAggregator) handleEvents(ctx context.Context, watcher watch.Interface, resourceVersion *string) bool {
+	received := false
+
+	for {
+		select {
+		case <-ctx.Done():
+			return received
+		case evt, ok := <-watcher.ResultChan():
+			if !ok {
+				return received
+			}
+			if evt.Type == watch.Error {
+				if err := apierrors.FromObject(evt.Object); apierrors.IsGone(err) || apierrors.IsResourceExpired(err) {
+					// The resourceVersion is too old: watch from the current state.
+					*resourceVersion = ""
+				}
+				return received
+			}
+
+			e, ok := evt.Object.(*v1.Event)
+			if !ok {
+				continue
+			}
+			received = true
+			*resourceVersion = e.ResourceVersion
+			if evt.Type == watch.Added || evt.Type == watch.Modified {
+				a.handleEvent(e)
+			}
+		}
+	}
+}
+
+// watchWarningEvents watches the warning events, starting after `resourceVersion` if it isn't empty.
+func watchWarningEvents(ctx context.Context, events corev1.EventInterface, resourceVersion string) (watch.Interface, error) {
+	var forever int64 = 3600 * 24 * 365 * 100
+
+	return events.Watch(ctx, metav1.ListOptions{
+		FieldSelector:   "type=" + v1.EventTypeWarning,
+		ResourceVersion: resourceVersion,
+		TimeoutSeconds:  &forever,
+	})
+}
+
+// handleEvent shows a warning event if it concerns a tracked resource and happened during this session.
+func (a *LogAggregator) handleEvent(e *v1.Event) {
+	if e.Type != v1.EventTypeWarning || eventTime(e).Before(a.sinceTime) {
+		return
+	}
+	if a.isTrackedOrKeep(e) {
+		a.showEvent(e)
+	}
+}
+
+// isTrackedOrKeep returns true if an event concerns a tracked resource.
+// Otherwise the event is kept, in case the resource is tracked once its pod is seen.
+func (a *LogAggregator) isTrackedOrKeep(e *v1.Event) bool {
+	a.tracked.Lock()
+	defer a.tracked.Unlock()
+
+	if a.tracked.uids[e.InvolvedObject.UID] {
+		return true
+	}
+	for i, p := range a.tracked.pending {
+		if p.UID != "" && p.UID == e.UID {
+			// The event was updated, for example its count
+			a.tracked.pending[i] = e
+			return false
+		}
+	}
+	a.tracked.pending = append(a.tracked.pending, e)
+	if len(a.tracked.pending) > maxPendingEvents {
+		a.tracked.pending = a.tracked.pending[len(a.tracked.pending)-maxPendingEvents:]
+	}
+	return false
+}
+
+// showEvent shows a warning event with the logs and sends it to the event API.
+func (a *LogAggregator) showEvent(e *v1.Event) {
+	kind := strings.ToLower(e.InvolvedObject.Kind)
+	message := fmt.Sprintf("%s: %s", e.Reason, strings.TrimSpace(e.Message))
+	if e.Count > 1 {
+		message += fmt.Sprintf(" (x%d)", e.Count)
+	}
+
+	a.enqueueLogLine(color.Yellow, fmt.Sprintf("[%s/%s]", kind, e.InvolvedObject.Name), message+"\n")
+	event.KubernetesEventReceived(&proto.KubernetesEvent{
+		Type:          e.Type,
+		Reason:        e.Reason,
+		Message:       e.Message,
+		Kind:          e.InvolvedObject.Kind,
+		Name:          e.InvolvedObject.Name,
+		Namespace:     e.InvolvedObject.Namespace,
+		ContainerName: containerName(e.InvolvedObject.FieldPath),
+		Count:         e.Count,
+		KubeContext:   a.kubeContext,
+	})
+}
+
+// eventTime returns the last time an event occurred.
+func eventTime(e *v1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	default:
+		return e.FirstTimestamp.Time
+	}
+}
+
+// containerName extracts the name of a container from a field path like `spec.containers{web}`.
+func containerName(fieldPath string) string {
+	start := strings.Index(fieldPath, "{")
+	end := strings.LastIndex(fieldPath, "}")
+	if start < 0 || end < start {
+		return ""
+	}
+	return fieldPath[start+1 : end]
+}
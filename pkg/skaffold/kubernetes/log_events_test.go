@@ -0,0 +1,336 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func queuedLines(a *LogAggregator) []string {
+	var lines []string
+	for len(a.lines) > 0 {
+		l := <-a.lines
+		lines = append(lines, l.prefix+" "+l.text)
+	}
+	return lines
+}
+
+func TestReportTerminations(t *testing.T) {
+	since := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		labels      map[string]string
+		statuses    []v1.ContainerStatus
+		expected    []string
+	}{
+		{
+			description: "oom killed",
+			labels:      map[string]string{label.RunIDLabel: "run-id"},
+			statuses: []v1.ContainerStatus{{
+				Name:                 "web",
+				RestartCount:         2,
+				State:                v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+				LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ContainerID: "docker://1", Reason: "OOMKilled", ExitCode: 137, FinishedAt: metav1.NewTime(since.Add(time.Minute))}},
+			}},
+			expected: []string{"[pod/pod] Container web terminated: OOMKilled (exit code 137), restarted 2 times\n"},
+		},
+		{
+			description: "error without reason",
+			labels:      map[string]string{label.RunIDLabel: "run-id"},
+			statuses: []v1.ContainerStatus{{
+				Name:  "init",
+				State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ContainerID: "docker://2", ExitCode: 1}},
+			}},
+			expected: []string{"[pod/pod] Container init terminated: Error (exit code 1)\n"},
+		},
+		{
+			description: "successful termination",
+			labels:      map[string]string{label.RunIDLabel: "run-id"},
+			statuses: []v1.ContainerStatus{{
+				Name:  "job",
+				State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ContainerID: "docker://3", Reason: "Completed"}},
+			}},
+		},
+		{
+			description: "terminated before the session",
+			labels:      map[string]string{label.RunIDLabel: "run-id"},
+			statuses: []v1.ContainerStatus{{
+				Name:                 "web",
+				LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ContainerID: "docker://4", Reason: "Error", ExitCode: 1, FinishedAt: metav1.NewTime(since.Add(-time.Minute))}},
+			}},
+		},
+		{
+			description: "other run",
+			labels:      map[string]string{label.RunIDLabel: "other-run-id"},
+			statuses: []v1.ContainerStatus{{
+				Name:  "web",
+				State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ContainerID: "docker://5", Reason: "Error", ExitCode: 1}},
+			}},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			a := &LogAggregator{runID: "run-id", sinceTime: since, lines: make(chan logLine, 10)}
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "pod", UID: "pod-uid", Labels: test.labels},
+				Status:     v1.PodStatus{ContainerStatuses: test.statuses},
+			}
+
+			a.trackPod(context.Background(), fakekubeclientset.NewSimpleClientset(), pod)
+			a.reportTerminations(pod)
+			// terminations are reported once
+			a.reportTerminations(pod)
+
+			t.CheckDeepEqual(test.expected, queuedLines(a))
+		})
+	}
+}
+
+func TestHandleEvent(t *testing.T) {
+	since := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		event       v1.Event
+		expected    []string
+	}{
+		{
+			description: "pod event",
+			event: v1.Event{
+				Type:           v1.EventTypeWarning,
+				Reason:         "Unhealthy",
+				Message:        "Readiness probe failed: HTTP probe failed with statuscode: 500",
+				InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "pod", UID: "pod-uid", FieldPath: "spec.containers{web}"},
+				LastTimestamp:  metav1.NewTime(since.Add(time.Minute)),
+				Count:          3,
+			},
+			expected: []string{"[pod/pod] Unhealthy: Readiness probe failed: HTTP probe failed with statuscode: 500 (x3)\n"},
+		},
+		{
+			description: "owner event",
+			event: v1.Event{
+				Type:           v1.EventTypeWarning,
+				Reason:         "FailedCreate",
+				Message:        "exceeded quota",
+				InvolvedObject: v1.ObjectReference{Kind: "ReplicaSet", Name: "web-7d9f", UID: "rs-uid"},
+				EventTime:      metav1.NewMicroTime(since.Add(time.Minute)),
+			},
+			expected: []string{"[replicaset/web-7d9f] FailedCreate: exceeded quota\n"},
+		},
+		{
+			description: "top-level owner event",
+			event: v1.Event{
+				Type:           v1.EventTypeWarning,
+				Reason:         "FailedCreate",
+				Message:        "exceeded quota",
+				InvolvedObject: v1.ObjectReference{Kind: "Deployment", Name: "web", UID: "deployment-uid"},
+				EventTime:      metav1.NewMicroTime(since.Add(time.Minute)),
+			},
+			expected: []string{"[deployment/web] FailedCreate: exceeded quota\n"},
+		},
+		{
+			description: "normal event",
+			event: v1.Event{
+				Type:           v1.EventTypeNormal,
+				Reason:         "Pulled",
+				InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "pod", UID: "pod-uid"},
+				LastTimestamp:  metav1.NewTime(since.Add(time.Minute)),
+			},
+		},
+		{
+			description: "untracked resource",
+			event: v1.Event{
+				Type:           v1.EventTypeWarning,
+				Reason:         "BackOff",
+				InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "other", UID: "other-uid"},
+				LastTimestamp:  metav1.NewTime(since.Add(time.Minute)),
+			},
+		},
+		{
+			description: "old event",
+			event: v1.Event{
+				Type:           v1.EventTypeWarning,
+				Reason:         "BackOff",
+				InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "pod", UID: "pod-uid"},
+				LastTimestamp:  metav1.NewTime(since.Add(-time.Minute)),
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			a := &LogAggregator{sinceTime: since, lines: make(chan logLine, 10)}
+			a.trackPod(context.Background(), fakeWorkloadClient(), trackedPod())
+
+			a.handleEvent(&test.event)
+
+			t.CheckDeepEqual(test.expected, queuedLines(a))
+		})
+	}
+}
+
+func TestHandleEventBeforePod(t *testing.T) {
+	since := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	testutil.Run(t, "", func(t *testutil.T) {
+		a := &LogAggregator{sinceTime: since, lines: make(chan logLine, 10)}
+		scheduling := &v1.Event{
+			ObjectMeta:     metav1.ObjectMeta{UID: "event1"},
+			Type:           v1.EventTypeWarning,
+			Reason:         "FailedScheduling",
+			Message:        "0/1 nodes are available",
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "pod", UID: "pod-uid"},
+			LastTimestamp:  metav1.NewTime(since.Add(time.Minute)),
+		}
+		other := &v1.Event{
+			ObjectMeta:     metav1.ObjectMeta{UID: "event2"},
+			Type:           v1.EventTypeWarning,
+			Reason:         "BackOff",
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "other", UID: "other-uid"},
+			LastTimestamp:  metav1.NewTime(since.Add(time.Minute)),
+		}
+		a.handleEvent(scheduling)
+		// the event is updated before the pod is seen
+		updated := scheduling.DeepCopy()
+		updated.Count = 2
+		a.handleEvent(updated)
+		a.handleEvent(other)
+		t.CheckDeepEqual(0, len(queuedLines(a)))
+
+		a.trackPod(context.Background(), fakeWorkloadClient(), trackedPod())
+
+		t.CheckDeepEqual([]string{"[pod/pod] FailedScheduling: 0/1 nodes are available (x2)\n"}, queuedLines(a))
+		t.CheckDeepEqual([]*v1.Event{other}, a.tracked.pending)
+	})
+}
+
+func TestPendingEventsAreBounded(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		a := &LogAggregator{lines: make(chan logLine, 10)}
+		for i := 0; i < maxPendingEvents+10; i++ {
+			a.handleEvent(&v1.Event{
+				ObjectMeta:     metav1.ObjectMeta{UID: types.UID(fmt.Sprintf("event%d", i))},
+				Type:           v1.EventTypeWarning,
+				InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "other", UID: "other-uid"},
+				LastTimestamp:  metav1.Now(),
+			})
+		}
+
+		t.CheckDeepEqual(maxPendingEvents, len(a.tracked.pending))
+		t.CheckDeepEqual(types.UID("event10"), a.tracked.pending[0].UID)
+	})
+}
+
+// trackedPod is a pod owned by the `web` deployment of fakeWorkloadClient.
+func trackedPod() *v1.Pod {
+	return &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:            "pod",
+		Namespace:       "default",
+		UID:             "pod-uid",
+		OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "web-7d9f", UID: "rs-uid"}},
+	}}
+}
+
+// fakeWorkloadClient knows about the `web` deployment and its replica set.
+func fakeWorkloadClient() *fakekubeclientset.Clientset {
+	return fakekubeclientset.NewSimpleClientset(
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", UID: "deployment-uid"}},
+		&appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+			Name:            "web-7d9f",
+			Namespace:       "default",
+			UID:             "rs-uid",
+			OwnerReferences: []metav1.OwnerReference{{Kind: "Deployment", Name: "web", UID: "deployment-uid"}},
+		}},
+	)
+}
+
+func TestFollowEvents(t *testing.T) {
+	tests := []struct {
+		description              string
+		closeWith                *watch.Event
+		expectedResourceVersions []string
+	}{
+		{
+			description:              "watch again from the last seen resourceVersion",
+			expectedResourceVersions: []string{"", "5"},
+		},
+		{
+			description:              "watch again from the current state when the resourceVersion is too old",
+			closeWith:                &watch.Event{Type: watch.Error, Object: &apierrors.NewGone("too old").ErrStatus},
+			expectedResourceVersions: []string{"", ""},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&eventWatchBackoff, time.Millisecond)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var resourceVersions []string
+			watchers := make(chan *watch.FakeWatcher, 2)
+			client := fakekubeclientset.NewSimpleClientset()
+			client.PrependWatchReactor("events", func(action k8stesting.Action) (bool, watch.Interface, error) {
+				resourceVersions = append(resourceVersions, action.(k8stesting.WatchActionImpl).WatchRestrictions.ResourceVersion)
+				w := watch.NewFakeWithChanSize(2, false)
+				watchers <- w
+				if len(resourceVersions) == 2 {
+					cancel()
+				}
+				return true, w, nil
+			})
+
+			logger := &LogAggregator{namespaces: []string{"ns"}}
+			logger.watchEvents(ctx, client)
+
+			w := <-watchers
+			w.Add(&v1.Event{ObjectMeta: metav1.ObjectMeta{Name: "event", ResourceVersion: "5"}})
+			if test.closeWith != nil {
+				w.Action(test.closeWith.Type, test.closeWith.Object)
+			} else {
+				w.Stop()
+			}
+
+			select {
+			case <-watchers:
+			case <-time.After(5 * time.Second):
+				t.Fatal("the events weren't watched again")
+			}
+			t.CheckDeepEqual(test.expectedResourceVersions, resourceVersions)
+		})
+	}
+}
+
+func TestContainerName(t *testing.T) {
+	testutil.CheckDeepEqual(t, "web", containerName("spec.containers{web}"))
+	testutil.CheckDeepEqual(t, "", containerName(""))
+}
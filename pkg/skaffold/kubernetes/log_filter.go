@@ -0,0 +1,238 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// logLevels are the known log levels, from the least to the most severe.
+var logLevels = map[string]int{
+	"trace":    0,
+	"debug":    1,
+	"info":     2,
+	"warn":     3,
+	"warning":  3,
+	"error":    4,
+	"fatal":    5,
+	"critical": 5,
+	"panic":    5,
+}
+
+// levelFields are the fields of JSON structured logs that hold the log level.
+var levelFields = []string{"level", "severity", "lvl"}
+
+// messageFields are the fields of JSON structured logs that hold the message.
+var messageFields = []string{"msg", "message"}
+
+// logFormatter filters and formats the log lines of a container.
+type logFormatter struct {
+	include  []*regexp.Regexp
+	exclude  []*regexp.Regexp
+	minLevel int
+	hasLevel bool
+	json     *latest.JSONParseConfig
+}
+
+// mergeLogsConfig overrides the `deploy.logs` configuration with the options set on the command line.
+func mergeLogsConfig(c latest.LogsConfig, opts config.LogsOptions) latest.LogsConfig {
+	filter := latest.LogsFilter{}
+	if c.Filter != nil {
+		filter = *c.Filter
+	}
+	if len(opts.Images) > 0 {
+		filter.Images = opts.Images
+	}
+	if len(opts.Containers) > 0 {
+		filter.Containers = opts.Containers
+	}
+	if len(opts.Include) > 0 {
+		filter.Include = opts.Include
+	}
+	if len(opts.Exclude) > 0 {
+		filter.Exclude = opts.Exclude
+	}
+	if opts.Level != "" {
+		filter.Level = opts.Level
+	}
+	c.Filter = &filter
+	if opts.Dir != "" {
+		c.Dir = opts.Dir
+	}
+	return c
+}
+
+// selectsContainer checks if the logs of a container are shown.
+func selectsContainer(filter *latest.LogsFilter, pod *v1.Pod, container v1.ContainerStatus) bool {
+	if filter == nil {
+		return true
+	}
+
+	image := container.Image
+	for _, c := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+		if c.Name == container.Name {
+			image = c.Image
+			break
+		}
+	}
+
+	return matchesAny(filter.Images, stripTag(image)) && matchesAny(filter.Containers, container.Name)
+}
+
+// matchesAny checks if `value` matches one of the patterns. An empty list of patterns matches everything.
+func matchesAny(patterns []string, value string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, p := range patterns {
+		if util.RegexEqual(p, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func newLogFormatter(c latest.LogsConfig) (*logFormatter, error) {
+	f := &logFormatter{json: c.JSONParse}
+	if c.Filter == nil {
+		return f, nil
+	}
+
+	var err error
+	if f.include, err = compileAll(c.Filter.Include); err != nil {
+		return nil, err
+	}
+	if f.exclude, err = compileAll(c.Filter.Exclude); err != nil {
+		return nil, err
+	}
+	if c.Filter.Level != "" {
+		level, found := logLevels[strings.ToLower(c.Filter.Level)]
+		if !found {
+			return nil, fmt.Errorf("invalid log level %q: must be one of trace, debug, info, warn, error or fatal", c.Filter.Level)
+		}
+		f.minLevel, f.hasLevel = level, true
+	}
+	return f, nil
+}
+
+func compileAll(expressions []string) ([]*regexp.Regexp, error) {
+	var compiled []*regexp.Regexp
+	for _, e := range expressions {
+		re, err := regexp.Compile(e)
+		if err != nil {
+			return nil, fmt.Errorf("invalid log filter %q: %w", e, err)
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled, nil
+}
+
+// format returns the text to print for a log line, and false if the line is filtered out.
+func (f *logFormatter) format(line string) (string, bool) {
+	if len(f.include) > 0 && !matchesAnyRegexp(f.include, line) {
+		return "", false
+	}
+	if matchesAnyRegexp(f.exclude, line) {
+		return "", false
+	}
+	if !f.hasLevel && f.json == nil {
+		return line, true
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(strings.TrimSpace(line)), &fields); err != nil {
+		// not a structured log line
+		return line, true
+	}
+	if f.hasLevel {
+		if level, found := logLevel(fields); found && level < f.minLevel {
+			return "", false
+		}
+	}
+	if f.json == nil {
+		return line, true
+	}
+	return formatJSON(fields, f.json.Fields) + "\n", true
+}
+
+func matchesAnyRegexp(expressions []*regexp.Regexp, line string) bool {
+	for _, re := range expressions {
+		if re.MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
+
+func logLevel(fields map[string]interface{}) (int, bool) {
+	for _, name := range levelFields {
+		if value, found := fields[name].(string); found {
+			level, known := logLevels[strings.ToLower(value)]
+			return level, known
+		}
+	}
+	return 0, false
+}
+
+// formatJSON prints the given fields of a JSON log line, or all the fields with the message first if none are given.
+func formatJSON(fields map[string]interface{}, names []string) string {
+	if len(names) == 0 {
+		for _, name := range messageFields {
+			if _, found := fields[name]; found {
+				names = append(names, name)
+			}
+		}
+		var others []string
+		for name := range fields {
+			if !util.StrSliceContains(messageFields, name) {
+				others = append(others, name)
+			}
+		}
+		sort.Strings(others)
+		names = append(names, others...)
+	}
+
+	var parts []string
+	for _, name := range names {
+		value, found := fields[name]
+		if !found {
+			continue
+		}
+		text := fmt.Sprint(value)
+		if _, isString := value.(string); !isString {
+			if buf, err := json.Marshal(value); err == nil {
+				text = string(buf)
+			}
+		}
+		if util.StrSliceContains(messageFields, name) {
+			parts = append(parts, text)
+		} else {
+			parts = append(parts, name+"="+text)
+		}
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestLogFormatter(t *testing.T) {
+	tests := []struct {
+		description string
+		logs        latest.LogsConfig
+		line        string
+		expected    string
+		shown       bool
+	}{
+		{
+			description: "no filter",
+			line:        "hello\n",
+			expected:    "hello\n",
+			shown:       true,
+		},
+		{
+			description: "included",
+			logs:        latest.LogsConfig{Filter: &latest.LogsFilter{Include: []string{"^GET", "^POST"}}},
+			line:        "POST /api\n",
+			expected:    "POST /api\n",
+			shown:       true,
+		},
+		{
+			description: "not included",
+			logs:        latest.LogsConfig{Filter: &latest.LogsFilter{Include: []string{"^GET", "^POST"}}},
+			line:        "starting server\n",
+		},
+		{
+			description: "excluded",
+			logs:        latest.LogsConfig{Filter: &latest.LogsFilter{Exclude: []string{"healthz"}}},
+			line:        "GET /healthz\n",
+		},
+		{
+			description: "level above minimum",
+			logs:        latest.LogsConfig{Filter: &latest.LogsFilter{Level: "warn"}},
+			line:        `{"level":"ERROR","msg":"failed"}` + "\n",
+			expected:    `{"level":"ERROR","msg":"failed"}` + "\n",
+			shown:       true,
+		},
+		{
+			description: "level below minimum",
+			logs:        latest.LogsConfig{Filter: &latest.LogsFilter{Level: "warn"}},
+			line:        `{"severity":"info","msg":"started"}` + "\n",
+		},
+		{
+			description: "line without level",
+			logs:        latest.LogsConfig{Filter: &latest.LogsFilter{Level: "warn"}},
+			line:        "plain text\n",
+			expected:    "plain text\n",
+			shown:       true,
+		},
+		{
+			description: "json with all fields",
+			logs:        latest.LogsConfig{JSONParse: &latest.JSONParseConfig{}},
+			line:        `{"level":"info","msg":"request served","status":200,"path":"/"}` + "\n",
+			expected:    "request served level=info path=/ status=200\n",
+			shown:       true,
+		},
+		{
+			description: "json with selected fields",
+			logs:        latest.LogsConfig{JSONParse: &latest.JSONParseConfig{Fields: []string{"level", "message", "error"}}},
+			line:        `{"level":"error","message":"failed","error":{"code":3},"time":"now"}` + "\n",
+			expected:    `level=error failed error={"code":3}` + "\n",
+			shown:       true,
+		},
+		{
+			description: "not json",
+			logs:        latest.LogsConfig{JSONParse: &latest.JSONParseConfig{}},
+			line:        "plain text\n",
+			expected:    "plain text\n",
+			shown:       true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			f, err := newLogFormatter(test.logs)
+			t.RequireNoError(err)
+
+			text, shown := f.format(test.line)
+
+			t.CheckDeepEqual(test.shown, shown)
+			t.CheckDeepEqual(test.expected, text)
+		})
+	}
+}
+
+func TestInvalidLogFilters(t *testing.T) {
+	_, err := newLogFormatter(latest.LogsConfig{Filter: &latest.LogsFilter{Include: []string{"("}}})
+	testutil.CheckError(t, true, err)
+
+	_, err = newLogFormatter(latest.LogsConfig{Filter: &latest.LogsFilter{Level: "verbose"}})
+	testutil.CheckError(t, true, err)
+}
+
+func TestSelectsContainer(t *testing.T) {
+	pod := &v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{
+		{Name: "web", Image: "gcr.io/project/leeroy-web:v1"},
+		{Name: "istio-proxy", Image: "docker.io/istio/proxyv2:1.9"},
+	}}}
+
+	tests := []struct {
+		description string
+		filter      *latest.LogsFilter
+		container   string
+		expected    bool
+	}{
+		{"no filter", nil, "istio-proxy", true},
+		{"image", &latest.LogsFilter{Images: []string{"leeroy-.*"}}, "web", true},
+		{"other image", &latest.LogsFilter{Images: []string{"leeroy-.*"}}, "istio-proxy", false},
+		{"negated container", &latest.LogsFilter{Containers: []string{"!istio-proxy"}}, "istio-proxy", false},
+		{"container", &latest.LogsFilter{Containers: []string{"!istio-proxy"}}, "web", true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			selected := selectsContainer(test.filter, pod, v1.ContainerStatus{Name: test.container})
+
+			t.CheckDeepEqual(test.expected, selected)
+		})
+	}
+}
+
+func TestMergeLogsConfig(t *testing.T) {
+	c := latest.LogsConfig{
+		Prefix: "container",
+		Filter: &latest.LogsFilter{Images: []string{"web"}, Exclude: []string{"healthz"}},
+		Dir:    "logs",
+	}
+
+	merged := mergeLogsConfig(c, config.LogsOptions{Exclude: []string{"metrics"}, Level: "info", Dir: "out"})
+
+	testutil.CheckDeepEqual(t, latest.LogsConfig{
+		Prefix: "container",
+		Filter: &latest.LogsFilter{Images: []string{"web"}, Exclude: []string{"metrics"}, Level: "info"},
+		Dir:    "out",
+	}, merged)
+	// the original config isn't modified
+	testutil.CheckDeepEqual(t, []string{"healthz"}, c.Filter.Exclude)
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// maxLogStreamRetries is the number of consecutive failed attempts to reconnect to a log stream before giving up.
+	maxLogStreamRetries = 5
+
+	// logStreamBackoff is the delay before reconnecting to a log stream, multiplied by the number of consecutive attempts.
+	logStreamBackoff = time.Second
+)
+
+// containerLogStream follows the logs of a container through the Kubernetes API.
+// When the connection drops while the container is still running, it reconnects
+// and resumes after the last line that was received.
+type containerLogStream struct {
+	name string
+
+	// open opens the log stream, starting at `since` if it isn't nil.
+	open func(ctx context.Context, since *metav1.Time) (io.ReadCloser, error)
+	// running checks if the container still runs.
+	running func(ctx context.Context) (bool, error)
+
+	backoff    time.Duration
+	maxRetries int
+}
+
+func newContainerLogStream(client kubernetes.Interface, pod *v1.Pod, container v1.ContainerStatus, sinceSeconds int64) *containerLogStream {
+	pods := client.CoreV1().Pods(pod.Namespace)
+
+	return &containerLogStream{
+		name: fmt.Sprintf("pod: %s container: %s", pod.Name, container.Name),
+		open: func(ctx context.Context, since *metav1.Time) (io.ReadCloser, error) {
+			opts := &v1.PodLogOptions{
+				Container:  container.Name,
+				Follow:     true,
+				Timestamps: true,
+			}
+			if since != nil {
+				opts.SinceTime = since
+			} else {
+				opts.SinceSeconds = &sinceSeconds
+			}
+			return pods.GetLogs(pod.Name, opts).Stream(ctx)
+		},
+		running: func(ctx context.Context) (bool, error) {
+			current, err := pods.Get(ctx, pod.Name, metav1.GetOptions{})
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			if err != nil {
+				return false, err
+			}
+			for _, c := range allContainerStatuses(current) {
+				if c.Name == container.Name {
+					return c.ContainerID == container.ContainerID && c.State.Running != nil, nil
+				}
+			}
+			return false, nil
+		},
+		backoff:    logStreamBackoff,
+		maxRetries: maxLogStreamRetries,
+	}
+}
+
+// follow calls `handle` with every log line until the container terminates or the context is cancelled.
+func (s *containerLogStream) follow(ctx context.Context, handle func(line string)) error {
+	var last, resumeAfter time.Time
+	var since *metav1.Time
+	retries := 0
+
+	for {
+		rc, err := s.open(ctx, since)
+		if err == nil {
+			var received int
+			received, err = s.read(rc, resumeAfter, &last, handle)
+			rc.Close()
+			if received > 0 {
+				retries = 0
+			}
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if err == nil {
+			// The stream ended: either the container terminated or the connection was dropped.
+			running, checkErr := s.running(ctx)
+			if checkErr == nil && !running {
+				return nil
+			}
+			err = checkErr
+		}
+
+		retries++
+		if retries > s.maxRetries {
+			if err != nil {
+				return fmt.Errorf("streaming logs from %s: %w", s.name, err)
+			}
+			return fmt.Errorf("streaming logs from %s: giving up after %d attempts to reconnect", s.name, s.maxRetries)
+		}
+		logrus.Debugf("Reconnecting to the logs of %s (attempt %d): %v", s.name, retries, err)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(s.backoff * time.Duration(retries)):
+		}
+
+		if !last.IsZero() {
+			// The API only supports a one second precision so lines that were already received are skipped.
+			since = &metav1.Time{Time: last}
+			resumeAfter = last
+		}
+	}
+}
+
+// read reads timestamped log lines, skipping those that aren't strictly after `resumeAfter`.
+// It records the timestamp of the last line in `last` and returns the number of lines passed to `handle`.
+func (s *containerLogStream) read(rc io.Reader, resumeAfter time.Time, last *time.Time, handle func(string)) (int, error) {
+	r := bufio.NewReader(rc)
+	received := 0
+	for {
+		line, err := r.ReadString('\n')
+		if line != "" {
+			timestamp, text := splitTimestamp(line)
+			if timestamp.IsZero() || timestamp.After(resumeAfter) {
+				resumeAfter = time.Time{}
+				if !timestamp.IsZero() {
+					*last = timestamp
+				}
+				handle(text)
+				received++
+			}
+		}
+		if err == io.EOF {
+			return received, nil
+		}
+		if err != nil {
+			return received, fmt.Errorf("reading bytes from log stream: %w", err)
+		}
+	}
+}
+
+// splitTimestamp splits the RFC3339 timestamp the API adds in front of a log line.
+func splitTimestamp(line string) (time.Time, string) {
+	parts := strings.SplitN(line, " ", 2)
+	if len(parts) != 2 {
+		return time.Time{}, line
+	}
+	timestamp, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return time.Time{}, line
+	}
+	return timestamp, parts[1]
+}
+
+func allContainerStatuses(pod *v1.Pod) []v1.ContainerStatus {
+	var statuses []v1.ContainerStatus
+	statuses = append(statuses, pod.Status.InitContainerStatuses...)
+	statuses = append(statuses, pod.Status.ContainerStatuses...)
+	return append(statuses, pod.Status.EphemeralContainerStatuses...)
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestContainerLogStreamFollow(t *testing.T) {
+	tests := []struct {
+		description string
+		streams     []string
+		openErrs    []error
+		running     []bool
+		expected    []string
+		expectedErr bool
+		since       []*metav1.Time
+	}{
+		{
+			description: "container terminates",
+			streams:     []string{"2021-03-01T10:00:00.1Z first\n2021-03-01T10:00:00.2Z second\n"},
+			running:     []bool{false},
+			expected:    []string{"first\n", "second\n"},
+			since:       []*metav1.Time{nil},
+		},
+		{
+			description: "resume after disconnect",
+			streams: []string{
+				"2021-03-01T10:00:00.1Z first\n2021-03-01T10:00:00.2Z second\n",
+				"2021-03-01T10:00:00.1Z first\n2021-03-01T10:00:00.2Z second\n2021-03-01T10:00:01.5Z third\n",
+			},
+			running:  []bool{true, false},
+			expected: []string{"first\n", "second\n", "third\n"},
+			since:    []*metav1.Time{nil, {Time: time.Date(2021, 3, 1, 10, 0, 0, 200000000, time.UTC)}},
+		},
+		{
+			description: "retry when opening the stream fails",
+			streams:     []string{"", "2021-03-01T10:00:00.1Z first\n"},
+			openErrs:    []error{errors.New("connection refused"), nil},
+			running:     []bool{false},
+			expected:    []string{"first\n"},
+			since:       []*metav1.Time{nil, nil},
+		},
+		{
+			description: "lines without timestamp",
+			streams:     []string{"no timestamp\nlast line without newline"},
+			running:     []bool{false},
+			expected:    []string{"no timestamp\n", "last line without newline"},
+			since:       []*metav1.Time{nil},
+		},
+		{
+			description: "give up",
+			streams:     []string{"", "", ""},
+			running:     []bool{true, true, true},
+			expectedErr: true,
+			since:       []*metav1.Time{nil, nil, nil},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var since []*metav1.Time
+			opened := 0
+			checked := 0
+			stream := &containerLogStream{
+				name: "pod: pod container: container",
+				open: func(_ context.Context, s *metav1.Time) (io.ReadCloser, error) {
+					since = append(since, s)
+					i := opened
+					opened++
+					if i < len(test.openErrs) && test.openErrs[i] != nil {
+						return nil, test.openErrs[i]
+					}
+					return ioutil.NopCloser(strings.NewReader(test.streams[i])), nil
+				},
+				running: func(context.Context) (bool, error) {
+					i := checked
+					checked++
+					return test.running[i], nil
+				},
+				maxRetries: 2,
+			}
+
+			var lines []string
+			err := stream.follow(context.Background(), func(line string) { lines = append(lines, line) })
+
+			t.CheckError(test.expectedErr, err)
+			t.CheckDeepEqual(test.expected, lines)
+			t.CheckDeepEqual(test.since, since)
+		})
+	}
+}
+
+func TestEnqueueLogLine(t *testing.T) {
+	testutil.Run(t, "streams are paused when the output is too slow", func(t *testutil.T) {
+		var buf strings.Builder
+		logger := &LogAggregator{
+			output:  &buf,
+			lines:   make(chan logLine, 1),
+			stop:    make(chan struct{}),
+			printed: make(chan struct{}),
+		}
+
+		enqueued := make(chan struct{})
+		go func() {
+			logger.enqueueLogLine(color.Default, "[pod]", "first\n")
+			logger.enqueueLogLine(color.Default, "[pod]", "second\n")
+			close(enqueued)
+		}()
+
+		select {
+		case <-enqueued:
+			t.Fatal("the second line should wait for the first one to be printed")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		go logger.printLogLines(context.Background())
+		<-enqueued
+		logger.enqueueLogLine(color.Default, "[pod]", "third\n")
+		close(logger.stop)
+		<-logger.printed
+
+		t.CheckDeepEqual("[pod] first\n[pod] second\n[pod] third\n", buf.String())
+	})
+
+	testutil.Run(t, "queued lines are printed when the context is cancelled", func(t *testutil.T) {
+		var buf strings.Builder
+		logger := &LogAggregator{
+			output:  &buf,
+			lines:   make(chan logLine, 10),
+			printed: make(chan struct{}),
+		}
+
+		logger.enqueueLogLine(color.Default, "[pod]", "first\n")
+		logger.enqueueLogLine(color.Default, "[pod]", "second\n")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		logger.printLogLines(ctx)
+
+		t.CheckDeepEqual("[pod] first\n[pod] second\n", buf.String())
+	})
+}
@@ -28,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
@@ -187,9 +188,9 @@ func TestPrefix(t *testing.T) {
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
-			logger := NewLogAggregator(nil, nil, nil, nil, nil, &mockConfig{log: latest.LogsConfig{
+			logger := NewLogAggregator(nil, nil, nil, nil, "", &mockConfig{log: latest.LogsConfig{
 				Prefix: test.prefix,
-			}})
+			}}, "")
 
 			p := logger.prefix(&test.pod, test.container)
 
@@ -213,7 +214,8 @@ func containerWithName(n string) v1.ContainerStatus {
 }
 
 type mockConfig struct {
-	log latest.LogsConfig
+	log  latest.LogsConfig
+	opts config.LogsOptions
 }
 
 func (c *mockConfig) PipelineForImage(string) (latest.Pipeline, bool) {
@@ -227,3 +229,7 @@ func (c *mockConfig) DefaultPipeline() latest.Pipeline {
 	pipeline.Deploy.Logs = c.log
 	return pipeline
 }
+
+func (c *mockConfig) Logs() config.LogsOptions {
+	return c.opts
+}
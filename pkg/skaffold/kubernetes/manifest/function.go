@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// resourceListAPIVersion is the version of the `ResourceList` exchanged with KRM functions.
+const resourceListAPIVersion = "config.kubernetes.io/v1alpha1"
+
+// newFunction creates a transform that runs a KRM function, either from an executable or from a local image.
+func newFunction(cfg latest.KRMFunction) (resourcesTransform, error) {
+	if cfg.Image == "" && cfg.Exec == "" {
+		return nil, errors.New("the function must have an image or an exec")
+	}
+	functionConfig, err := toObject(cfg.Config.Values)
+	if err != nil {
+		return nil, fmt.Errorf("reading function config: %w", err)
+	}
+
+	return func(resources []map[string]interface{}) ([]map[string]interface{}, error) {
+		input := map[string]interface{}{
+			"apiVersion": resourceListAPIVersion,
+			"kind":       "ResourceList",
+			"items":      resources,
+		}
+		if len(functionConfig) > 0 {
+			input["functionConfig"] = functionConfig
+		}
+		buf, err := yaml.Marshal(input)
+		if err != nil {
+			return nil, fmt.Errorf("marshalling yaml: %w", err)
+		}
+
+		var cmd *exec.Cmd
+		if cfg.Image != "" {
+			if err := util.RunCmd(exec.Command("docker", "image", "inspect", "--format", "{{.Id}}", cfg.Image)); err != nil {
+				return nil, fmt.Errorf("function image %q isn't available locally, build or pull it first", cfg.Image)
+			}
+			cmd = exec.Command("docker", "run", "--rm", "-i", "--network", "none", cfg.Image)
+		} else {
+			cmd = exec.Command(cfg.Exec)
+		}
+		cmd.Stdin = bytes.NewReader(buf)
+
+		out, err := util.RunCmdOut(cmd)
+		if err != nil {
+			return nil, fmt.Errorf("running function %q: %w", functionName(cfg), err)
+		}
+
+		var output struct {
+			Kind  string                   `yaml:"kind"`
+			Items []map[string]interface{} `yaml:"items"`
+		}
+		if err := yaml.Unmarshal(out, &output); err != nil {
+			return nil, fmt.Errorf("reading the output of function %q: %w", functionName(cfg), err)
+		}
+		if output.Kind != "ResourceList" {
+			return nil, fmt.Errorf("function %q should output a ResourceList", functionName(cfg))
+		}
+		return output.Items, nil
+	}, nil
+}
+
+func functionName(cfg latest.KRMFunction) string {
+	if cfg.Image != "" {
+		return cfg.Image
+	}
+	return cfg.Exec
+}
@@ -0,0 +1,357 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+const (
+	// HydratedIndexFile is the file, at the root of a hydrated directory, that lists the files written by Skaffold.
+	HydratedIndexFile = "skaffold-render.json"
+	// KustomizationFile is the kustomization written at the root of a hydrated directory when image overrides are requested.
+	KustomizationFile = "kustomization.yaml"
+
+	clusterScopeDir = "_cluster"
+	unknownKindDir  = "_unknown"
+)
+
+// HydratedModule holds the manifests rendered for a config module.
+type HydratedModule struct {
+	Name      string
+	Manifests ManifestList
+	// ResourceSelector selects the custom resources whose images are restored, if any.
+	ResourceSelector *ResourceSelector
+}
+
+// HydratedFile describes a resource file written in a hydrated directory.
+type HydratedFile struct {
+	Path      string `json:"path"`
+	Module    string `json:"module,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	SHA256    string `json:"sha256"`
+}
+
+// HydratedIndex lists what was written in a hydrated directory. Paths are relative to the directory,
+// slash separated and sorted.
+type HydratedIndex struct {
+	Files         []HydratedFile `json:"files"`
+	Kustomization string         `json:"kustomization,omitempty"`
+}
+
+// HydrateOptions configures how hydrated manifests are written.
+type HydrateOptions struct {
+	// Kustomization leaves the images built by Skaffold untagged in the resources and writes
+	// a `kustomization.yaml` that overrides them with the built tags instead.
+	Kustomization bool
+	// Builds are the built images that were used to render the manifests.
+	Builds []build.Artifact
+}
+
+type kustomization struct {
+	APIVersion string           `yaml:"apiVersion"`
+	Kind       string           `yaml:"kind"`
+	Resources  []string         `yaml:"resources"`
+	Images     []kustomizeImage `yaml:"images,omitempty"`
+}
+
+type kustomizeImage struct {
+	Name    string `yaml:"name"`
+	NewName string `yaml:"newName,omitempty"`
+	NewTag  string `yaml:"newTag,omitempty"`
+	Digest  string `yaml:"digest,omitempty"`
+}
+
+// WriteHydrated writes each resource to its own file in dir, laid out as `<module>/<namespace>/<kind>/<name>.yaml`.
+// Resources without a namespace go to `_cluster`. Files listed in the index of a previous run that are no
+// longer rendered are removed, so that the directory only ever contains the current rendering.
+func WriteHydrated(dir string, modules []HydratedModule, opts HydrateOptions) (*HydratedIndex, error) {
+	previous, err := readHydratedIndex(dir)
+	if err != nil {
+		return nil, writeErr(err)
+	}
+
+	var restorer *imageRestorer
+	if opts.Kustomization {
+		restorer = newImageRestorer(opts.Builds)
+	}
+
+	index := &HydratedIndex{}
+	contents := map[string][]byte{}
+	for _, module := range modules {
+		manifests := module.Manifests
+		if restorer != nil {
+			if manifests, err = manifests.Visit(restorer, module.ResourceSelector); err != nil {
+				return nil, replaceImageErr(err)
+			}
+		}
+
+		for _, manifest := range manifests {
+			file, content, ok, err := hydratedFile(module.Name, manifest)
+			if err != nil {
+				return nil, writeErr(err)
+			}
+			if !ok {
+				continue
+			}
+
+			file.Path = uniquePath(contents, file.Path)
+			contents[file.Path] = content
+			index.Files = append(index.Files, file)
+		}
+	}
+	sort.Slice(index.Files, func(i, j int) bool { return index.Files[i].Path < index.Files[j].Path })
+
+	if restorer != nil {
+		k, err := restorer.kustomization(index.Files)
+		if err != nil {
+			return nil, writeErr(err)
+		}
+		index.Kustomization = KustomizationFile
+		contents[KustomizationFile] = k
+	}
+
+	for p, content := range contents {
+		if err := writeHydratedFile(dir, p, content); err != nil {
+			return nil, writeErr(err)
+		}
+	}
+
+	if previous != nil {
+		for _, p := range previous.paths() {
+			if _, found := contents[p]; !found {
+				removeHydratedFile(dir, p)
+			}
+		}
+	}
+
+	buf, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return nil, writeErr(fmt.Errorf("marshalling index: %w", err))
+	}
+	if err := writeHydratedFile(dir, HydratedIndexFile, append(buf, '\n')); err != nil {
+		return nil, writeErr(err)
+	}
+
+	return index, nil
+}
+
+func hydratedFile(module string, manifest []byte) (HydratedFile, []byte, bool, error) {
+	var m struct {
+		Kind     string `yaml:"kind"`
+		Metadata struct {
+			Name         string `yaml:"name"`
+			GenerateName string `yaml:"generateName"`
+			Namespace    string `yaml:"namespace"`
+		} `yaml:"metadata"`
+	}
+	if err := yaml.Unmarshal(manifest, &m); err != nil {
+		return HydratedFile{}, nil, false, fmt.Errorf("reading Kubernetes YAML: %w", err)
+	}
+
+	content := bytes.TrimSpace(manifest)
+	if len(content) == 0 {
+		return HydratedFile{}, nil, false, nil
+	}
+	content = append(content, '\n')
+
+	name := m.Metadata.Name
+	if name == "" {
+		name = m.Metadata.GenerateName
+	}
+	if name == "" {
+		name = "resource"
+	}
+
+	kindDir := strings.ToLower(m.Kind)
+	if kindDir == "" {
+		kindDir = unknownKindDir
+	}
+	namespaceDir := m.Metadata.Namespace
+	if namespaceDir == "" {
+		namespaceDir = clusterScopeDir
+	}
+
+	sum := sha256.Sum256(content)
+	return HydratedFile{
+		Path:      path.Join(pathSegment(module), pathSegment(namespaceDir), pathSegment(kindDir), pathSegment(name)+".yaml"),
+		Module:    module,
+		Namespace: m.Metadata.Namespace,
+		Kind:      m.Kind,
+		Name:      m.Metadata.Name,
+		SHA256:    hex.EncodeToString(sum[:]),
+	}, content, true, nil
+}
+
+// pathSegment makes sure a name can't escape its directory.
+func pathSegment(s string) string {
+	s = strings.NewReplacer("/", "_", "\\", "_").Replace(s)
+	if s == "." || s == ".." {
+		return "_"
+	}
+	return s
+}
+
+// uniquePath disambiguates resources that map to the same file, like resources of the same kind
+// and name from different API groups.
+func uniquePath(taken map[string][]byte, p string) string {
+	if _, found := taken[p]; !found {
+		return p
+	}
+	base := strings.TrimSuffix(p, ".yaml")
+	for i := 2; ; i++ {
+		candidate := fmt.Sprintf("%s-%d.yaml", base, i)
+		if _, found := taken[candidate]; !found {
+			return candidate
+		}
+	}
+}
+
+func writeHydratedFile(dir, p string, content []byte) error {
+	file := filepath.Join(dir, filepath.FromSlash(p))
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return fmt.Errorf("creating directory for %q: %w", p, err)
+	}
+	if err := ioutil.WriteFile(file, content, 0644); err != nil {
+		return fmt.Errorf("writing %q: %w", p, err)
+	}
+	return nil
+}
+
+// removeHydratedFile removes a stale file and the directories left empty by its removal.
+func removeHydratedFile(dir, p string) {
+	file := filepath.Join(dir, filepath.FromSlash(p))
+	if err := os.Remove(file); err != nil {
+		return
+	}
+	for parent := filepath.Dir(file); parent != filepath.Clean(dir); parent = filepath.Dir(parent) {
+		if os.Remove(parent) != nil {
+			return
+		}
+	}
+}
+
+func readHydratedIndex(dir string) (*HydratedIndex, error) {
+	buf, err := ioutil.ReadFile(filepath.Join(dir, HydratedIndexFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %q: %w", HydratedIndexFile, err)
+	}
+
+	var index HydratedIndex
+	if err := json.Unmarshal(buf, &index); err != nil {
+		return nil, fmt.Errorf("parsing %q: %w", HydratedIndexFile, err)
+	}
+	return &index, nil
+}
+
+func (i *HydratedIndex) paths() []string {
+	var paths []string
+	for _, f := range i.Files {
+		if f.Path != "" && !strings.HasPrefix(path.Clean(f.Path), "..") && !path.IsAbs(f.Path) {
+			paths = append(paths, f.Path)
+		}
+	}
+	if i.Kustomization != "" {
+		paths = append(paths, i.Kustomization)
+	}
+	return paths
+}
+
+// imageRestorer replaces the references of built images with the name of their artifact.
+type imageRestorer struct {
+	buildsByTag map[string]build.Artifact
+	found       map[string]build.Artifact
+}
+
+func newImageRestorer(builds []build.Artifact) *imageRestorer {
+	buildsByTag := map[string]build.Artifact{}
+	for _, b := range builds {
+		buildsByTag[b.Tag] = b
+	}
+
+	return &imageRestorer{
+		buildsByTag: buildsByTag,
+		found:       map[string]build.Artifact{},
+	}
+}
+
+func (r *imageRestorer) Visit(o map[string]interface{}, k string, v interface{}) bool {
+	if k != "image" {
+		return true
+	}
+
+	image, ok := v.(string)
+	if !ok {
+		return true
+	}
+	if b, found := r.buildsByTag[image]; found {
+		r.found[b.ImageName] = b
+		o[k] = b.ImageName
+	}
+	return false
+}
+
+func (r *imageRestorer) kustomization(files []HydratedFile) ([]byte, error) {
+	k := kustomization{
+		APIVersion: "kustomize.config.k8s.io/v1beta1",
+		Kind:       "Kustomization",
+		Resources:  []string{},
+	}
+	for _, f := range files {
+		k.Resources = append(k.Resources, f.Path)
+	}
+
+	for imageName, b := range r.found {
+		parsed, err := docker.ParseReference(b.Tag)
+		if err != nil {
+			return nil, fmt.Errorf("parsing image reference %q: %w", b.Tag, err)
+		}
+
+		image := kustomizeImage{Name: imageName}
+		if parsed.BaseName != imageName {
+			image.NewName = parsed.BaseName
+		}
+		if parsed.Digest != "" {
+			image.Digest = parsed.Digest
+		} else {
+			image.NewTag = parsed.Tag
+		}
+		k.Images = append(k.Images, image)
+	}
+	sort.Slice(k.Images, func(i, j int) bool { return k.Images[i].Name < k.Images[j].Name })
+
+	return yaml.Marshal(k)
+}
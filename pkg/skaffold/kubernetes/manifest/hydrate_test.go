@@ -0,0 +1,176 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const (
+	hydratedDeployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+  namespace: prod
+spec:
+  template:
+    spec:
+      containers:
+      - image: gcr.io/project/app:v1@sha256:aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa
+        name: app`
+	hydratedNamespace = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: prod`
+	hydratedService = `apiVersion: v1
+kind: Service
+metadata:
+  name: app
+  namespace: prod`
+)
+
+func TestWriteHydrated(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		dir := t.NewTempDir()
+
+		index, err := WriteHydrated(dir.Root(), []HydratedModule{
+			{Name: "backend", Manifests: ManifestList{[]byte(hydratedDeployment), []byte(hydratedService)}},
+			{Manifests: ManifestList{[]byte(hydratedNamespace), []byte("\n")}},
+		}, HydrateOptions{})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{
+			"_cluster/namespace/prod.yaml",
+			"backend/prod/deployment/app.yaml",
+			"backend/prod/service/app.yaml",
+		}, filePaths(index))
+		t.CheckDeepEqual("", index.Kustomization)
+		t.CheckDeepEqual(HydratedFile{
+			Path:      "backend/prod/deployment/app.yaml",
+			Module:    "backend",
+			Namespace: "prod",
+			Kind:      "Deployment",
+			Name:      "app",
+			SHA256:    index.Files[1].SHA256,
+		}, index.Files[1])
+		checkFileContent(t, dir.Path("backend/prod/deployment/app.yaml"), []byte(hydratedDeployment+"\n"))
+		checkFileContent(t, dir.Path("_cluster/namespace/prod.yaml"), []byte(hydratedNamespace+"\n"))
+
+		written, err := readHydratedIndex(dir.Root())
+		t.CheckNoError(err)
+		t.CheckDeepEqual(index, written)
+	})
+}
+
+func TestWriteHydratedRemovesStaleFiles(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		dir := t.NewTempDir()
+
+		_, err := WriteHydrated(dir.Root(), []HydratedModule{
+			{Manifests: ManifestList{[]byte(hydratedDeployment), []byte(hydratedNamespace)}},
+		}, HydrateOptions{})
+		t.CheckNoError(err)
+
+		dir.Write("README.md", "not written by skaffold")
+		index, err := WriteHydrated(dir.Root(), []HydratedModule{
+			{Manifests: ManifestList{[]byte(hydratedNamespace)}},
+		}, HydrateOptions{})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"_cluster/namespace/prod.yaml"}, filePaths(index))
+		_, err = os.Stat(dir.Path("prod"))
+		t.CheckTrue(os.IsNotExist(err))
+		checkFileContent(t, dir.Path("README.md"), []byte("not written by skaffold"))
+	})
+}
+
+func TestWriteHydratedDuplicates(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		dir := t.NewTempDir()
+
+		index, err := WriteHydrated(dir.Root(), []HydratedModule{
+			{Manifests: ManifestList{[]byte(hydratedService), []byte(hydratedService), []byte("apiVersion: v1")}},
+		}, HydrateOptions{})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{
+			"_cluster/_unknown/resource.yaml",
+			"prod/service/app-2.yaml",
+			"prod/service/app.yaml",
+		}, filePaths(index))
+	})
+}
+
+func TestWriteHydratedKustomization(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		dir := t.NewTempDir()
+
+		index, err := WriteHydrated(dir.Root(), []HydratedModule{
+			{Manifests: ManifestList{[]byte(hydratedDeployment), []byte(hydratedService)}},
+		}, HydrateOptions{
+			Kustomization: true,
+			Builds: []build.Artifact{
+				{ImageName: "app", Tag: "gcr.io/project/app:v1@sha256:aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"},
+				{ImageName: "unused", Tag: "unused:v2"},
+			},
+		})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(KustomizationFile, index.Kustomization)
+		checkFileContent(t, dir.Path(KustomizationFile), []byte(`apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+- prod/deployment/app.yaml
+- prod/service/app.yaml
+images:
+- name: app
+  newName: gcr.io/project/app
+  digest: sha256:aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa
+`))
+		deployment, err := ioutil.ReadFile(dir.Path("prod/deployment/app.yaml"))
+		t.CheckNoError(err)
+		t.CheckContains("- image: app\n", string(deployment))
+
+		// Turning kustomization off removes the kustomization written before
+		_, err = WriteHydrated(dir.Root(), []HydratedModule{
+			{Manifests: ManifestList{[]byte(hydratedService)}},
+		}, HydrateOptions{})
+		t.CheckNoError(err)
+		_, err = os.Stat(filepath.Join(dir.Root(), KustomizationFile))
+		t.CheckTrue(os.IsNotExist(err))
+	})
+}
+
+func filePaths(index *HydratedIndex) []string {
+	var paths []string
+	for _, f := range index.Files {
+		paths = append(paths, f.Path)
+	}
+	return paths
+}
+
+func checkFileContent(t *testutil.T, path string, expected []byte) {
+	content, err := ioutil.ReadFile(path)
+	t.CheckNoError(err)
+	t.CheckDeepEqual(string(expected), string(content))
+}
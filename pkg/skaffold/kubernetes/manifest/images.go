@@ -25,9 +25,9 @@ import (
 )
 
 // GetImages gathers a map of base image names to the image with its tag
-func (l *ManifestList) GetImages() ([]build.Artifact, error) {
+func (l *ManifestList) GetImages(rs *ResourceSelector) ([]build.Artifact, error) {
 	s := &imageSaver{}
-	_, err := l.Visit(s)
+	_, err := l.Visit(s, rs)
 	return s.Images, parseImagesInManifestErr(err)
 }
 
@@ -57,11 +57,11 @@ func (is *imageSaver) Visit(o map[string]interface{}, k string, v interface{}) b
 	return false
 }
 
-// ReplaceImages replaces image names in a list of manifests.
-func (l *ManifestList) ReplaceImages(builds []build.Artifact) (ManifestList, error) {
+// ReplaceImages replaces image names in a list of manifests, including in the custom resources selected by `rs`.
+func (l *ManifestList) ReplaceImages(builds []build.Artifact, rs *ResourceSelector) (ManifestList, error) {
 	replacer := newImageReplacer(builds)
 
-	updated, err := l.Visit(replacer)
+	updated, err := l.Visit(replacer, rs)
 	if err != nil {
 		return nil, replaceImageErr(err)
 	}
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/warnings"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
@@ -54,7 +55,7 @@ spec:
 		},
 	}
 
-	actual, err := manifests.GetImages()
+	actual, err := manifests.GetImages(nil)
 	testutil.CheckErrorAndDeepEqual(t, false, err, expectedImages, actual)
 }
 
@@ -126,7 +127,7 @@ spec:
 		fakeWarner := &warnings.Collect{}
 		t.Override(&warnings.Printf, fakeWarner.Warnf)
 
-		resultManifest, err := manifests.ReplaceImages(builds)
+		resultManifest, err := manifests.ReplaceImages(builds, nil)
 
 		t.CheckNoError(err)
 		t.CheckDeepEqual(expected.String(), resultManifest.String())
@@ -140,7 +141,7 @@ func TestReplaceEmptyManifest(t *testing.T) {
 	manifests := ManifestList{[]byte(""), []byte("  ")}
 	expected := ManifestList{}
 
-	resultManifest, err := manifests.ReplaceImages(nil)
+	resultManifest, err := manifests.ReplaceImages(nil, nil)
 
 	testutil.CheckErrorAndDeepEqual(t, false, err, expected.String(), resultManifest.String())
 }
@@ -148,7 +149,7 @@ func TestReplaceEmptyManifest(t *testing.T) {
 func TestReplaceInvalidManifest(t *testing.T) {
 	manifests := ManifestList{[]byte("INVALID")}
 
-	_, err := manifests.ReplaceImages(nil)
+	_, err := manifests.ReplaceImages(nil, nil)
 
 	testutil.CheckError(t, true, err)
 }
@@ -161,7 +162,93 @@ image:
 - value2
 `)}
 
-	output, err := manifests.ReplaceImages(nil)
+	output, err := manifests.ReplaceImages(nil, nil)
 
 	testutil.CheckErrorAndDeepEqual(t, false, err, manifests.String(), output.String())
 }
+
+func TestReplaceImagesInCustomResource(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		rs, err := NewResourceSelector([]latest.ResourceFilter{{
+			GroupKind: "Task.tekton.dev",
+			Image:     []string{".spec.steps.*.image"},
+		}})
+		t.CheckNoError(err)
+
+		manifests := ManifestList{[]byte(`
+apiVersion: tekton.dev/v1beta1
+kind: Task
+metadata:
+  name: task
+spec:
+  params:
+  - image: gcr.io/k8s-skaffold/example
+  steps:
+  - image: gcr.io/k8s-skaffold/example
+    name: step
+`)}
+		expected := ManifestList{[]byte(`
+apiVersion: tekton.dev/v1beta1
+kind: Task
+metadata:
+  name: task
+spec:
+  params:
+  - image: gcr.io/k8s-skaffold/example
+  steps:
+  - image: gcr.io/k8s-skaffold/example:TAG
+    name: step
+`)}
+
+		resultManifest, err := manifests.ReplaceImages([]build.Artifact{{ImageName: "gcr.io/k8s-skaffold/example", Tag: "gcr.io/k8s-skaffold/example:TAG"}}, rs)
+
+		t.CheckErrorAndDeepEqual(false, err, expected.String(), resultManifest.String())
+	})
+}
+
+func TestReplaceImagesInCustomResourceFieldNames(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		rs, err := NewResourceSelector([]latest.ResourceFilter{{
+			GroupKind: "Pipeline.example.com",
+			Image:     []string{".spec.runner.containerImage", ".spec.images.*"},
+		}})
+		t.CheckNoError(err)
+
+		manifests := ManifestList{[]byte(`
+apiVersion: example.com/v1
+kind: Pipeline
+metadata:
+  name: pipeline
+spec:
+  images:
+  - gcr.io/k8s-skaffold/example
+  - gcr.io/k8s-skaffold/other
+  runner:
+    containerImage: gcr.io/k8s-skaffold/example
+    image: gcr.io/k8s-skaffold/example
+`)}
+		expected := ManifestList{[]byte(`
+apiVersion: example.com/v1
+kind: Pipeline
+metadata:
+  name: pipeline
+spec:
+  images:
+  - gcr.io/k8s-skaffold/example:TAG
+  - gcr.io/k8s-skaffold/other
+  runner:
+    containerImage: gcr.io/k8s-skaffold/example:TAG
+    image: gcr.io/k8s-skaffold/example
+`)}
+
+		resultManifest, err := manifests.ReplaceImages([]build.Artifact{{ImageName: "gcr.io/k8s-skaffold/example", Tag: "gcr.io/k8s-skaffold/example:TAG"}}, rs)
+		t.CheckErrorAndDeepEqual(false, err, expected.String(), resultManifest.String())
+
+		images, err := manifests.GetImages(rs)
+		t.CheckErrorAndDeepEqual(false, err, []build.Artifact{
+			{ImageName: "gcr.io/k8s-skaffold/example", Tag: "gcr.io/k8s-skaffold/example"},
+			{ImageName: "gcr.io/k8s-skaffold/example", Tag: "gcr.io/k8s-skaffold/example"},
+			{ImageName: "gcr.io/k8s-skaffold/other", Tag: "gcr.io/k8s-skaffold/other"},
+		}, images)
+	})
+}
@@ -20,14 +20,14 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-// SetLabels add labels to a list of Kubernetes manifests.
-func (l *ManifestList) SetLabels(labels map[string]string) (ManifestList, error) {
+// SetLabels add labels to a list of Kubernetes manifests, including to the custom resources selected by `rs`.
+func (l *ManifestList) SetLabels(labels map[string]string, rs *ResourceSelector) (ManifestList, error) {
 	if len(labels) == 0 {
 		return *l, nil
 	}
 
 	replacer := newLabelsSetter(labels)
-	updated, err := l.Visit(replacer)
+	updated, err := l.Visit(replacer, rs)
 	if err != nil {
 		return nil, labelSettingErr(err)
 	}
@@ -26,7 +26,7 @@ import (
 func (l *ManifestList) CollectNamespaces() ([]string, error) {
 	replacer := newNamespaceCollector()
 
-	if _, err := l.Visit(replacer); err != nil {
+	if _, err := l.Visit(replacer, nil); err != nil {
 		return nil, fmt.Errorf("collecting namespaces: %w", err)
 	}
 
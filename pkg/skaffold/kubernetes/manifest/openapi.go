@@ -0,0 +1,259 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"sigs.k8s.io/kustomize/kyaml/openapi/kubernetesapi"
+)
+
+const (
+	// bundledSchemaAsset is the OpenAPI document of Kubernetes 1.20 bundled with kyaml.
+	bundledSchemaAsset = "kubernetesapi/" + kubernetesapi.DefaultOpenAPI + "/swagger.json"
+
+	objectMetaDefinition = "io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta"
+	quantityDefinition   = "io.k8s.apimachinery.pkg.api.resource.Quantity"
+)
+
+var (
+	bundledSchemasOnce sync.Once
+	bundledSchemas     *openAPISchemas
+	bundledSchemasErr  error
+)
+
+// openAPISchema is the subset of an OpenAPI schema that resources are validated against.
+type openAPISchema struct {
+	Ref                   string                    `json:"$ref"`
+	Type                  string                    `json:"type"`
+	Format                string                    `json:"format"`
+	Properties            map[string]*openAPISchema `json:"properties"`
+	AdditionalProperties  *additionalProperties     `json:"additionalProperties"`
+	Items                 *openAPISchema            `json:"items"`
+	Required              []string                  `json:"required"`
+	PreserveUnknownFields bool                      `json:"x-kubernetes-preserve-unknown-fields"`
+	EmbeddedResource      bool                      `json:"x-kubernetes-embedded-resource"`
+	IntOrString           bool                      `json:"x-kubernetes-int-or-string"`
+	GroupVersionKinds     []groupVersionKind        `json:"x-kubernetes-group-version-kind"`
+}
+
+// additionalProperties is either a boolean or a schema.
+type additionalProperties struct {
+	Allowed bool
+	Schema  *openAPISchema
+}
+
+func (a *additionalProperties) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, &a.Allowed); err == nil {
+		return nil
+	}
+	a.Allowed = true
+	return json.Unmarshal(b, &a.Schema)
+}
+
+type groupVersionKind struct {
+	Group   string `json:"group"`
+	Version string `json:"version"`
+	Kind    string `json:"kind"`
+}
+
+func (gvk groupVersionKind) String() string {
+	if gvk.Group == "" {
+		return gvk.Version + "/" + gvk.Kind
+	}
+	return gvk.Group + "/" + gvk.Version + "/" + gvk.Kind
+}
+
+// openAPISchemas indexes the schemas of an OpenAPI document by the kind of resource they describe.
+type openAPISchemas struct {
+	version     string
+	definitions map[string]*openAPISchema
+	kinds       map[string]*openAPISchema
+}
+
+func loadBundledSchemas() (*openAPISchemas, error) {
+	bundledSchemasOnce.Do(func() {
+		bundledSchemas, bundledSchemasErr = parseOpenAPISchemas(kubernetesapi.OpenAPIMustAsset[kubernetesapi.DefaultOpenAPI](bundledSchemaAsset))
+	})
+	return bundledSchemas, bundledSchemasErr
+}
+
+func parseOpenAPISchemas(buf []byte) (*openAPISchemas, error) {
+	var doc struct {
+		Info struct {
+			Version string `json:"version"`
+		} `json:"info"`
+		Definitions map[string]*openAPISchema `json:"definitions"`
+	}
+	if err := json.Unmarshal(buf, &doc); err != nil {
+		return nil, fmt.Errorf("parsing OpenAPI document: %w", err)
+	}
+
+	schemas := &openAPISchemas{
+		version:     majorMinor(doc.Info.Version),
+		definitions: doc.Definitions,
+		kinds:       map[string]*openAPISchema{},
+	}
+	for _, s := range doc.Definitions {
+		for _, gvk := range s.GroupVersionKinds {
+			schemas.kinds[gvk.String()] = s
+		}
+	}
+	return schemas, nil
+}
+
+// majorMinor turns a version like `v1.20.4` into `1.20`.
+func majorMinor(version string) string {
+	parts := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3)
+	if len(parts) < 2 {
+		return strings.Join(parts, ".")
+	}
+	return parts[0] + "." + parts[1]
+}
+
+// schemaValidator reports the fields of an object that don't match a schema.
+type schemaValidator struct {
+	definitions map[string]*openAPISchema
+	report      func(path, message string)
+}
+
+func (v *schemaValidator) resolve(s *openAPISchema) *openAPISchema {
+	for s != nil && s.Ref != "" {
+		name := strings.TrimPrefix(s.Ref, "#/definitions/")
+		if name == quantityDefinition {
+			// Quantities are serialized as strings but can be written as numbers
+			return &openAPISchema{IntOrString: true}
+		}
+		s = v.definitions[name]
+	}
+	return s
+}
+
+func (v *schemaValidator) validate(path string, value interface{}, s *openAPISchema) {
+	s = v.resolve(s)
+	if s == nil || value == nil {
+		return
+	}
+	if s.IntOrString || s.Format == "int-or-string" {
+		switch value.(type) {
+		case string, float64:
+		default:
+			v.report(path, fmt.Sprintf("expected an integer or a string, got %s", typeName(value)))
+		}
+		return
+	}
+
+	switch s.Type {
+	case "object":
+		v.validateObject(path, value, s)
+	case "array":
+		items, ok := value.([]interface{})
+		if !ok {
+			v.report(path, fmt.Sprintf("expected an array, got %s", typeName(value)))
+			return
+		}
+		for i, item := range items {
+			v.validate(fmt.Sprintf("%s[%d]", path, i), item, s.Items)
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			v.report(path, fmt.Sprintf("expected a string, got %s", typeName(value)))
+		}
+	case "integer":
+		if n, ok := value.(float64); !ok || n != math.Trunc(n) {
+			v.report(path, fmt.Sprintf("expected an integer, got %s", typeName(value)))
+		}
+	case "number":
+		if _, ok := value.(float64); !ok {
+			v.report(path, fmt.Sprintf("expected a number, got %s", typeName(value)))
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			v.report(path, fmt.Sprintf("expected a boolean, got %s", typeName(value)))
+		}
+	case "":
+		if s.Properties != nil {
+			v.validateObject(path, value, s)
+		}
+	}
+}
+
+func (v *schemaValidator) validateObject(path string, value interface{}, s *openAPISchema) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		v.report(path, fmt.Sprintf("expected an object, got %s", typeName(value)))
+		return
+	}
+
+	for _, required := range s.Required {
+		if _, found := object[required]; !found {
+			v.report(join(path, required), "required field is missing")
+		}
+	}
+
+	for _, k := range sortedKeys(object) {
+		field := join(path, k)
+		switch {
+		case s.Properties[k] != nil:
+			v.validate(field, object[k], s.Properties[k])
+		case s.EmbeddedResource && (k == "apiVersion" || k == "kind" || k == "metadata"):
+		case s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil:
+			v.validate(field, object[k], s.AdditionalProperties.Schema)
+		case s.AdditionalProperties != nil && s.AdditionalProperties.Allowed, s.PreserveUnknownFields, len(s.Properties) == 0:
+		default:
+			v.report(field, "unknown field")
+		}
+	}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func join(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+func typeName(value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}:
+		return "an object"
+	case []interface{}:
+		return "an array"
+	case string:
+		return "a string"
+	case float64:
+		return "a number"
+	case bool:
+		return "a boolean"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// kindPriorities lists the kinds other resources depend on. They are sorted first.
+var kindPriorities = map[string]int{
+	"Namespace":                1,
+	"CustomResourceDefinition": 2,
+}
+
+type sortableResource struct {
+	manifest   []byte
+	priority   int
+	kind       string
+	namespace  string
+	name       string
+	apiVersion string
+}
+
+// SortResources sorts the manifests by kind, namespace and name so that the output doesn't depend on the order
+// in which the resources were rendered. Namespaces and custom resource definitions come first.
+func (l ManifestList) SortResources() (ManifestList, error) {
+	var resources []sortableResource
+	for _, manifest := range l {
+		var m struct {
+			APIVersion string `yaml:"apiVersion"`
+			Kind       string `yaml:"kind"`
+			Metadata   struct {
+				Name      string `yaml:"name"`
+				Namespace string `yaml:"namespace"`
+			} `yaml:"metadata"`
+		}
+		if err := yaml.Unmarshal(manifest, &m); err != nil {
+			return nil, fmt.Errorf("reading Kubernetes YAML: %w", err)
+		}
+
+		manifest = bytes.TrimSpace(manifest)
+		if len(manifest) == 0 {
+			continue
+		}
+
+		priority, found := kindPriorities[m.Kind]
+		if !found {
+			priority = len(kindPriorities) + 1
+		}
+		resources = append(resources, sortableResource{
+			manifest:   manifest,
+			priority:   priority,
+			kind:       m.Kind,
+			namespace:  m.Metadata.Namespace,
+			name:       m.Metadata.Name,
+			apiVersion: m.APIVersion,
+		})
+	}
+
+	sort.SliceStable(resources, func(i, j int) bool {
+		a, b := resources[i], resources[j]
+		switch {
+		case a.priority != b.priority:
+			return a.priority < b.priority
+		case a.kind != b.kind:
+			return a.kind < b.kind
+		case a.namespace != b.namespace:
+			return a.namespace < b.namespace
+		case a.name != b.name:
+			return a.name < b.name
+		case a.apiVersion != b.apiVersion:
+			return a.apiVersion < b.apiVersion
+		default:
+			return bytes.Compare(a.manifest, b.manifest) < 0
+		}
+	})
+
+	var sorted ManifestList
+	for _, r := range resources {
+		sorted = append(sorted, r.manifest)
+	}
+	return sorted, nil
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestSortResources(t *testing.T) {
+	service := "apiVersion: v1\nkind: Service\nmetadata:\n  name: web\n  namespace: b"
+	otherService := "apiVersion: v1\nkind: Service\nmetadata:\n  name: web\n  namespace: a"
+	deployment := "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: web"
+	namespace := "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: a"
+	crd := "apiVersion: apiextensions.k8s.io/v1\nkind: CustomResourceDefinition\nmetadata:\n  name: foos.example.com"
+
+	testutil.Run(t, "", func(t *testutil.T) {
+		sorted, err := ManifestList{
+			[]byte(service),
+			[]byte(deployment + "\n"),
+			[]byte("\n"),
+			[]byte(crd),
+			[]byte(otherService),
+			[]byte(namespace),
+		}.SortResources()
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(ManifestList{
+			[]byte(namespace),
+			[]byte(crd),
+			[]byte(deployment),
+			[]byte(otherService),
+			[]byte(service),
+		}, sorted)
+	})
+}
+
+func TestSortResourcesError(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		_, err := ManifestList{[]byte("kind: [")}.SortResources()
+
+		t.CheckError(true, err)
+	})
+}
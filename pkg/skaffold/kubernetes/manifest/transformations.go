@@ -23,6 +23,8 @@ import (
 type Registries struct {
 	InsecureRegistries   map[string]bool
 	DebugHelpersRegistry string
+	// ResourceSelector selects the custom resources to transform, if any.
+	ResourceSelector *ResourceSelector
 }
 
 type Transform func(l ManifestList, builds []build.Artifact, registries Registries) (ManifestList, error)
@@ -35,16 +37,28 @@ func AddTransform(newTransform Transform) {
 	transforms = append(transforms, newTransform)
 }
 
+// ResetTransforms removes the transforms added so far.
+func ResetTransforms() {
+	transforms = nil
+}
+
 // GetTransforms returns all manifest transforms.
 func GetTransforms() []Transform {
 	return transforms
 }
 
-// ApplyTransforms applies all manifests transforms to the provided manifests.
-func ApplyTransforms(manifests ManifestList, builds []build.Artifact, insecureRegistries map[string]bool, debugHelpersRegistry string) (ManifestList, error) {
+// ApplyTransforms applies all manifests transforms to the provided manifests,
+// followed by the transforms of the deployer's `transformer`, unless it's nil.
+// The custom resources selected by the `transformer` are transformed too.
+func ApplyTransforms(manifests ManifestList, builds []build.Artifact, transformer *Transformer, insecureRegistries map[string]bool, debugHelpersRegistry string) (ManifestList, error) {
+	all := transforms
+	if transformer != nil {
+		all = append(all[:len(all):len(all)], transformer.apply)
+	}
+
 	var err error
-	for _, transform := range transforms {
-		manifests, err = transform(manifests, builds, Registries{insecureRegistries, debugHelpersRegistry})
+	for _, transform := range all {
+		manifests, err = transform(manifests, builds, Registries{insecureRegistries, debugHelpersRegistry, transformer.ResourceSelector()})
 		if err != nil {
 			return nil, transformManifestErr(err)
 		}
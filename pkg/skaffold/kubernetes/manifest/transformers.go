@@ -0,0 +1,488 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	apimachinery "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/client-go/kubernetes/scheme"
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// clusterScopedKinds are the built-in kinds of resources that don't belong to a namespace.
+var clusterScopedKinds = map[string]bool{
+	"APIService":                     true,
+	"CSIDriver":                      true,
+	"CSINode":                        true,
+	"CertificateSigningRequest":      true,
+	"ClusterRole":                    true,
+	"ClusterRoleBinding":             true,
+	"CustomResourceDefinition":       true,
+	"IngressClass":                   true,
+	"MutatingWebhookConfiguration":   true,
+	"Namespace":                      true,
+	"Node":                           true,
+	"PersistentVolume":               true,
+	"PodSecurityPolicy":              true,
+	"PriorityClass":                  true,
+	"RuntimeClass":                   true,
+	"StorageClass":                   true,
+	"ValidatingWebhookConfiguration": true,
+	"VolumeAttachment":               true,
+}
+
+// resourcesTransform transforms a list of resources.
+type resourcesTransform func([]map[string]interface{}) ([]map[string]interface{}, error)
+
+// Transformer applies the `transforms` of a deploy configuration to the manifests of a deployer,
+// then stamps the annotations of the current deployment on every resource.
+// It also holds the custom resources that the deployer transforms, as selected by its config.
+type Transformer struct {
+	cfgs      []latest.ManifestTransform
+	transform Transform
+	selector  *ResourceSelector
+	// annotations returns the annotations of the current deployment, if it's not nil.
+	annotations func() map[string]string
+}
+
+// NewTransformer creates a Transformer that applies the given transforms, in order,
+// and stamps the annotations returned by `annotations` each time the manifests are transformed.
+// The custom resources selected by `selector` are transformed too.
+// It returns nil if there are neither transforms, selected resources nor annotations.
+func NewTransformer(cfgs []latest.ManifestTransform, selector *ResourceSelector, annotations func() map[string]string) (*Transformer, error) {
+	if len(cfgs) == 0 && selector == nil && annotations == nil {
+		return nil, nil
+	}
+	var transform Transform
+	if len(cfgs) > 0 {
+		var err error
+		if transform, err = NewTransform(cfgs); err != nil {
+			return nil, err
+		}
+	}
+	return &Transformer{cfgs: cfgs, transform: transform, selector: selector, annotations: annotations}, nil
+}
+
+// Config returns the transforms applied by the Transformer.
+func (t *Transformer) Config() []latest.ManifestTransform {
+	if t == nil {
+		return nil
+	}
+	return t.cfgs
+}
+
+// ResourceSelector returns the custom resources that the Transformer's deployer transforms.
+func (t *Transformer) ResourceSelector() *ResourceSelector {
+	if t == nil {
+		return nil
+	}
+	return t.selector
+}
+
+// Annotations returns the annotations of the current deployment that the Transformer stamps.
+func (t *Transformer) Annotations() map[string]string {
+	if t == nil || t.annotations == nil {
+		return nil
+	}
+	return t.annotations()
+}
+
+func (t *Transformer) apply(l ManifestList, builds []build.Artifact, registries Registries) (ManifestList, error) {
+	var err error
+	if t.transform != nil {
+		if l, err = t.transform(l, builds, registries); err != nil {
+			return nil, err
+		}
+	}
+
+	annotations := t.Annotations()
+	if len(annotations) == 0 {
+		return l, nil
+	}
+	stamp, err := NewTransform([]latest.ManifestTransform{{Annotations: annotations}})
+	if err != nil {
+		return nil, err
+	}
+	return stamp(l, builds, registries)
+}
+
+// NewTransform creates a Transform that applies the `transforms` of a deploy configuration, in order.
+// Files referenced by the transforms are read each time the manifests are transformed.
+func NewTransform(cfgs []latest.ManifestTransform) (Transform, error) {
+	var steps []resourcesTransform
+	for i, cfg := range cfgs {
+		step, err := newResourcesTransform(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transform #%d: %w", i+1, err)
+		}
+		steps = append(steps, step)
+	}
+
+	return func(l ManifestList, _ []build.Artifact, _ Registries) (ManifestList, error) {
+		resources, err := parseResources(l)
+		if err != nil {
+			return nil, err
+		}
+		for _, step := range steps {
+			if resources, err = step(resources); err != nil {
+				return nil, err
+			}
+		}
+		return marshalResources(resources)
+	}, nil
+}
+
+func newResourcesTransform(cfg latest.ManifestTransform) (resourcesTransform, error) {
+	switch {
+	case cfg.Namespace != "":
+		return forEachResource(func(r map[string]interface{}) error {
+			setNamespace(r, cfg.Namespace)
+			return nil
+		}), nil
+	case len(cfg.Labels) > 0:
+		return forEachResource(func(r map[string]interface{}) error {
+			setMetadata(r, "labels", cfg.Labels)
+			visitPodTemplates(r, func(template map[string]interface{}) {
+				setMetadata(template, "labels", cfg.Labels)
+			})
+			return nil
+		}), nil
+	case len(cfg.Annotations) > 0:
+		return forEachResource(func(r map[string]interface{}) error {
+			setMetadata(r, "annotations", cfg.Annotations)
+			return nil
+		}), nil
+	case cfg.Patch != nil:
+		return newPatch(*cfg.Patch)
+	case cfg.Sidecar != nil:
+		return newSidecar(*cfg.Sidecar)
+	case cfg.EnvFile != "":
+		return replaceEnv(cfg.EnvFile), nil
+	case cfg.Function != nil:
+		return newFunction(*cfg.Function)
+	default:
+		return nil, errors.New("one of namespace, labels, annotations, patch, sidecar, envFile or function must be set")
+	}
+}
+
+func forEachResource(transform func(map[string]interface{}) error) resourcesTransform {
+	return func(resources []map[string]interface{}) ([]map[string]interface{}, error) {
+		for _, r := range resources {
+			if err := transform(r); err != nil {
+				return nil, err
+			}
+		}
+		return resources, nil
+	}
+}
+
+func parseResources(l ManifestList) ([]map[string]interface{}, error) {
+	var resources []map[string]interface{}
+	for _, manifest := range l {
+		r := map[string]interface{}{}
+		if err := yaml.Unmarshal(manifest, &r); err != nil {
+			return nil, fmt.Errorf("reading Kubernetes YAML: %w", err)
+		}
+		if len(r) > 0 {
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+func marshalResources(resources []map[string]interface{}) (ManifestList, error) {
+	var l ManifestList
+	for _, r := range resources {
+		buf, err := yaml.Marshal(r)
+		if err != nil {
+			return nil, fmt.Errorf("marshalling yaml: %w", err)
+		}
+		l = append(l, buf)
+	}
+	return l, nil
+}
+
+// toObject turns a yaml fragment of the configuration into a new JSON-compatible object.
+func toObject(values map[string]interface{}) (map[string]interface{}, error) {
+	buf, err := yaml.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	object := map[string]interface{}{}
+	if err := yaml.Unmarshal(buf, &object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+func metadataOf(r map[string]interface{}) map[string]interface{} {
+	metadata, ok := r["metadata"].(map[string]interface{})
+	if !ok {
+		metadata = map[string]interface{}{}
+		r["metadata"] = metadata
+	}
+	return metadata
+}
+
+func setNamespace(r map[string]interface{}, namespace string) {
+	if kind, _ := r["kind"].(string); clusterScopedKinds[kind] {
+		return
+	}
+	metadataOf(r)["namespace"] = namespace
+}
+
+// setMetadata sets entries of the `labels` or the `annotations` of an object, overwriting existing values.
+func setMetadata(r map[string]interface{}, field string, values map[string]string) {
+	metadata := metadataOf(r)
+	entries, ok := metadata[field].(map[string]interface{})
+	if !ok {
+		entries = map[string]interface{}{}
+		metadata[field] = entries
+	}
+	for k, v := range values {
+		entries[k] = v
+	}
+}
+
+// visitPodTemplates calls visit for each pod template found in an object.
+// A pod template is an object with a `spec` holding a list of `containers`.
+func visitPodTemplates(value interface{}, visit func(map[string]interface{})) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		if spec, ok := value["spec"].(map[string]interface{}); ok {
+			if _, ok := spec["containers"].([]interface{}); ok {
+				visit(value)
+			}
+		}
+		for _, k := range sortedKeys(value) {
+			visitPodTemplates(value[k], visit)
+		}
+	case []interface{}:
+		for _, item := range value {
+			visitPodTemplates(item, visit)
+		}
+	}
+}
+
+// matchesTarget returns true if a resource is selected by a target.
+func matchesTarget(target latest.TransformTarget, r map[string]interface{}) bool {
+	kind, _ := r["kind"].(string)
+	metadata, _ := r["metadata"].(map[string]interface{})
+	name, _ := metadata["name"].(string)
+	namespace, _ := metadata["namespace"].(string)
+	labels, _ := metadata["labels"].(map[string]interface{})
+
+	if (target.Kind != "" && target.Kind != kind) || (target.Name != "" && target.Name != name) || (target.Namespace != "" && target.Namespace != namespace) {
+		return false
+	}
+	for k, v := range target.Labels {
+		if value, _ := labels[k].(string); value != v {
+			return false
+		}
+	}
+	return true
+}
+
+func newPatch(cfg latest.ManifestPatch) (resourcesTransform, error) {
+	if cfg.Type != "" && cfg.Type != "strategic" && cfg.Type != "json" {
+		return nil, fmt.Errorf("unknown patch type %q, it should be `strategic` or `json`", cfg.Type)
+	}
+	if cfg.Patch == "" && cfg.Path == "" {
+		return nil, errors.New("patch or path must be set")
+	}
+
+	return func(resources []map[string]interface{}) ([]map[string]interface{}, error) {
+		patch := []byte(cfg.Patch)
+		if cfg.Path != "" {
+			var err error
+			if patch, err = ioutil.ReadFile(cfg.Path); err != nil {
+				return nil, fmt.Errorf("reading patch: %w", err)
+			}
+		}
+		patch, err := k8syaml.YAMLToJSON(patch)
+		if err != nil {
+			return nil, fmt.Errorf("reading patch: %w", err)
+		}
+
+		for i, r := range resources {
+			if !matchesTarget(cfg.Target, r) {
+				continue
+			}
+			original, err := json.Marshal(r)
+			if err != nil {
+				return nil, err
+			}
+
+			var patched []byte
+			if cfg.Type == "json" {
+				var p jsonpatch.Patch
+				if p, err = jsonpatch.DecodePatch(patch); err == nil {
+					patched, err = p.Apply(original)
+				}
+			} else {
+				patched, err = strategicMerge(r, original, patch)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("patching %s: %w", resourceName(r), err)
+			}
+
+			updated := map[string]interface{}{}
+			if err := yaml.Unmarshal(patched, &updated); err != nil {
+				return nil, err
+			}
+			resources[i] = updated
+		}
+		return resources, nil
+	}, nil
+}
+
+// strategicMerge applies a strategic merge patch to a built-in resource and a JSON merge patch to other resources,
+// since their merge strategies aren't known.
+func strategicMerge(r map[string]interface{}, original, patch []byte) ([]byte, error) {
+	apiVersion, _ := r["apiVersion"].(string)
+	kind, _ := r["kind"].(string)
+	object, err := scheme.Scheme.New(apimachinery.FromAPIVersionAndKind(apiVersion, kind))
+	if err != nil {
+		return jsonpatch.MergePatch(original, patch)
+	}
+	return strategicpatch.StrategicMergePatch(original, patch, object)
+}
+
+func resourceName(r map[string]interface{}) string {
+	kind, _ := r["kind"].(string)
+	metadata, _ := r["metadata"].(map[string]interface{})
+	name, _ := metadata["name"].(string)
+	return fmt.Sprintf("%s %q", kind, name)
+}
+
+func newSidecar(cfg latest.SidecarTransform) (resourcesTransform, error) {
+	container, err := toObject(cfg.Container.Values)
+	if err != nil {
+		return nil, fmt.Errorf("reading sidecar container: %w", err)
+	}
+	name, _ := container["name"].(string)
+	if name == "" {
+		return nil, errors.New("the sidecar container must have a name")
+	}
+
+	field := "containers"
+	if cfg.Init {
+		field = "initContainers"
+	}
+
+	return forEachResource(func(r map[string]interface{}) error {
+		if !matchesTarget(cfg.Target, r) {
+			return nil
+		}
+		var err error
+		visitPodTemplates(r, func(template map[string]interface{}) {
+			spec := template["spec"].(map[string]interface{})
+			containers, _ := spec[field].([]interface{})
+
+			sidecar, copyErr := toObject(container)
+			if copyErr != nil {
+				err = copyErr
+				return
+			}
+			for i, c := range containers {
+				if existing, ok := c.(map[string]interface{}); ok && existing["name"] == name {
+					containers[i] = sidecar
+					return
+				}
+			}
+			spec[field] = append(containers, sidecar)
+		})
+		return err
+	}), nil
+}
+
+func replaceEnv(envFile string) resourcesTransform {
+	return func(resources []map[string]interface{}) ([]map[string]interface{}, error) {
+		values, err := readEnvFile(envFile)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range resources {
+			visitContainers("", r, func(_ string, container map[string]interface{}) {
+				env, _ := container["env"].([]interface{})
+				for _, e := range env {
+					variable, ok := e.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					name, _ := variable["name"].(string)
+					if value, found := values[name]; found {
+						variable["value"] = value
+						delete(variable, "valueFrom")
+					}
+				}
+			})
+		}
+		return resources, nil
+	}
+}
+
+// readEnvFile reads the `KEY=VALUE` lines of a `.env` file. Empty lines and comments are ignored.
+// Values can be single or double quoted.
+func readEnvFile(path string) (map[string]string, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading env file: %w", err)
+	}
+
+	values := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(buf))
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		kv := strings.SplitN(strings.TrimPrefix(line, "export "), "=", 2)
+		key := strings.TrimSpace(kv[0])
+		if len(kv) != 2 || key == "" {
+			return nil, fmt.Errorf("reading env file %q: line %d should look like KEY=VALUE", path, n)
+		}
+
+		value := strings.TrimSpace(kv[1])
+		switch {
+		case len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"':
+			if value, err = strconv.Unquote(value); err != nil {
+				return nil, fmt.Errorf("reading env file %q: line %d: %w", path, n, err)
+			}
+		case len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'':
+			value = value[1 : len(value)-1]
+		}
+		values[key] = value
+	}
+	return values, scanner.Err()
+}
@@ -0,0 +1,465 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const (
+	transformedDeployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      containers:
+      - name: web
+        image: web:v1
+        env:
+        - name: MODE
+          value: dev
+        - name: TOKEN
+          valueFrom:
+            secretKeyRef:
+              name: token
+              key: token
+`
+	transformedNamespace = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: prod
+`
+	transformedCustomResource = `apiVersion: example.com/v1
+kind: Backup
+metadata:
+  name: nightly
+spec:
+  schedule: "0 0 * * *"
+  targets: [db]
+`
+)
+
+func TestNewTransform(t *testing.T) {
+	tests := []struct {
+		description string
+		transforms  []latest.ManifestTransform
+		manifests   ManifestList
+		expected    ManifestList
+	}{
+		{
+			description: "namespace",
+			transforms:  []latest.ManifestTransform{{Namespace: "prod"}},
+			manifests:   ManifestList{[]byte(transformedNamespace), []byte(transformedCustomResource)},
+			expected: ManifestList{[]byte(transformedNamespace), []byte(`apiVersion: example.com/v1
+kind: Backup
+metadata:
+  name: nightly
+  namespace: prod
+spec:
+  schedule: 0 0 * * *
+  targets:
+  - db
+`)},
+		},
+		{
+			description: "labels are set on resources and pod templates",
+			transforms:  []latest.ManifestTransform{{Labels: map[string]string{"app": "shop", "team": "a"}}},
+			manifests:   ManifestList{[]byte(transformedDeployment)},
+			expected: ManifestList{[]byte(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  labels:
+    app: shop
+    team: a
+  name: web
+spec:
+  template:
+    metadata:
+      labels:
+        app: shop
+        team: a
+    spec:
+      containers:
+      - env:
+        - name: MODE
+          value: dev
+        - name: TOKEN
+          valueFrom:
+            secretKeyRef:
+              key: token
+              name: token
+        image: web:v1
+        name: web
+`)},
+		},
+		{
+			description: "annotations",
+			transforms:  []latest.ManifestTransform{{Annotations: map[string]string{"owner": "me"}}},
+			manifests:   ManifestList{[]byte(transformedNamespace)},
+			expected: ManifestList{[]byte(`apiVersion: v1
+kind: Namespace
+metadata:
+  annotations:
+    owner: me
+  name: prod
+`)},
+		},
+		{
+			description: "strategic merge patch",
+			transforms: []latest.ManifestTransform{{Patch: &latest.ManifestPatch{
+				Target: latest.TransformTarget{Kind: "Deployment"},
+				Patch: `spec:
+  replicas: 3
+  template:
+    spec:
+      containers:
+      - name: web
+        image: web:v2`,
+			}}},
+			manifests: ManifestList{[]byte(transformedDeployment), []byte(transformedNamespace)},
+			expected: ManifestList{[]byte(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  replicas: 3
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      containers:
+      - env:
+        - name: MODE
+          value: dev
+        - name: TOKEN
+          valueFrom:
+            secretKeyRef:
+              key: token
+              name: token
+        image: web:v2
+        name: web
+`), []byte(transformedNamespace)},
+		},
+		{
+			description: "merge patch of a custom resource",
+			transforms: []latest.ManifestTransform{{Patch: &latest.ManifestPatch{
+				Patch: `{"spec": {"targets": ["db", "cache"]}}`,
+			}}},
+			manifests: ManifestList{[]byte(transformedCustomResource)},
+			expected: ManifestList{[]byte(`apiVersion: example.com/v1
+kind: Backup
+metadata:
+  name: nightly
+spec:
+  schedule: 0 0 * * *
+  targets:
+  - db
+  - cache
+`)},
+		},
+		{
+			description: "json patch",
+			transforms: []latest.ManifestTransform{{Patch: &latest.ManifestPatch{
+				Target: latest.TransformTarget{Name: "nightly"},
+				Type:   "json",
+				Patch:  `[{"op": "replace", "path": "/spec/schedule", "value": "@hourly"}]`,
+			}}},
+			manifests: ManifestList{[]byte(transformedCustomResource)},
+			expected: ManifestList{[]byte(`apiVersion: example.com/v1
+kind: Backup
+metadata:
+  name: nightly
+spec:
+  schedule: '@hourly'
+  targets:
+  - db
+`)},
+		},
+		{
+			description: "sidecar",
+			transforms: []latest.ManifestTransform{{Sidecar: &latest.SidecarTransform{
+				Target:    latest.TransformTarget{Labels: map[string]string{"app": "web"}},
+				Container: schemautil.HelmOverrides{Values: map[string]interface{}{"name": "proxy", "image": "envoy"}},
+			}}, {Sidecar: &latest.SidecarTransform{
+				Container: schemautil.HelmOverrides{Values: map[string]interface{}{"name": "init", "image": "busybox"}},
+				Init:      true,
+			}}},
+			manifests: ManifestList{[]byte(`apiVersion: v1
+kind: Pod
+metadata:
+  name: web
+  labels:
+    app: web
+spec:
+  containers:
+  - name: web
+    image: web:v1
+  - name: proxy
+    image: old
+`)},
+			expected: ManifestList{[]byte(`apiVersion: v1
+kind: Pod
+metadata:
+  labels:
+    app: web
+  name: web
+spec:
+  containers:
+  - image: web:v1
+    name: web
+  - image: envoy
+    name: proxy
+  initContainers:
+  - image: busybox
+    name: init
+`)},
+		},
+		{
+			description: "transforms are applied in order",
+			transforms:  []latest.ManifestTransform{{Namespace: "prod"}, {Patch: &latest.ManifestPatch{Target: latest.TransformTarget{Namespace: "prod"}, Patch: "metadata: {name: weekly}"}}},
+			manifests:   ManifestList{[]byte(transformedCustomResource)},
+			expected: ManifestList{[]byte(`apiVersion: example.com/v1
+kind: Backup
+metadata:
+  name: weekly
+  namespace: prod
+spec:
+  schedule: 0 0 * * *
+  targets:
+  - db
+`)},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			transform, err := NewTransform(test.transforms)
+			t.CheckNoError(err)
+
+			transformed, err := transform(test.manifests, nil, Registries{})
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected.String(), transformed.String())
+		})
+	}
+}
+
+func TestNewTransformErrors(t *testing.T) {
+	tests := []struct {
+		description string
+		transform   latest.ManifestTransform
+		expected    string
+	}{
+		{
+			description: "empty",
+			expected:    "invalid transform #1: one of namespace, labels, annotations, patch, sidecar, envFile or function must be set",
+		},
+		{
+			description: "unknown patch type",
+			transform:   latest.ManifestTransform{Patch: &latest.ManifestPatch{Type: "merge", Patch: "{}"}},
+			expected:    "invalid transform #1: unknown patch type \"merge\", it should be `strategic` or `json`",
+		},
+		{
+			description: "sidecar without a name",
+			transform:   latest.ManifestTransform{Sidecar: &latest.SidecarTransform{Container: schemautil.HelmOverrides{Values: map[string]interface{}{"image": "envoy"}}}},
+			expected:    "invalid transform #1: the sidecar container must have a name",
+		},
+		{
+			description: "function without image or exec",
+			transform:   latest.ManifestTransform{Function: &latest.KRMFunction{}},
+			expected:    "invalid transform #1: the function must have an image or an exec",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			_, err := NewTransform([]latest.ManifestTransform{test.transform})
+
+			t.CheckErrorContains(test.expected, err)
+		})
+	}
+}
+
+func TestEnvFileTransform(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		envFile := t.TempFile("env", []byte(`# local settings
+MODE=local
+export TOKEN="abc\tdef"
+UNUSED='x'
+`))
+		transform, err := NewTransform([]latest.ManifestTransform{{EnvFile: envFile}})
+		t.CheckNoError(err)
+
+		transformed, err := transform(ManifestList{[]byte(transformedDeployment)}, nil, Registries{})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      containers:
+      - env:
+        - name: MODE
+          value: local
+        - name: TOKEN
+          value: "abc\tdef"
+        image: web:v1
+        name: web`, transformed.String())
+	})
+}
+
+func TestFunctionTransform(t *testing.T) {
+	tests := []struct {
+		description string
+		function    latest.KRMFunction
+		commands    util.Command
+		shouldErr   bool
+	}{
+		{
+			description: "executable",
+			function:    latest.KRMFunction{Exec: "/fn/set-owner"},
+			commands: testutil.CmdRunOut("/fn/set-owner", `apiVersion: config.kubernetes.io/v1alpha1
+kind: ResourceList
+items:
+- apiVersion: v1
+  kind: Namespace
+  metadata:
+    name: prod
+    annotations:
+      owner: me
+`),
+		},
+		{
+			description: "local image",
+			function:    latest.KRMFunction{Image: "set-owner:v1"},
+			commands: testutil.CmdRun("docker image inspect --format {{.Id}} set-owner:v1").
+				AndRunOut("docker run --rm -i --network none set-owner:v1", `kind: ResourceList
+items:
+- apiVersion: v1
+  kind: Namespace
+  metadata:
+    name: prod
+    annotations:
+      owner: me
+`),
+		},
+		{
+			description: "missing image",
+			function:    latest.KRMFunction{Image: "set-owner:v1"},
+			commands:    testutil.CmdRunErr("docker image inspect --format {{.Id}} set-owner:v1", errors.New("no such image")),
+			shouldErr:   true,
+		},
+		{
+			description: "invalid output",
+			function:    latest.KRMFunction{Exec: "/fn/set-owner"},
+			commands:    testutil.CmdRunOut("/fn/set-owner", "kind: Namespace"),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			transform, err := NewTransform([]latest.ManifestTransform{{Function: &test.function}})
+			t.CheckNoError(err)
+
+			transformed, err := transform(ManifestList{[]byte(transformedNamespace)}, nil, Registries{})
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(`apiVersion: v1
+kind: Namespace
+metadata:
+  annotations:
+    owner: me
+  name: prod`, transformed.String())
+			}
+		})
+	}
+}
+
+func TestApplyTransformsWithTransformer(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&transforms, nil)
+		global, err := NewTransform([]latest.ManifestTransform{{Namespace: "global"}})
+		t.CheckNoError(err)
+		AddTransform(global)
+		transformer, err := NewTransformer([]latest.ManifestTransform{{Namespace: "deployer"}}, nil, nil)
+		t.CheckNoError(err)
+
+		configMap := ManifestList{[]byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: web")}
+
+		transformed, err := ApplyTransforms(configMap, nil, nil, nil, "")
+		t.CheckNoError(err)
+		t.CheckContains("namespace: global", transformed.String())
+
+		// The transforms of the deployer are applied last
+		transformed, err = ApplyTransforms(configMap, nil, transformer, nil, "")
+		t.CheckNoError(err)
+		t.CheckContains("namespace: deployer", transformed.String())
+		t.CheckDeepEqual(1, len(GetTransforms()))
+
+		ResetTransforms()
+		t.CheckDeepEqual(0, len(GetTransforms()))
+	})
+}
+
+func TestTransformerStampsAnnotations(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&transforms, nil)
+		var annotations map[string]string
+		transformer, err := NewTransformer(nil, nil, func() map[string]string { return annotations })
+		t.CheckNoError(err)
+
+		configMap := ManifestList{[]byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: web")}
+
+		// Nothing is stamped before the first deployment
+		transformed, err := ApplyTransforms(configMap, nil, transformer, nil, "")
+		t.CheckNoError(err)
+		t.CheckDeepEqual(configMap.String(), transformed.String())
+
+		annotations = map[string]string{"skaffold.dev/deployed-at": "2021-03-01T10:00:00Z"}
+		transformed, err = ApplyTransforms(configMap, nil, transformer, nil, "")
+		t.CheckNoError(err)
+		t.CheckContains("skaffold.dev/deployed-at: \"2021-03-01T10:00:00Z\"", transformed.String())
+		t.CheckDeepEqual(0, len(transformer.Config()))
+	})
+}
+
+func TestNewTransformerWithoutTransforms(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		transformer, err := NewTransformer(nil, nil, nil)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual((*Transformer)(nil), transformer)
+	})
+}
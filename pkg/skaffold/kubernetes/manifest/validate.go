@@ -0,0 +1,322 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// Violation is a problem found in a resource.
+type Violation struct {
+	// Resource identifies the resource, like `Deployment "web" in namespace "prod"`.
+	Resource string
+	// Field is the path of the offending field, if any.
+	Field   string
+	Message string
+}
+
+func (v Violation) String() string {
+	if v.Field == "" {
+		return fmt.Sprintf("%s: %s", v.Resource, v.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", v.Resource, v.Field, v.Message)
+}
+
+// ValidationError lists the violations found in a set of manifests.
+type ValidationError struct {
+	Violations []Violation
+}
+
+func (e *ValidationError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d problems found in the manifests:", len(e.Violations))
+	for _, v := range e.Violations {
+		fmt.Fprintf(&b, "\n - %s", v)
+	}
+	return b.String()
+}
+
+// Validator checks resources against the OpenAPI schemas of a Kubernetes version,
+// the schemas of custom resource definitions and a set of policies.
+type Validator struct {
+	cfg     latest.ValidateConfig
+	schemas *openAPISchemas
+	// crds are the schemas of the configured custom resource definitions.
+	crds map[string]*openAPISchema
+}
+
+// NewValidator creates a Validator from a `validate` configuration.
+func NewValidator(cfg latest.ValidateConfig) (*Validator, error) {
+	var schemas *openAPISchemas
+	if cfg.KubernetesSchema != "" {
+		buf, err := ioutil.ReadFile(cfg.KubernetesSchema)
+		if err != nil {
+			return nil, fmt.Errorf("reading Kubernetes schema: %w", err)
+		}
+		if schemas, err = parseOpenAPISchemas(buf); err != nil {
+			return nil, fmt.Errorf("reading Kubernetes schema %q: %w", cfg.KubernetesSchema, err)
+		}
+	} else {
+		var err error
+		if schemas, err = loadBundledSchemas(); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.KubernetesVersion != "" && majorMinor(cfg.KubernetesVersion) != schemas.version {
+		if cfg.KubernetesSchema == "" {
+			return nil, fmt.Errorf("no schema bundled for Kubernetes %s, only %s is, set `kubernetesSchema` to the OpenAPI document of Kubernetes %s", cfg.KubernetesVersion, schemas.version, cfg.KubernetesVersion)
+		}
+		return nil, fmt.Errorf("%q is the schema of Kubernetes %s, not %s", cfg.KubernetesSchema, schemas.version, cfg.KubernetesVersion)
+	}
+
+	v := &Validator{
+		cfg:     cfg,
+		schemas: schemas,
+		crds:    map[string]*openAPISchema{},
+	}
+	var files []string
+	for _, pattern := range cfg.CRDs {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+		if len(matches) == 0 {
+			// Report the missing file
+			matches = []string{pattern}
+		}
+		files = append(files, matches...)
+	}
+	for _, file := range files {
+		buf, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("reading custom resource definitions: %w", err)
+		}
+		manifests, err := Load(bytes.NewReader(buf))
+		if err != nil {
+			return nil, fmt.Errorf("reading custom resource definitions in %q: %w", file, err)
+		}
+		for _, m := range manifests {
+			if err := addCRD(v.crds, m); err != nil {
+				return nil, fmt.Errorf("reading custom resource definitions in %q: %w", file, err)
+			}
+		}
+	}
+	return v, nil
+}
+
+// Config returns the `validate` configuration the Validator was created from.
+func (v *Validator) Config() latest.ValidateConfig {
+	return v.cfg
+}
+
+// Validate checks the manifests and returns a *ValidationError listing all the violations found, if any.
+// Custom resource definitions that are part of the manifests are used to validate the custom resources
+// of these manifests only.
+func (v *Validator) Validate(manifests ManifestList) error {
+	crds := map[string]*openAPISchema{}
+	for gvk, schema := range v.crds {
+		crds[gvk] = schema
+	}
+
+	var resources []map[string]interface{}
+	for _, m := range manifests {
+		resource := map[string]interface{}{}
+		if err := k8syaml.Unmarshal(m, &resource); err != nil {
+			return fmt.Errorf("reading Kubernetes YAML: %w", err)
+		}
+		if len(resource) == 0 {
+			continue
+		}
+		if err := addCRD(crds, m); err != nil {
+			return err
+		}
+		resources = append(resources, resource)
+	}
+
+	var violations []Violation
+	for _, resource := range resources {
+		violations = append(violations, v.validateResource(resource, crds)...)
+	}
+	if len(violations) > 0 {
+		return &ValidationError{Violations: violations}
+	}
+	return nil
+}
+
+func (v *Validator) validateResource(resource map[string]interface{}, crds map[string]*openAPISchema) []Violation {
+	apiVersion, _ := resource["apiVersion"].(string)
+	kind, _ := resource["kind"].(string)
+	metadata, _ := resource["metadata"].(map[string]interface{})
+	name, _ := metadata["name"].(string)
+	namespace, _ := metadata["namespace"].(string)
+
+	id := fmt.Sprintf("%s %q", kind, name)
+	if namespace != "" {
+		id += fmt.Sprintf(" in namespace %q", namespace)
+	}
+
+	var violations []Violation
+	report := func(field, message string) {
+		violations = append(violations, Violation{Resource: id, Field: field, Message: message})
+	}
+
+	if apiVersion == "" || kind == "" {
+		report("", "apiVersion and kind are required")
+		return violations
+	}
+
+	validator := &schemaValidator{definitions: v.schemas.definitions, report: report}
+	gvk := apiVersion + "/" + kind
+	switch {
+	case v.schemas.kinds[gvk] != nil:
+		validator.validate("", resource, v.schemas.kinds[gvk])
+	case crds[gvk] != nil:
+		// Custom resource schemas don't describe the fields common to all resources
+		validator.validate("metadata", resource["metadata"], v.schemas.definitions[objectMetaDefinition])
+		custom := map[string]interface{}{}
+		for k, value := range resource {
+			if k != "apiVersion" && k != "kind" && k != "metadata" {
+				custom[k] = value
+			}
+		}
+		validator.validate("", custom, crds[gvk])
+	case !v.cfg.IgnoreUnknownKinds:
+		report("", fmt.Sprintf("no schema found for %s %s", apiVersion, kind))
+	}
+
+	v.checkPolicies(resource, report)
+	return violations
+}
+
+// addCRD indexes the schemas of a custom resource definition. Other resources are ignored.
+func addCRD(crds map[string]*openAPISchema, manifest []byte) error {
+	var crd struct {
+		APIVersion string `json:"apiVersion"`
+		Kind       string `json:"kind"`
+		Spec       struct {
+			Group string `json:"group"`
+			Names struct {
+				Kind string `json:"kind"`
+			} `json:"names"`
+			// apiextensions.k8s.io/v1beta1
+			Version    string                `json:"version"`
+			Validation *customResourceSchema `json:"validation"`
+			Versions   []struct {
+				Name   string                `json:"name"`
+				Schema *customResourceSchema `json:"schema"`
+			} `json:"versions"`
+		} `json:"spec"`
+	}
+	buf, err := k8syaml.YAMLToJSON(manifest)
+	if err != nil {
+		return fmt.Errorf("reading Kubernetes YAML: %w", err)
+	}
+	if err := json.Unmarshal(buf, &crd); err != nil || crd.Kind != "CustomResourceDefinition" || !strings.HasPrefix(crd.APIVersion, "apiextensions.k8s.io/") {
+		return nil
+	}
+
+	add := func(version string, schema *customResourceSchema) {
+		if schema != nil && schema.OpenAPIV3Schema != nil {
+			crds[crd.Spec.Group+"/"+version+"/"+crd.Spec.Names.Kind] = schema.OpenAPIV3Schema
+		}
+	}
+	if crd.Spec.Version != "" {
+		add(crd.Spec.Version, crd.Spec.Validation)
+	}
+	for _, version := range crd.Spec.Versions {
+		schema := version.Schema
+		if schema == nil {
+			schema = crd.Spec.Validation
+		}
+		add(version.Name, schema)
+	}
+	return nil
+}
+
+type customResourceSchema struct {
+	OpenAPIV3Schema *openAPISchema `json:"openAPIV3Schema"`
+}
+
+// checkPolicies reports the containers that don't follow the configured policies.
+func (v *Validator) checkPolicies(resource map[string]interface{}, report func(field, message string)) {
+	policies := v.cfg.Policies
+	if !policies.RequireResourceLimits && !policies.ForbidLatestTag {
+		return
+	}
+
+	visitContainers("", resource, func(path string, container map[string]interface{}) {
+		if policies.ForbidLatestTag {
+			if image, ok := container["image"].(string); ok && usesLatestTag(image) {
+				report(path+".image", fmt.Sprintf("image %q must be referenced by a tag other than latest or by digest", image))
+			}
+		}
+
+		if policies.RequireResourceLimits {
+			resources, _ := container["resources"].(map[string]interface{})
+			limits, _ := resources["limits"].(map[string]interface{})
+			for _, resource := range []string{"cpu", "memory"} {
+				if limits[resource] == nil {
+					report(path+".resources.limits", fmt.Sprintf("%s limit is required", resource))
+				}
+			}
+		}
+	})
+}
+
+// visitContainers calls visit for each container of the pod specs found in an object.
+func visitContainers(path string, value interface{}, visit func(string, map[string]interface{})) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for _, k := range sortedKeys(value) {
+			field := join(path, k)
+			if k == "containers" || k == "initContainers" || k == "ephemeralContainers" {
+				if containers, ok := value[k].([]interface{}); ok {
+					for i, c := range containers {
+						if container, ok := c.(map[string]interface{}); ok {
+							visit(fmt.Sprintf("%s[%d]", field, i), container)
+						}
+					}
+					continue
+				}
+			}
+			visitContainers(field, value[k], visit)
+		}
+	case []interface{}:
+		for i, item := range value {
+			visitContainers(fmt.Sprintf("%s[%d]", path, i), item, visit)
+		}
+	}
+}
+
+func usesLatestTag(image string) bool {
+	parsed, err := docker.ParseReference(image)
+	if err != nil {
+		return false
+	}
+	return parsed.Digest == "" && (parsed.Tag == "" || parsed.Tag == "latest")
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const (
+	validDeployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: prod
+spec:
+  replicas: 2
+  selector:
+    matchLabels:
+      app: web
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      containers:
+      - name: web
+        image: gcr.io/project/web:v1
+        ports:
+        - containerPort: 8080
+        resources:
+          limits:
+            cpu: 1
+            memory: 128Mi`
+	crd = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: apps.example.com
+spec:
+  group: example.com
+  scope: Namespaced
+  names:
+    kind: App
+    plural: apps
+  versions:
+  - name: v1
+    served: true
+    storage: true
+    schema:
+      openAPIV3Schema:
+        type: object
+        properties:
+          spec:
+            type: object
+            required: [image]
+            properties:
+              image:
+                type: string
+              replicas:
+                type: integer`
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         latest.ValidateConfig
+		manifests   []string
+		expected    []Violation
+	}{
+		{
+			description: "valid deployment",
+			manifests:   []string{validDeployment},
+		},
+		{
+			description: "wrong types and unknown fields",
+			manifests: []string{`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  replicas: "2"
+  replica: 2
+  selector: {}
+  template:
+    spec:
+      containers:
+      - name: web
+        ports:
+        - containerPort: http`},
+			expected: []Violation{
+				{Resource: `Deployment "web"`, Field: "spec.replica", Message: "unknown field"},
+				{Resource: `Deployment "web"`, Field: "spec.replicas", Message: "expected an integer, got a string"},
+				{Resource: `Deployment "web"`, Field: "spec.template.spec.containers[0].ports[0].containerPort", Message: "expected an integer, got a string"},
+			},
+		},
+		{
+			description: "missing required field",
+			manifests:   []string{"apiVersion: v1\nkind: Pod\nmetadata:\n  name: web\nspec: {}"},
+			expected: []Violation{
+				{Resource: `Pod "web"`, Field: "spec.containers", Message: "required field is missing"},
+			},
+		},
+		{
+			description: "unknown kind",
+			manifests:   []string{"apiVersion: example.com/v1\nkind: App\nmetadata:\n  name: web", "kind: Service"},
+			expected: []Violation{
+				{Resource: `App "web"`, Message: "no schema found for example.com/v1 App"},
+				{Resource: `Service ""`, Message: "apiVersion and kind are required"},
+			},
+		},
+		{
+			description: "ignore unknown kind",
+			cfg:         latest.ValidateConfig{IgnoreUnknownKinds: true},
+			manifests:   []string{"apiVersion: example.com/v1\nkind: App\nmetadata:\n  name: web"},
+		},
+		{
+			description: "custom resources",
+			manifests: []string{
+				crd,
+				"apiVersion: example.com/v1\nkind: App\nmetadata:\n  name: valid\nspec:\n  image: web",
+				"apiVersion: example.com/v1\nkind: App\nmetadata:\n  name: invalid\n  label: oops\nspec:\n  replicas: many",
+			},
+			expected: []Violation{
+				{Resource: `App "invalid"`, Field: "metadata.label", Message: "unknown field"},
+				{Resource: `App "invalid"`, Field: "spec.image", Message: "required field is missing"},
+				{Resource: `App "invalid"`, Field: "spec.replicas", Message: "expected an integer, got a string"},
+			},
+		},
+		{
+			description: "policies",
+			cfg:         latest.ValidateConfig{Policies: latest.ValidationPolicies{RequireResourceLimits: true, ForbidLatestTag: true}},
+			manifests: []string{
+				validDeployment,
+				"apiVersion: v1\nkind: Pod\nmetadata:\n  name: web\nspec:\n  initContainers:\n  - name: init\n    image: busybox@sha256:" + "a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2" + "\n    resources:\n      limits:\n        cpu: 100m\n  containers:\n  - name: web\n    image: web:latest",
+			},
+			expected: []Violation{
+				{Resource: `Pod "web"`, Field: "spec.containers[0].image", Message: `image "web:latest" must be referenced by a tag other than latest or by digest`},
+				{Resource: `Pod "web"`, Field: "spec.containers[0].resources.limits", Message: "cpu limit is required"},
+				{Resource: `Pod "web"`, Field: "spec.containers[0].resources.limits", Message: "memory limit is required"},
+				{Resource: `Pod "web"`, Field: "spec.initContainers[0].resources.limits", Message: "memory limit is required"},
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			validator, err := NewValidator(test.cfg)
+			t.CheckNoError(err)
+
+			var manifests ManifestList
+			for _, m := range test.manifests {
+				manifests = append(manifests, []byte(m))
+			}
+			err = validator.Validate(manifests)
+
+			if test.expected == nil {
+				t.CheckNoError(err)
+				return
+			}
+			validationErr, ok := err.(*ValidationError)
+			t.CheckTrue(ok)
+			if ok {
+				t.CheckDeepEqual(test.expected, validationErr.Violations)
+			}
+		})
+	}
+}
+
+func TestValidateDoesNotKeepCRDs(t *testing.T) {
+	validator, err := NewValidator(latest.ValidateConfig{})
+	testutil.CheckError(t, false, err)
+
+	app := []byte("apiVersion: example.com/v1\nkind: App\nmetadata:\n  name: web\nspec:\n  image: web")
+	testutil.CheckError(t, false, validator.Validate(ManifestList{[]byte(crd), app}))
+
+	// The definition of the previous manifests is no longer known
+	err = validator.Validate(ManifestList{app})
+	validationErr, ok := err.(*ValidationError)
+	testutil.CheckDeepEqual(t, true, ok)
+	if ok {
+		testutil.CheckDeepEqual(t, []Violation{{Resource: `App "web"`, Message: "no schema found for example.com/v1 App"}}, validationErr.Violations)
+	}
+}
+
+func TestNewValidator(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         latest.ValidateConfig
+		files       map[string]string
+		shouldErr   bool
+	}{
+		{
+			description: "bundled version",
+			cfg:         latest.ValidateConfig{KubernetesVersion: "1.20"},
+		},
+		{
+			description: "version without a bundled schema",
+			cfg:         latest.ValidateConfig{KubernetesVersion: "1.17"},
+			shouldErr:   true,
+		},
+		{
+			description: "kubernetes schema",
+			cfg:         latest.ValidateConfig{KubernetesVersion: "v1.20.2", KubernetesSchema: "swagger.json"},
+			files:       map[string]string{"swagger.json": `{"info": {"version": "v1.20.2"}, "definitions": {}}`},
+		},
+		{
+			description: "kubernetes schema of another version",
+			cfg:         latest.ValidateConfig{KubernetesVersion: "1.19", KubernetesSchema: "swagger.json"},
+			files:       map[string]string{"swagger.json": `{"info": {"version": "v1.20.2"}, "definitions": {}}`},
+			shouldErr:   true,
+		},
+		{
+			description: "crds",
+			cfg:         latest.ValidateConfig{CRDs: []string{"crds/*.yaml"}},
+			files:       map[string]string{"crds/app.yaml": crd},
+		},
+		{
+			description: "missing crds",
+			cfg:         latest.ValidateConfig{CRDs: []string{"crds.yaml"}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().WriteFiles(test.files)
+			if test.cfg.KubernetesSchema != "" {
+				test.cfg.KubernetesSchema = tmpDir.Path(test.cfg.KubernetesSchema)
+			}
+			for i := range test.cfg.CRDs {
+				test.cfg.CRDs[i] = tmpDir.Path(test.cfg.CRDs[i])
+			}
+
+			_, err := NewValidator(test.cfg)
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
@@ -18,9 +18,12 @@ package manifest
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	apimachinery "k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
 )
 
@@ -41,6 +44,92 @@ var transformableAllowlist = map[apimachinery.GroupKind]bool{
 	{Group: "agones.dev", Kind: "GameServer"}:       true,
 }
 
+// transformableKind describes where the images and the metadata of a custom resource kind are.
+type transformableKind struct {
+	recursive bool
+	images    []fieldPath
+	labels    []fieldPath
+}
+
+// fieldPath is a JSONPath-like path split into its segments. A `*` segment matches all the items of a list
+// or all the fields of an object.
+type fieldPath []string
+
+// ResourceSelector holds the custom resource kinds that Skaffold transforms, next to the built-in ones,
+// as configured in the `resourceSelector` section of a skaffold.yaml.
+// A nil ResourceSelector selects no custom resource.
+type ResourceSelector struct {
+	filters []latest.ResourceFilter
+	kinds   map[apimachinery.GroupKind]transformableKind
+}
+
+// NewResourceSelector creates a ResourceSelector for the given filters. It returns nil if there are none.
+func NewResourceSelector(filters []latest.ResourceFilter) (*ResourceSelector, error) {
+	if len(filters) == 0 {
+		return nil, nil
+	}
+
+	rs := &ResourceSelector{filters: filters, kinds: map[apimachinery.GroupKind]transformableKind{}}
+	for _, f := range filters {
+		groupKind := apimachinery.ParseGroupKind(f.GroupKind)
+		if groupKind.Kind == "" {
+			return nil, fmt.Errorf("invalid groupKind %q in resourceSelector", f.GroupKind)
+		}
+
+		kind := transformableKind{recursive: f.Recursive}
+		for _, p := range f.Image {
+			path, err := parseFieldPath(p)
+			if err != nil {
+				return nil, err
+			}
+			kind.images = append(kind.images, path)
+		}
+		for _, p := range f.Labels {
+			path, err := parseFieldPath(p)
+			if err != nil {
+				return nil, err
+			}
+			kind.labels = append(kind.labels, path)
+		}
+
+		rs.kinds[groupKind] = kind
+	}
+	return rs, nil
+}
+
+// Config returns the filters the ResourceSelector was created with.
+func (rs *ResourceSelector) Config() []latest.ResourceFilter {
+	if rs == nil {
+		return nil
+	}
+	return rs.filters
+}
+
+// kind returns where the images and the metadata of a selected custom resource kind are.
+func (rs *ResourceSelector) kind(groupKind apimachinery.GroupKind) (transformableKind, bool) {
+	if rs == nil {
+		return transformableKind{}, false
+	}
+	kind, found := rs.kinds[groupKind]
+	return kind, found
+}
+
+// parseFieldPath parses paths like `.spec.containers.*.image` or `.spec.containers[*].image`.
+func parseFieldPath(p string) (fieldPath, error) {
+	normalized := strings.NewReplacer("[", ".", "]", "").Replace(p)
+	if !strings.HasPrefix(normalized, ".") || len(normalized) == 1 {
+		return nil, fmt.Errorf("invalid field path %q in resourceSelector, it should look like `.spec.containers.*.image`", p)
+	}
+
+	path := fieldPath(strings.Split(normalized[1:], "."))
+	for _, segment := range path {
+		if segment == "" {
+			return nil, fmt.Errorf("invalid field path %q in resourceSelector, it contains an empty segment", p)
+		}
+	}
+	return path, nil
+}
+
 // FieldVisitor represents the aggregation/transformation that should be performed on each traversed field.
 type FieldVisitor interface {
 	// Visit is called for each transformable key contained in the object and may apply transformations/aggregations on it.
@@ -49,7 +138,8 @@ type FieldVisitor interface {
 }
 
 // Visit recursively visits all transformable object fields within the manifests and lets the visitor apply transformations/aggregations on them.
-func (l *ManifestList) Visit(visitor FieldVisitor) (ManifestList, error) {
+// The fields of the custom resources are visited as configured by `rs`.
+func (l *ManifestList) Visit(visitor FieldVisitor, rs *ResourceSelector) (ManifestList, error) {
 	var updated ManifestList
 
 	for _, manifest := range *l {
@@ -62,7 +152,7 @@ func (l *ManifestList) Visit(visitor FieldVisitor) (ManifestList, error) {
 			continue
 		}
 
-		traverseManifestFields(m, visitor)
+		traverseManifestFields(m, visitor, rs)
 
 		updatedManifest, err := yaml.Marshal(m)
 		if err != nil {
@@ -76,20 +166,36 @@ func (l *ManifestList) Visit(visitor FieldVisitor) (ManifestList, error) {
 }
 
 // traverseManifest traverses all transformable fields contained within the manifest.
-func traverseManifestFields(manifest map[string]interface{}, visitor FieldVisitor) {
-	if shouldTransformManifest(manifest) {
-		visitor = &recursiveVisitorDecorator{visitor}
+func traverseManifestFields(manifest map[string]interface{}, visitor FieldVisitor, rs *ResourceSelector) {
+	groupKind, ok := manifestGroupKind(manifest)
+	if !ok {
+		visitFields(manifest, visitor)
+		return
 	}
+
+	kind, found := rs.kind(groupKind)
+	if transformableAllowlist[groupKind] || (found && kind.recursive) {
+		visitFields(manifest, &recursiveVisitorDecorator{visitor})
+		return
+	}
+
 	visitFields(manifest, visitor)
+	// The fields at the end of the paths are visited as `image` and `metadata` fields, whatever their name.
+	for _, path := range kind.images {
+		visitPath(manifest, path, &renamingVisitorDecorator{visitor, "image"})
+	}
+	for _, path := range kind.labels {
+		visitPath(manifest, path, &renamingVisitorDecorator{visitor, "metadata"})
+	}
 }
 
-func shouldTransformManifest(manifest map[string]interface{}) bool {
+func manifestGroupKind(manifest map[string]interface{}) (apimachinery.GroupKind, bool) {
 	var apiVersion string
 	switch value := manifest["apiVersion"].(type) {
 	case string:
 		apiVersion = value
 	default:
-		return false
+		return apimachinery.GroupKind{}, false
 	}
 
 	var kind string
@@ -97,16 +203,11 @@ func shouldTransformManifest(manifest map[string]interface{}) bool {
 	case string:
 		kind = value
 	default:
-		return false
+		return apimachinery.GroupKind{}, false
 	}
 
 	gvk := apimachinery.FromAPIVersionAndKind(apiVersion, kind)
-	groupKind := apimachinery.GroupKind{
-		Group: gvk.Group,
-		Kind:  gvk.Kind,
-	}
-
-	return transformableAllowlist[groupKind]
+	return gvk.GroupKind(), true
 }
 
 // recursiveVisitorDecorator adds recursion to a FieldVisitor.
@@ -121,6 +222,20 @@ func (d *recursiveVisitorDecorator) Visit(o map[string]interface{}, k string, v
 	return false
 }
 
+// renamingVisitorDecorator calls a FieldVisitor as if the visited field was named `key`.
+// Changes made by the visitor to the field are copied back to the visited object.
+type renamingVisitorDecorator struct {
+	delegate FieldVisitor
+	key      string
+}
+
+func (d *renamingVisitorDecorator) Visit(o map[string]interface{}, k string, v interface{}) bool {
+	renamed := map[string]interface{}{d.key: v}
+	d.delegate.Visit(renamed, d.key, v)
+	o[k] = renamed[d.key]
+	return false
+}
+
 // visitFields traverses all fields and calls the visitor for each.
 func visitFields(o interface{}, visitor FieldVisitor) {
 	switch entries := o.(type) {
@@ -134,3 +249,89 @@ func visitFields(o interface{}, visitor FieldVisitor) {
 		}
 	}
 }
+
+// visitPath calls the visitor for each field matching the path.
+// List items are visited as fields of an object holding only them, whose changes are copied back to the list.
+func visitPath(o interface{}, path fieldPath, visitor FieldVisitor) {
+	switch entries := o.(type) {
+	case []interface{}:
+		for i, v := range entries {
+			if path[0] != "*" && path[0] != strconv.Itoa(i) {
+				continue
+			}
+			if len(path) == 1 {
+				item := map[string]interface{}{path[0]: v}
+				visitor.Visit(item, path[0], v)
+				entries[i] = item[path[0]]
+			} else {
+				visitPath(v, path[1:], visitor)
+			}
+		}
+	case map[string]interface{}:
+		for k, v := range entries {
+			if path[0] != "*" && path[0] != k {
+				continue
+			}
+			if len(path) == 1 {
+				visitor.Visit(entries, k, v)
+			} else {
+				visitPath(v, path[1:], visitor)
+			}
+		}
+	}
+}
+
+// PodTemplate is a pod spec found in a custom resource, along with the metadata next to it.
+type PodTemplate struct {
+	Metadata map[string]interface{}
+	Spec     map[string]interface{}
+}
+
+// podTemplateFinder is a FieldVisitor that collects the pod specs at the end of a path.
+type podTemplateFinder struct {
+	templates []PodTemplate
+}
+
+func (f *podTemplateFinder) Visit(o map[string]interface{}, k string, v interface{}) bool {
+	spec, ok := v.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	metadata, ok := o["metadata"].(map[string]interface{})
+	if !ok {
+		metadata = map[string]interface{}{}
+		o["metadata"] = metadata
+	}
+	f.templates = append(f.templates, PodTemplate{Metadata: metadata, Spec: spec})
+	return false
+}
+
+// CustomResourcePodTemplates finds the pod specs of a custom resource whose image paths are configured
+// in `rs`. A pod spec is the object holding the `containers` or `initContainers`
+// an image path points to, and its metadata is the `metadata` field next to it.
+func CustomResourcePodTemplates(manifest map[string]interface{}, rs *ResourceSelector) []PodTemplate {
+	groupKind, ok := manifestGroupKind(manifest)
+	if !ok {
+		return nil
+	}
+
+	finder := &podTemplateFinder{}
+	seen := map[string]bool{}
+	kind, _ := rs.kind(groupKind)
+	for _, path := range kind.images {
+		for i := 1; i < len(path); i++ {
+			if path[i] != "containers" && path[i] != "initContainers" {
+				continue
+			}
+
+			specPath := path[:i]
+			if key := strings.Join(specPath, "."); !seen[key] {
+				seen[key] = true
+				visitPath(manifest, specPath, finder)
+			}
+			break
+		}
+	}
+	return finder.templates
+}
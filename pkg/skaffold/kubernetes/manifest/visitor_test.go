@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -248,7 +249,7 @@ spec:
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			visitor := &mockVisitor{map[string]int{}, test.pivotKey, test.replaceWith}
-			actual, err := test.manifests.Visit(visitor)
+			actual, err := test.manifests.Visit(visitor, nil)
 			expectedVisits := map[string]int{}
 			for _, visit := range test.expected {
 				expectedVisits[visit]++
@@ -264,3 +265,105 @@ spec:
 		})
 	}
 }
+
+func TestVisitCustomResources(t *testing.T) {
+	rollout := ManifestList{[]byte(`apiVersion: argoproj.io/v1alpha1
+kind: Rollout
+metadata:
+  name: app
+spec:
+  template:
+    metadata:
+      name: pod
+    spec:
+      containers:
+      - image: orig
+        name: c1
+      initContainers:
+      - image: init`)}
+
+	tests := []struct {
+		description string
+		filters     []latest.ResourceFilter
+		expected    []string
+	}{
+		{
+			description: "not selected",
+			expected:    []string{"apiVersion=argo...", "kind=Roll...", "metadata=map[...", "spec=map[..."},
+		},
+		{
+			description: "image and label paths",
+			filters: []latest.ResourceFilter{{
+				GroupKind: "Rollout.argoproj.io",
+				Image:     []string{".spec.template.spec.containers.*.image", ".spec.template.spec.initContainers[0].image"},
+				Labels:    []string{".spec.template.metadata"},
+			}},
+			expected: []string{"apiVersion=argo...", "kind=Roll...", "metadata=map[...", "spec=map[...",
+				"image=orig", "image=init", "metadata=map[..."},
+		},
+		{
+			description: "paths to fields with other names",
+			filters: []latest.ResourceFilter{{
+				GroupKind: "Rollout.argoproj.io",
+				Image:     []string{".spec.template.spec.containers.*.name"},
+				Labels:    []string{".spec.template"},
+			}},
+			expected: []string{"apiVersion=argo...", "kind=Roll...", "metadata=map[...", "spec=map[...",
+				"image=c1", "metadata=map[..."},
+		},
+		{
+			description: "recursive",
+			filters:     []latest.ResourceFilter{{GroupKind: "Rollout.argoproj.io", Recursive: true}},
+			expected: []string{"apiVersion=argo...", "kind=Roll...", "metadata=map[...", "name=app",
+				"spec=map[...", "template=map[...", "metadata=map[...", "name=pod", "spec=map[...",
+				"containers=[map...", "image=orig", "name=c1", "initContainers=[map...", "image=init"},
+		},
+		{
+			description: "other group",
+			filters:     []latest.ResourceFilter{{GroupKind: "Rollout.example.com", Recursive: true}},
+			expected:    []string{"apiVersion=argo...", "kind=Roll...", "metadata=map[...", "spec=map[..."},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			rs, err := NewResourceSelector(test.filters)
+			t.CheckNoError(err)
+
+			visitor := &mockVisitor{map[string]int{}, "", nil}
+			_, err = rollout.Visit(visitor, rs)
+
+			expectedVisits := map[string]int{}
+			for _, visit := range test.expected {
+				expectedVisits[visit]++
+			}
+			t.CheckErrorAndDeepEqual(false, err, expectedVisits, visitor.visited)
+		})
+	}
+}
+
+func TestNewResourceSelectorErrors(t *testing.T) {
+	tests := []struct {
+		description string
+		filter      latest.ResourceFilter
+	}{
+		{
+			description: "missing kind",
+			filter:      latest.ResourceFilter{GroupKind: ".argoproj.io"},
+		},
+		{
+			description: "relative path",
+			filter:      latest.ResourceFilter{GroupKind: "Rollout.argoproj.io", Image: []string{"spec.image"}},
+		},
+		{
+			description: "empty segment",
+			filter:      latest.ResourceFilter{GroupKind: "Rollout.argoproj.io", Labels: []string{".spec..metadata"}},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			_, err := NewResourceSelector([]latest.ResourceFilter{test.filter})
+
+			t.CheckError(true, err)
+		})
+	}
+}
@@ -22,13 +22,15 @@ import (
 
 	"github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 
 	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 )
 
 // TopLevelOwnerKey returns a key associated with the top level
-// owner of a Kubernetes resource in the form Kind-Name
-func TopLevelOwnerKey(ctx context.Context, obj metav1.Object, kind string) string {
+// owner of a Kubernetes resource in the form Kind-Name.
+// The owners are looked up in the cluster of the given kube-context, an empty one stands for the active kube-context.
+func TopLevelOwnerKey(ctx context.Context, kubeContext string, obj metav1.Object, kind string) string {
 	for {
 		or := obj.GetOwnerReferences()
 		if or == nil {
@@ -36,7 +38,7 @@ func TopLevelOwnerKey(ctx context.Context, obj metav1.Object, kind string) strin
 		}
 		var err error
 		kind = or[0].Kind
-		obj, err = ownerMetaObject(ctx, obj.GetNamespace(), or[0])
+		obj, err = ownerMetaObject(ctx, kubeContext, obj.GetNamespace(), or[0])
 		if err != nil {
 			logrus.Warnf("unable to get owner from reference: %v", or[0])
 			return ""
@@ -44,12 +46,17 @@ func TopLevelOwnerKey(ctx context.Context, obj metav1.Object, kind string) strin
 	}
 }
 
-func ownerMetaObject(ctx context.Context, ns string, owner metav1.OwnerReference) (metav1.Object, error) {
-	client, err := kubernetesclient.Client()
+func ownerMetaObject(ctx context.Context, kubeContext, ns string, owner metav1.OwnerReference) (metav1.Object, error) {
+	client, err := kubernetesclient.ClientForContext(kubeContext)
 	if err != nil {
 		return nil, err
 	}
 
+	return getOwner(ctx, client, ns, owner)
+}
+
+// getOwner gets the resource referenced by an owner reference.
+func getOwner(ctx context.Context, client kubernetes.Interface, ns string, owner metav1.OwnerReference) (metav1.Object, error) {
 	switch owner.Kind {
 	case "Deployment":
 		return client.AppsV1().Deployments(ns).Get(ctx, owner.Name, metav1.GetOptions{})
@@ -28,7 +28,7 @@ import (
 )
 
 var (
-	portForwardEvent = func(entry *portForwardEntry) {
+	portForwardEvent = func(entry *portForwardEntry, kubeContext string) {
 		// TODO priyawadhwa@, change event API to accept ports of type int
 		event.PortForwarded(
 			int32(entry.localPort),
@@ -39,7 +39,8 @@ var (
 			entry.portName,
 			string(entry.resource.Type),
 			entry.resource.Name,
-			entry.resource.Address)
+			entry.resource.Address,
+			kubeContext)
 	}
 )
 
@@ -88,10 +89,14 @@ type EntryManager struct {
 	entryForwarder EntryForwarder
 
 	// forwardedPorts serves as a synchronized set of ports we've forwarded.
-	forwardedPorts util.PortSet
+	// It's shared by the entry managers of all the clusters.
+	forwardedPorts *util.PortSet
 
 	// forwardedResources is a map of portForwardEntry key (string) -> portForwardEntry
 	forwardedResources forwardedResources
+
+	// kubeContext is the kube-context of the cluster when Skaffold deploys to several clusters.
+	kubeContext string
 }
 
 // NewEntryManager returns a new port forward entry manager to keep track
@@ -100,6 +105,7 @@ func NewEntryManager(out io.Writer, entryForwarder EntryForwarder) *EntryManager
 	return &EntryManager{
 		output:         out,
 		entryForwarder: entryForwarder,
+		forwardedPorts: &util.PortSet{},
 	}
 }
 
@@ -111,9 +117,14 @@ func (b *EntryManager) forwardPortForwardEntry(ctx context.Context, entry *portF
 	b.forwardedResources.Store(entry.key(), entry)
 
 	if err := b.entryForwarder.Forward(ctx, entry); err == nil {
+		var prefix string
+		if b.kubeContext != "" {
+			prefix = fmt.Sprintf("[%s] ", b.kubeContext)
+		}
 		color.Green.Fprintln(
 			b.output,
-			fmt.Sprintf("Port forwarding %s/%s in namespace %s, remote port %s -> address %s port %d",
+			fmt.Sprintf("%sPort forwarding %s/%s in namespace %s, remote port %s -> address %s port %d",
+				prefix,
 				entry.resource.Type,
 				entry.resource.Name,
 				entry.resource.Namespace,
@@ -123,7 +134,7 @@ func (b *EntryManager) forwardPortForwardEntry(ctx context.Context, entry *portF
 	} else {
 		color.Red.Fprintln(b.output, err)
 	}
-	portForwardEvent(entry)
+	portForwardEvent(entry, b.kubeContext)
 }
 
 // Stop terminates all kubectl port-forward commands.
@@ -34,12 +34,18 @@ type Forwarder interface {
 
 // ForwarderManager manages all forwarders
 type ForwarderManager struct {
-	forwarders []Forwarder
+	forwarders    []Forwarder
+	entryManagers []*EntryManager
 }
 
-// NewForwarderManager returns a new port manager which handles starting and stopping port forwarding
-func NewForwarderManager(out io.Writer, cli *kubectl.CLI, podSelector kubernetes.PodSelector, namespaces []string, label string, opts config.PortForwardOptions, userDefined []*latest.PortForwardResource) *ForwarderManager {
-	entryManager := NewEntryManager(out, NewKubectlForwarder(out, cli))
+// NewForwarderManager returns a new port manager which handles starting and stopping port forwarding.
+// `kubeContext` is the kube-context of the cluster to forward ports from when Skaffold deploys to several clusters.
+// It's empty otherwise, and the active kube-context is used.
+func NewForwarderManager(out io.Writer, cli *kubectl.CLI, podSelector kubernetes.PodSelector, namespaces []string, label string, opts config.PortForwardOptions, userDefined []*latest.PortForwardResource, kubeContext string) *ForwarderManager {
+	kubectlForwarder := NewKubectlForwarder(out, cli)
+	kubectlForwarder.kubeContext = kubeContext
+	entryManager := NewEntryManager(out, kubectlForwarder)
+	entryManager.kubeContext = kubeContext
 
 	var forwarders []Forwarder
 	forwarders = append(forwarders, NewResourceForwarder(entryManager, namespaces, label, userDefined))
@@ -48,10 +54,25 @@ func NewForwarderManager(out io.Writer, cli *kubectl.CLI, podSelector kubernetes
 	}
 
 	return &ForwarderManager{
-		forwarders: forwarders,
+		forwarders:    forwarders,
+		entryManagers: []*EntryManager{entryManager},
 	}
 }
 
+// Add adds the forwarders of another ForwarderManager, for another cluster.
+// Local ports are then allocated from the same set, so that they don't overlap.
+func (p *ForwarderManager) Add(other *ForwarderManager) {
+	if p == nil || other == nil {
+		return
+	}
+
+	for _, em := range other.entryManagers {
+		em.forwardedPorts = p.entryManagers[0].forwardedPorts
+	}
+	p.forwarders = append(p.forwarders, other.forwarders...)
+	p.entryManagers = append(p.entryManagers, other.entryManagers...)
+}
+
 // Start begins all forwarders managed by the ForwarderManager
 func (p *ForwarderManager) Start(ctx context.Context) error {
 	// Port forwarding is not enabled.
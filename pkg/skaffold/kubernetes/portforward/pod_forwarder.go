@@ -50,7 +50,7 @@ type WatchingPodForwarder struct {
 func NewWatchingPodForwarder(entryManager *EntryManager, podSelector kubernetes.PodSelector, namespaces []string) *WatchingPodForwarder {
 	return &WatchingPodForwarder{
 		entryManager: entryManager,
-		podWatcher:   newPodWatcher(podSelector, namespaces),
+		podWatcher:   newPodWatcher(podSelector, namespaces, entryManager.kubeContext),
 		events:       make(chan kubernetes.PodEvent),
 	}
 }
@@ -94,7 +94,7 @@ func (p *WatchingPodForwarder) Stop() {
 }
 
 func (p *WatchingPodForwarder) portForwardPod(ctx context.Context, pod *v1.Pod) error {
-	ownerReference := topLevelOwnerKey(ctx, pod, pod.Kind)
+	ownerReference := topLevelOwnerKey(ctx, p.entryManager.kubeContext, pod, pod.Kind)
 	for _, c := range pod.Spec.Containers {
 		for _, port := range c.Ports {
 			// get current entry for this container
@@ -142,7 +142,7 @@ func (p *WatchingPodForwarder) podForwardingEntry(resourceVersion, containerName
 	}
 
 	// retrieve an open port on the host
-	entry.localPort = retrieveAvailablePort(resource.Address, resource.Port.IntVal, &p.entryManager.forwardedPorts)
+	entry.localPort = retrieveAvailablePort(resource.Address, resource.Port.IntVal, p.entryManager.forwardedPorts)
 
 	return entry, nil
 }
@@ -76,7 +76,7 @@ func NewResourceForwarder(entryManager *EntryManager, namespaces []string, label
 // Start gets a list of services deployed by skaffold as []latest.PortForwardResource and
 // forwards them.
 func (p *ResourceForwarder) Start(ctx context.Context) error {
-	serviceResources, err := retrieveServices(ctx, p.label, p.namespaces)
+	serviceResources, err := retrieveServices(ctx, p.entryManager.kubeContext, p.label, p.namespaces)
 	if err != nil {
 		return fmt.Errorf("retrieving services for automatic port forwarding: %w", err)
 	}
@@ -116,14 +116,14 @@ func (p *ResourceForwarder) getCurrentEntry(resource latest.PortForwardResource)
 	}
 
 	// retrieve an open port on the host
-	entry.localPort = retrieveAvailablePort(resource.Address, resource.LocalPort, &p.entryManager.forwardedPorts)
+	entry.localPort = retrieveAvailablePort(resource.Address, resource.LocalPort, p.entryManager.forwardedPorts)
 	return entry
 }
 
-// retrieveServiceResources retrieves all services in the cluster matching the given label
+// retrieveServiceResources retrieves all services in the cluster of `kubeContext` matching the given label
 // as a list of PortForwardResources
-func retrieveServiceResources(ctx context.Context, label string, namespaces []string) ([]*latest.PortForwardResource, error) {
-	client, err := kubernetesclient.Client()
+func retrieveServiceResources(ctx context.Context, kubeContext, label string, namespaces []string) ([]*latest.PortForwardResource, error) {
+	client, err := kubernetesclient.ClientForContext(kubeContext)
 	if err != nil {
 		return nil, fmt.Errorf("getting Kubernetes client: %w", err)
 	}
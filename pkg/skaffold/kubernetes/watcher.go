@@ -38,6 +38,7 @@ type PodWatcher interface {
 type podWatcher struct {
 	podSelector PodSelector
 	namespaces  []string
+	kubeContext string
 	receivers   []chan<- PodEvent
 }
 
@@ -46,10 +47,13 @@ type PodEvent struct {
 	Pod  *v1.Pod
 }
 
-func NewPodWatcher(podSelector PodSelector, namespaces []string) PodWatcher {
+// NewPodWatcher returns a watcher for the pods in `namespaces`, selected by `podSelector`.
+// `kubeContext` is the kube-context of the cluster to watch, or empty for the active kube-context.
+func NewPodWatcher(podSelector PodSelector, namespaces []string, kubeContext string) PodWatcher {
 	return &podWatcher{
 		podSelector: podSelector,
 		namespaces:  namespaces,
+		kubeContext: kubeContext,
 	}
 }
 
@@ -69,7 +73,7 @@ func (w *podWatcher) Start() (func(), error) {
 		}
 	}
 
-	kubeclient, err := client.Client()
+	kubeclient, err := client.ClientForContext(w.kubeContext)
 	if err != nil {
 		return func() {}, fmt.Errorf("getting k8s client: %w", err)
 	}
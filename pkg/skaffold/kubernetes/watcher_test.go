@@ -60,7 +60,7 @@ func (h *hasName) Select(pod *v1.Pod) bool {
 
 func TestPodWatcher(t *testing.T) {
 	testutil.Run(t, "need to register first", func(t *testutil.T) {
-		watcher := NewPodWatcher(&anyPod{}, []string{"ns"})
+		watcher := NewPodWatcher(&anyPod{}, []string{"ns"}, "")
 		cleanup, err := watcher.Start()
 		defer cleanup()
 
@@ -70,7 +70,7 @@ func TestPodWatcher(t *testing.T) {
 	testutil.Run(t, "fail to get client", func(t *testutil.T) {
 		t.Override(&client.Client, func() (kubernetes.Interface, error) { return nil, errors.New("unable to get client") })
 
-		watcher := NewPodWatcher(&anyPod{}, []string{"ns"})
+		watcher := NewPodWatcher(&anyPod{}, []string{"ns"}, "")
 		watcher.Register(make(chan PodEvent))
 		cleanup, err := watcher.Start()
 		defer cleanup()
@@ -86,7 +86,7 @@ func TestPodWatcher(t *testing.T) {
 			return true, nil, errors.New("unable to watch")
 		})
 
-		watcher := NewPodWatcher(&anyPod{}, []string{"ns"})
+		watcher := NewPodWatcher(&anyPod{}, []string{"ns"}, "")
 		watcher.Register(make(chan PodEvent))
 		cleanup, err := watcher.Start()
 		defer cleanup()
@@ -102,7 +102,7 @@ func TestPodWatcher(t *testing.T) {
 			validNames: []string{"pod1", "pod2", "pod3"},
 		}
 		events := make(chan PodEvent)
-		watcher := NewPodWatcher(podSelector, []string{"ns1", "ns2"})
+		watcher := NewPodWatcher(podSelector, []string{"ns1", "ns2"}, "")
 		watcher.Register(events)
 		cleanup, err := watcher.Start()
 		defer cleanup()
@@ -0,0 +1,73 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remoteconfig
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// SyncArchive downloads the target archive into skaffold's local cache and returns the path to its root directory.
+var SyncArchive = syncArchive
+
+// for testing
+var download = util.DownloadWithContext
+
+func syncArchive(ctx context.Context, a latest.ArchiveInfo, opts config.SkaffoldOptions) (string, error) {
+	checksum := strings.ToLower(a.SHA256)
+	dir, metadataFile, err := cachePaths(opts, archiveDir, a.URL, checksum)
+	if err != nil {
+		return "", fmt.Errorf("failed to download archive %s: %w", a.URL, err)
+	}
+
+	// the cache is keyed by checksum, so a cached archive never needs to be downloaded again.
+	cached, err := readMetadata(metadataFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to download archive %s: %w", a.URL, err)
+	}
+	if cached != nil {
+		return dir, nil
+	}
+	if opts.RemoteCacheOnly {
+		return "", fmt.Errorf("failed to download archive %s: archive is not available in the cache and `--remote-cache-only` is set; run once without `--remote-cache-only` to populate the cache", a.URL)
+	}
+
+	logrus.Infof("Downloading config dependency %s", a.URL)
+	buf, err := download(ctx, a.URL)
+	if err != nil {
+		return "", fmt.Errorf("failed to download archive %s: %w", a.URL, err)
+	}
+	sum := sha256.Sum256(buf)
+	if actual := hex.EncodeToString(sum[:]); actual != checksum {
+		return "", fmt.Errorf("failed to verify archive %s: expected sha256 %s but found %s", a.URL, checksum, actual)
+	}
+
+	if err := store(bytes.NewReader(buf), dir, metadataFile, CachedDependency{Type: TypeArchive, Source: a.URL, Resolved: "sha256:" + checksum}); err != nil {
+		return "", fmt.Errorf("failed to download archive %s: %w", a.URL, err)
+	}
+	return dir, nil
+}
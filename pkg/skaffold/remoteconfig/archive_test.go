@@ -0,0 +1,172 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remoteconfig
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestSyncArchive(t *testing.T) {
+	archive := createTar(t, map[string]string{"modules/skaffold.yaml": "apiVersion: skaffold/v2beta13"}, true)
+	sum := sha256.Sum256(archive)
+	checksum := hex.EncodeToString(sum[:])
+
+	tests := []struct {
+		description string
+		sha256      string
+		cached      bool
+		remoteOnly  bool
+		downloadErr error
+		shouldErr   bool
+		downloads   int
+	}{
+		{
+			description: "first download succeeds",
+			sha256:      checksum,
+			downloads:   1,
+		},
+		{
+			description: "cached archive isn't downloaded again",
+			sha256:      checksum,
+			cached:      true,
+			downloads:   1,
+		},
+		{
+			description: "checksum mismatch",
+			sha256:      "0000000000000000000000000000000000000000000000000000000000000000",
+			shouldErr:   true,
+			downloads:   1,
+		},
+		{
+			description: "download fails",
+			sha256:      checksum,
+			downloadErr: errors.New("404"),
+			shouldErr:   true,
+			downloads:   1,
+		},
+		{
+			description: "remote cache only without cached archive",
+			sha256:      checksum,
+			remoteOnly:  true,
+			shouldErr:   true,
+		},
+		{
+			description: "remote cache only with cached archive",
+			sha256:      checksum,
+			cached:      true,
+			remoteOnly:  true,
+			downloads:   1,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			downloads := 0
+			t.Override(&download, func(context.Context, string) ([]byte, error) {
+				downloads++
+				return archive, test.downloadErr
+			})
+			a := latest.ArchiveInfo{URL: "https://example.com/modules.tar.gz", SHA256: test.sha256}
+			opts := config.SkaffoldOptions{RepoCacheDir: t.NewTempDir().Root()}
+			if test.cached {
+				_, err := syncArchive(context.Background(), a, opts)
+				t.CheckNoError(err)
+			}
+
+			opts.RemoteCacheOnly = test.remoteOnly
+			dir, err := syncArchive(context.Background(), a, opts)
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.downloads, downloads)
+			if !test.shouldErr {
+				content, err := ioutil.ReadFile(filepath.Join(dir, "modules", "skaffold.yaml"))
+				t.CheckNoError(err)
+				t.CheckDeepEqual("apiVersion: skaffold/v2beta13", string(content))
+			}
+		})
+	}
+}
+
+func TestExtractTarRejectsEscapingEntries(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		archive := createTar(t.T, map[string]string{"../skaffold.yaml": ""}, false)
+		err := extractTar(bytes.NewReader(archive), t.NewTempDir().Root())
+		t.CheckErrorContains("outside of the extraction directory", err)
+	})
+}
+
+func TestList(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		archive := createTar(t.T, map[string]string{"skaffold.yaml": ""}, false)
+		sum := sha256.Sum256(archive)
+		t.Override(&download, func(context.Context, string) ([]byte, error) { return archive, nil })
+		opts := config.SkaffoldOptions{RepoCacheDir: t.NewTempDir().Root()}
+
+		dir, err := syncArchive(context.Background(), latest.ArchiveInfo{URL: "https://example.com/modules.tar", SHA256: hex.EncodeToString(sum[:])}, opts)
+		t.CheckNoError(err)
+
+		deps, err := List(opts)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(1, len(deps))
+		t.CheckDeepEqual(CachedDependency{
+			Type:      TypeArchive,
+			Source:    "https://example.com/modules.tar",
+			Resolved:  "sha256:" + hex.EncodeToString(sum[:]),
+			Dir:       dir,
+			FetchedAt: deps[0].FetchedAt,
+		}, deps[0])
+	})
+}
+
+func createTar(t *testing.T, files map[string]string, gzipped bool) []byte {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	var tw *tar.Writer
+	if gzipped {
+		tw = tar.NewWriter(gw)
+	} else {
+		tw = tar.NewWriter(&buf)
+	}
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if gzipped {
+		if err := gw.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return buf.Bytes()
+}
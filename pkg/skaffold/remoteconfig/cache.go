@@ -0,0 +1,205 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remoteconfig
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+)
+
+const (
+	// TypeOCI identifies config dependencies pulled from OCI artifacts.
+	TypeOCI = "oci"
+	// TypeArchive identifies config dependencies downloaded as archives.
+	TypeArchive = "archive"
+
+	ociDir     = "oci"
+	archiveDir = "archives"
+)
+
+// for testing
+var now = time.Now
+
+// CachedDependency describes a remote config dependency stored in skaffold's cache.
+type CachedDependency struct {
+	// Type is either `oci` or `archive`.
+	Type string `json:"type"`
+	// Source is the image reference or URL the dependency was fetched from.
+	Source string `json:"source"`
+	// Resolved is the content digest of the fetched dependency.
+	Resolved string `json:"resolved"`
+	// Dir is the cache directory the dependency was extracted to.
+	Dir string `json:"dir"`
+	// FetchedAt is the time the dependency was last fetched.
+	FetchedAt time.Time `json:"fetchedAt"`
+}
+
+// List returns all the OCI artifacts and archives in skaffold's remote config cache.
+func List(opts config.SkaffoldOptions) ([]CachedDependency, error) {
+	root, err := config.GetRepoCacheDir(opts)
+	if err != nil {
+		return nil, err
+	}
+	var deps []CachedDependency
+	for _, kind := range []string{ociDir, archiveDir} {
+		files, err := filepath.Glob(filepath.Join(root, kind, "*.json"))
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			d, err := readMetadata(f)
+			if err != nil {
+				return nil, err
+			}
+			deps = append(deps, *d)
+		}
+	}
+	sort.Slice(deps, func(i, j int) bool { return deps[i].Source < deps[j].Source })
+	return deps, nil
+}
+
+// cachePaths returns the extraction directory and the metadata file for a dependency identified by `inputs`.
+func cachePaths(opts config.SkaffoldOptions, kind string, inputs ...string) (string, string, error) {
+	root, err := config.GetRepoCacheDir(opts)
+	if err != nil {
+		return "", "", err
+	}
+	hasher := sha256.New()
+	if err := json.NewEncoder(hasher).Encode(inputs); err != nil {
+		return "", "", err
+	}
+	hash := base64.URLEncoding.EncodeToString(hasher.Sum(nil))[:32]
+	dir := filepath.Join(root, kind, hash)
+	return dir, dir + ".json", nil
+}
+
+// readMetadata reads the metadata of a cached dependency. It returns `nil` if the dependency isn't cached.
+func readMetadata(file string) (*CachedDependency, error) {
+	buf, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading cache metadata %s: %w", file, err)
+	}
+	var d CachedDependency
+	if err := json.Unmarshal(buf, &d); err != nil {
+		return nil, fmt.Errorf("parsing cache metadata %s: %w", file, err)
+	}
+	if _, err := os.Stat(d.Dir); err != nil {
+		return nil, nil
+	}
+	return &d, nil
+}
+
+// store extracts the tarball read from `r` into `dir`, replacing any previous content, and records its metadata.
+func store(r io.Reader, dir, metadataFile string, d CachedDependency) error {
+	if err := os.MkdirAll(filepath.Dir(dir), 0700); err != nil {
+		return fmt.Errorf("creating cache directory: %w", err)
+	}
+	tmp, err := ioutil.TempDir(filepath.Dir(dir), ".tmp-")
+	if err != nil {
+		return fmt.Errorf("creating cache directory: %w", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	if err := extractTar(r, tmp); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("removing stale cache directory %s: %w", dir, err)
+	}
+	if err := os.Rename(tmp, dir); err != nil {
+		return fmt.Errorf("moving extracted files to %s: %w", dir, err)
+	}
+
+	d.Dir = dir
+	d.FetchedAt = now()
+	buf, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(metadataFile, buf, 0600)
+}
+
+// extractTar extracts a plain or gzipped tarball into `dest`. Entries escaping `dest` are rejected.
+func extractTar(r io.Reader, dest string) error {
+	br := bufio.NewReader(r)
+	var tr *tar.Reader
+	if magic, err := br.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return fmt.Errorf("reading gzipped archive: %w", err)
+		}
+		defer gz.Close()
+		tr = tar.NewReader(gz)
+	} else {
+		tr = tar.NewReader(br)
+	}
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading archive: %w", err)
+		}
+
+		target := filepath.Join(dest, filepath.FromSlash(hdr.Name))
+		if target != dest && !strings.HasPrefix(target, dest+string(os.PathSeparator)) {
+			return fmt.Errorf("archive entry %q is outside of the extraction directory", hdr.Name)
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.FileMode(hdr.Mode)&0755|0600)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(f, tr)
+			f.Close()
+			if err != nil {
+				return fmt.Errorf("extracting %s: %w", hdr.Name, err)
+			}
+		default:
+			// links and special files aren't needed to resolve skaffold configs.
+			continue
+		}
+	}
+}
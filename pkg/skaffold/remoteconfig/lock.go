@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remoteconfig
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// DefaultLockFile is the name of the lock file written next to the root skaffold configuration.
+const DefaultLockFile = "skaffold.lock"
+
+// ResolvedDigest returns the digest of the OCI artifact or archive cached in `dir`.
+var ResolvedDigest = resolvedDigest
+
+// Lock records the exact versions of the remote config dependencies resolved for a project.
+type Lock struct {
+	// Dependencies lists every remote dependency resolved, ordered by source.
+	Dependencies []LockedDependency `yaml:"dependencies"`
+}
+
+// LockedDependency records how a single remote config dependency was resolved.
+type LockedDependency struct {
+	// Type is one of `git`, `oci` or `archive`.
+	Type string `yaml:"type"`
+	// Source is the git repository, OCI artifact reference or archive URL.
+	Source string `yaml:"source"`
+	// Ref is the git ref that was resolved, if any.
+	Ref string `yaml:"ref,omitempty"`
+	// Path is the path to the skaffold configuration within the dependency.
+	Path string `yaml:"path,omitempty"`
+	// Resolved is the git commit or content digest the dependency resolved to.
+	Resolved string `yaml:"resolved"`
+	// ActiveProfiles lists the profiles the dependency configs were activated with.
+	ActiveProfiles []string `yaml:"activeProfiles,omitempty"`
+}
+
+// Key identifies the dependency independently of the version it resolved to.
+func (d LockedDependency) Key() string {
+	return fmt.Sprintf("%s:%s@%s:%s", d.Type, d.Source, d.Ref, d.Path)
+}
+
+// Find returns the locked version of a dependency, or `nil` if it isn't part of the lock.
+func (l *Lock) Find(d LockedDependency) *LockedDependency {
+	for i := range l.Dependencies {
+		if l.Dependencies[i].Key() == d.Key() {
+			return &l.Dependencies[i]
+		}
+	}
+	return nil
+}
+
+// Add records a resolved dependency, merging the active profiles of dependencies imported more than once.
+func (l *Lock) Add(d LockedDependency) {
+	if existing := l.Find(d); existing != nil {
+		for _, p := range d.ActiveProfiles {
+			if !util.StrSliceContains(existing.ActiveProfiles, p) {
+				existing.ActiveProfiles = append(existing.ActiveProfiles, p)
+			}
+		}
+		sort.Strings(existing.ActiveProfiles)
+		return
+	}
+	sort.Strings(d.ActiveProfiles)
+	l.Dependencies = append(l.Dependencies, d)
+	sort.Slice(l.Dependencies, func(i, j int) bool { return l.Dependencies[i].Key() < l.Dependencies[j].Key() })
+}
+
+// LockFile returns the path of the lock file for the current project, or an empty string when the configuration is read from a URL.
+func LockFile(opts config.SkaffoldOptions) string {
+	if opts.LockFile != "" {
+		return opts.LockFile
+	}
+	if util.IsURL(opts.ConfigurationFile) {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(opts.ConfigurationFile), DefaultLockFile)
+}
+
+// ReadLock reads the lock file. It returns `nil` if the lock file doesn't exist.
+func ReadLock(file string) (*Lock, error) {
+	if file == "" {
+		return nil, nil
+	}
+	buf, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading lock file %s: %w", file, err)
+	}
+	var l Lock
+	if err := yaml.UnmarshalStrict(buf, &l); err != nil {
+		return nil, fmt.Errorf("parsing lock file %s: %w", file, err)
+	}
+	return &l, nil
+}
+
+// WriteLock writes the lock file.
+func WriteLock(file string, l *Lock) error {
+	buf, err := yaml.Marshal(l)
+	if err != nil {
+		return fmt.Errorf("marshalling lock file: %w", err)
+	}
+	return ioutil.WriteFile(file, buf, 0644)
+}
+
+func resolvedDigest(dir string) (string, error) {
+	d, err := readMetadata(dir + ".json")
+	if err != nil {
+		return "", err
+	}
+	if d == nil {
+		return "", fmt.Errorf("no cached dependency found in %s", dir)
+	}
+	return d.Resolved, nil
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remoteconfig
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestLockAdd(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		l := &Lock{}
+		l.Add(LockedDependency{Type: "oci", Source: "gcr.io/foo/modules:v1", Resolved: "sha256:123", ActiveProfiles: []string{"p2"}})
+		l.Add(LockedDependency{Type: "git", Source: "https://github.com/foo.git", Ref: "main", Resolved: "abc"})
+		l.Add(LockedDependency{Type: "oci", Source: "gcr.io/foo/modules:v1", Resolved: "sha256:123", ActiveProfiles: []string{"p1", "p2"}})
+
+		t.CheckDeepEqual(&Lock{Dependencies: []LockedDependency{
+			{Type: "git", Source: "https://github.com/foo.git", Ref: "main", Resolved: "abc"},
+			{Type: "oci", Source: "gcr.io/foo/modules:v1", Resolved: "sha256:123", ActiveProfiles: []string{"p1", "p2"}},
+		}}, l)
+		t.CheckNotNil(l.Find(LockedDependency{Type: "git", Source: "https://github.com/foo.git", Ref: "main"}))
+		t.CheckNil(l.Find(LockedDependency{Type: "git", Source: "https://github.com/foo.git", Ref: "master"}))
+	})
+}
+
+func TestReadWriteLock(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir()
+		file := LockFile(config.SkaffoldOptions{ConfigurationFile: tmpDir.Path("skaffold.yaml")})
+		t.CheckDeepEqual(tmpDir.Path("skaffold.lock"), file)
+
+		missing, err := ReadLock(file)
+		t.CheckNoError(err)
+		t.CheckNil(missing)
+
+		l := &Lock{Dependencies: []LockedDependency{{Type: "archive", Source: "https://example.com/modules.tgz", Path: "skaffold.yaml", Resolved: "sha256:456"}}}
+		t.CheckNoError(WriteLock(file, l))
+		read, err := ReadLock(file)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(l, read)
+	})
+}
+
+func TestLockFileForURL(t *testing.T) {
+	testutil.CheckDeepEqual(t, "", LockFile(config.SkaffoldOptions{ConfigurationFile: "https://example.com/skaffold.yaml"}))
+	testutil.CheckDeepEqual(t, "custom.lock", LockFile(config.SkaffoldOptions{ConfigurationFile: "https://example.com/skaffold.yaml", LockFile: "custom.lock"}))
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remoteconfig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// SyncOCIArtifact pulls the target OCI artifact into skaffold's local cache and returns the path to its root directory.
+var SyncOCIArtifact = syncOCIArtifact
+
+// for testing
+var (
+	remoteHead  = remote.Head
+	remoteImage = remote.Image
+)
+
+func syncOCIArtifact(ctx context.Context, a latest.OCIArtifactInfo, opts config.SkaffoldOptions) (string, error) {
+	dir, metadataFile, err := cachePaths(opts, ociDir, a.Image)
+	if err != nil {
+		return "", fmt.Errorf("failed to pull artifact %s: %w", a.Image, err)
+	}
+	cached, err := readMetadata(metadataFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to pull artifact %s: %w", a.Image, err)
+	}
+
+	if opts.RemoteCacheOnly {
+		if cached == nil {
+			return "", fmt.Errorf("failed to pull artifact %s: artifact is not available in the cache and `--remote-cache-only` is set; run once without `--remote-cache-only` to populate the cache", a.Image)
+		}
+		if a.Digest != "" && cached.Resolved != a.Digest {
+			return "", fmt.Errorf("failed to verify artifact %s: expected digest %s but the cache contains %s", a.Image, a.Digest, cached.Resolved)
+		}
+		return dir, nil
+	}
+
+	ref, err := name.ParseReference(a.Image)
+	if err != nil {
+		return "", fmt.Errorf("failed to pull artifact: parsing reference %q: %w", a.Image, err)
+	}
+
+	// artifacts referenced by digest are immutable, so there's no need to check the registry again.
+	digest := ""
+	if d, ok := ref.(name.Digest); ok {
+		digest = d.DigestStr()
+	} else {
+		desc, err := remoteHead(ref, remote.WithAuthFromKeychain(authn.DefaultKeychain), remote.WithContext(ctx))
+		if err != nil {
+			return "", fmt.Errorf("failed to pull artifact %s: resolving digest: %w", a.Image, err)
+		}
+		digest = desc.Digest.String()
+	}
+	if a.Digest != "" && digest != a.Digest {
+		return "", fmt.Errorf("failed to verify artifact %s: expected digest %s but found %s", a.Image, a.Digest, digest)
+	}
+	if cached != nil && cached.Resolved == digest {
+		return dir, nil
+	}
+
+	logrus.Infof("Pulling config dependency %s", a.Image)
+	img, err := remoteImage(ref.Context().Digest(digest), remote.WithAuthFromKeychain(authn.DefaultKeychain), remote.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("failed to pull artifact %s: %w", a.Image, err)
+	}
+	actual, err := img.Digest()
+	if err != nil {
+		return "", fmt.Errorf("failed to pull artifact %s: computing digest: %w", a.Image, err)
+	}
+	if actual.String() != digest {
+		return "", fmt.Errorf("failed to verify artifact %s: expected digest %s but pulled %s", a.Image, digest, actual)
+	}
+
+	r := mutate.Extract(img)
+	defer r.Close()
+	if err := store(r, dir, metadataFile, CachedDependency{Type: TypeOCI, Source: a.Image, Resolved: digest}); err != nil {
+		return "", fmt.Errorf("failed to pull artifact %s: %w", a.Image, err)
+	}
+	return dir, nil
+}
+
+// PinnedImage returns the reference to the artifact `image` at the given digest.
+func PinnedImage(image, digest string) (string, error) {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return "", fmt.Errorf("parsing reference %q: %w", image, err)
+	}
+	return ref.Context().Digest(digest).String(), nil
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remoteconfig
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestSyncOCIArtifact(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	defer server.Close()
+	u, _ := url.Parse(server.URL)
+
+	v1 := pushArtifact(t, u.Host+"/modules:v1", "v1")
+	pushArtifact(t, u.Host+"/modules:v2", "v2")
+
+	tests := []struct {
+		description string
+		image       string
+		digest      string
+		cached      bool
+		remoteOnly  bool
+		shouldErr   bool
+		expected    string
+	}{
+		{
+			description: "pull by tag",
+			image:       u.Host + "/modules:v1",
+			expected:    "v1",
+		},
+		{
+			description: "pull by digest",
+			image:       u.Host + "/modules@" + v1,
+			expected:    "v1",
+		},
+		{
+			description: "pinned digest matches",
+			image:       u.Host + "/modules:v1",
+			digest:      v1,
+			expected:    "v1",
+		},
+		{
+			description: "pinned digest mismatch",
+			image:       u.Host + "/modules:v2",
+			digest:      v1,
+			shouldErr:   true,
+		},
+		{
+			description: "missing artifact",
+			image:       u.Host + "/modules:v3",
+			shouldErr:   true,
+		},
+		{
+			description: "remote cache only with cached artifact",
+			image:       u.Host + "/modules:v2",
+			cached:      true,
+			remoteOnly:  true,
+			expected:    "v2",
+		},
+		{
+			description: "remote cache only without cached artifact",
+			image:       u.Host + "/modules:v2",
+			remoteOnly:  true,
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			opts := config.SkaffoldOptions{RepoCacheDir: t.NewTempDir().Root()}
+			a := latest.OCIArtifactInfo{Image: test.image, Digest: test.digest}
+			if test.cached {
+				_, err := syncOCIArtifact(context.Background(), a, opts)
+				t.CheckNoError(err)
+			}
+
+			opts.RemoteCacheOnly = test.remoteOnly
+			dir, err := syncOCIArtifact(context.Background(), a, opts)
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				content, err := ioutil.ReadFile(filepath.Join(dir, "skaffold.yaml"))
+				t.CheckNoError(err)
+				t.CheckDeepEqual(test.expected, string(content))
+			}
+		})
+	}
+}
+
+// pushArtifact pushes an artifact containing a single `skaffold.yaml` file and returns its digest.
+func pushArtifact(t *testing.T, image, content string) string {
+	archive := createTar(t, map[string]string{"skaffold.yaml": content}, false)
+	layer, err := tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(archive)), nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := mutate.AppendLayers(empty.Image, layer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := remote.Write(ref, img); err != nil {
+		t.Fatal(err)
+	}
+	digest, err := img.Digest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprint(digest)
+}
@@ -44,7 +44,7 @@ func (r *SkaffoldRunner) Build(ctx context.Context, out io.Writer, artifacts []*
 		return nil, err
 	}
 
-	tags, err := r.imageTags(ctx, out, artifacts)
+	tags, additionalTags, err := r.imageTags(ctx, out, artifacts)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +80,16 @@ func (r *SkaffoldRunner) Build(ctx context.Context, out io.Writer, artifacts []*
 		return nil, err
 	}
 
+	// Additional tags are applied to cached images as well.
+	if err := r.builder.Retag(ctx, out, bRes, additionalTags); err != nil {
+		return nil, fmt.Errorf("applying additional tags: %w", err)
+	}
+
+	bRes, err = r.applyImageReference(ctx, bRes, tags)
+	if err != nil {
+		return nil, err
+	}
+
 	// Update which images are logged.
 	r.addTagsToPodSelector(bRes)
 
@@ -142,8 +152,9 @@ func (r *SkaffoldRunner) addTagsToPodSelector(artifacts []build.Artifact) {
 }
 
 type tagErr struct {
-	tag string
-	err error
+	tag        string
+	additional []string
+	err        error
 }
 
 // ApplyDefaultRepo applies the default repo to a given image tag.
@@ -151,8 +162,8 @@ func (r *SkaffoldRunner) ApplyDefaultRepo(tag string) (string, error) {
 	return deployutil.ApplyDefaultRepo(r.runCtx.GlobalConfig(), r.runCtx.DefaultRepo(), tag)
 }
 
-// imageTags generates tags for a list of artifacts
-func (r *SkaffoldRunner) imageTags(ctx context.Context, out io.Writer, artifacts []*latest.Artifact) (tag.ImageTags, error) {
+// imageTags generates tags for a list of artifacts, along with the additional tags of each artifact.
+func (r *SkaffoldRunner) imageTags(ctx context.Context, out io.Writer, artifacts []*latest.Artifact) (tag.ImageTags, tag.AdditionalTags, error) {
 	start := time.Now()
 	color.Default.Fprintln(out, "Generating tags...")
 
@@ -163,12 +174,18 @@ func (r *SkaffoldRunner) imageTags(ctx context.Context, out io.Writer, artifacts
 
 		i := i
 		go func() {
-			tag, err := tag.GenerateFullyQualifiedImageName(r.tagger, artifacts[i].Workspace, artifacts[i].ImageName)
-			tagErrs[i] <- tagErr{tag: tag, err: err}
+			t, err := tag.GenerateFullyQualifiedImageName(r.tagger, artifacts[i].Workspace, artifacts[i].ImageName)
+			if err != nil {
+				tagErrs[i] <- tagErr{err: err}
+				return
+			}
+			additional, err := tag.GenerateAdditionalImageNames(r.tagger, artifacts[i].Workspace, artifacts[i].ImageName)
+			tagErrs[i] <- tagErr{tag: t, additional: additional, err: err}
 		}()
 	}
 
 	imageTags := make(tag.ImageTags, len(artifacts))
+	additionalTags := make(tag.AdditionalTags)
 	showWarning := false
 
 	for i, artifact := range artifacts {
@@ -177,7 +194,7 @@ func (r *SkaffoldRunner) imageTags(ctx context.Context, out io.Writer, artifacts
 
 		select {
 		case <-ctx.Done():
-			return nil, context.Canceled
+			return nil, nil, context.Canceled
 
 		case t := <-tagErrs[i]:
 			if t.err != nil {
@@ -186,20 +203,41 @@ func (r *SkaffoldRunner) imageTags(ctx context.Context, out io.Writer, artifacts
 
 				fallbackTag, err := tag.GenerateFullyQualifiedImageName(&tag.ChecksumTagger{}, artifact.Workspace, imageName)
 				if err != nil {
-					return nil, fmt.Errorf("generating checksum as fall-back tag for %q: %w", imageName, err)
+					return nil, nil, fmt.Errorf("generating checksum as fall-back tag for %q: %w", imageName, err)
 				}
 
 				t.tag = fallbackTag
+				t.additional = nil
 				showWarning = true
 			}
 
+			configured, err := tag.ExpandAdditionalTags(imageName, artifact.AdditionalTags)
+			if err != nil {
+				return nil, nil, err
+			}
+
 			tag, err := r.ApplyDefaultRepo(t.tag)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 
 			fmt.Fprintln(out, tag)
 			imageTags[imageName] = tag
+
+			seen := map[string]bool{tag: true}
+			for _, additional := range append(t.additional, configured...) {
+				additionalTag, err := r.ApplyDefaultRepo(additional)
+				if err != nil {
+					return nil, nil, err
+				}
+				if seen[additionalTag] {
+					continue
+				}
+				seen[additionalTag] = true
+
+				color.Default.Fprintf(out, "   %s\n", additionalTag)
+				additionalTags[imageName] = append(additionalTags[imageName], additionalTag)
+			}
 		}
 	}
 
@@ -208,7 +246,7 @@ func (r *SkaffoldRunner) imageTags(ctx context.Context, out io.Writer, artifacts
 	}
 
 	logrus.Infoln("Tags generated in", util.ShowHumanizeTime(time.Since(start)))
-	return imageTags, nil
+	return imageTags, additionalTags, nil
 }
 
 func checkWorkspaces(artifacts []*latest.Artifact) error {
@@ -27,6 +27,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -171,6 +172,61 @@ func TestBuildDryRun(t *testing.T) {
 	})
 }
 
+func TestBuildAdditionalTags(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.OSEnviron, func() []string { return []string{"BRANCH=main"} })
+		testBench := &TestBench{}
+		artifacts := []*latest.Artifact{
+			{ImageName: "img1", AdditionalTags: []string{"stable", "{{.BRANCH}}", "latest"}},
+			{ImageName: "img2"},
+		}
+		runner := createRunner(t, testBench, nil, artifacts)
+
+		bRes, err := runner.Build(context.Background(), ioutil.Discard, artifacts)
+
+		t.CheckNoError(err)
+		// The deployed tags are unchanged
+		t.CheckDeepEqual([]build.Artifact{
+			{ImageName: "img1", Tag: "img1:1"},
+			{ImageName: "img2", Tag: "img2:1"}}, bRes)
+		t.CheckDeepEqual([]Actions{{
+			Built:    []string{"img1:1", "img2:1"},
+			Retagged: []string{"img1:stable", "img1:main"},
+		}}, testBench.Actions())
+	})
+}
+
+func TestBuildAdditionalTagsError(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.OSEnviron, func() []string { return nil })
+		testBench := &TestBench{}
+		artifacts := []*latest.Artifact{
+			{ImageName: "img1", AdditionalTags: []string{"{{.BRANCH}}"}},
+		}
+		runner := createRunner(t, testBench, nil, artifacts)
+
+		_, err := runner.Build(context.Background(), ioutil.Discard, artifacts)
+
+		t.CheckError(true, err)
+		t.CheckDeepEqual([]Actions{{}}, testBench.Actions())
+	})
+}
+
+func TestBuildImageReference(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		testBench := &TestBench{}
+		artifacts := []*latest.Artifact{{ImageName: "img1"}}
+		runner := createRunner(t, testBench, nil, artifacts)
+		runner.runCtx.Opts.ImageReference = "tag"
+
+		bRes, err := runner.Build(context.Background(), ioutil.Discard, artifacts)
+
+		t.CheckNoError(err)
+		// The generated tag is used instead of the one returned by the builder
+		t.CheckDeepEqual([]build.Artifact{{ImageName: "img1", Tag: "img1:latest"}}, bRes)
+	})
+}
+
 func TestBuildSkipBuild(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
 		testBench := &TestBench{}
@@ -28,6 +28,8 @@ type changeSet struct {
 	resyncTracker  map[string]*sync.Item
 	needsRedeploy  bool
 	needsReload    bool
+	// deployers to run when not all of them need to run
+	redeployOnly []string
 }
 
 func (c *changeSet) AddRebuild(a *latest.Artifact) {
@@ -55,6 +57,15 @@ func (c *changeSet) AddResync(s *sync.Item) {
 	c.needsResync = append(c.needsResync, s)
 }
 
+func (c *changeSet) AddRedeploy(deployer string) {
+	for _, d := range c.redeployOnly {
+		if d == deployer {
+			return
+		}
+	}
+	c.redeployOnly = append(c.redeployOnly, deployer)
+}
+
 func (c *changeSet) resetBuild() {
 	c.rebuildTracker = make(map[string]*latest.Artifact)
 	c.needsRebuild = nil
@@ -67,4 +78,5 @@ func (c *changeSet) resetSync() {
 
 func (c *changeSet) resetDeploy() {
 	c.needsRedeploy = false
+	c.redeployOnly = nil
 }
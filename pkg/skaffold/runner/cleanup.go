@@ -22,5 +22,8 @@ import (
 )
 
 func (r *SkaffoldRunner) Cleanup(ctx context.Context, out io.Writer) error {
-	return r.deployer.Cleanup(ctx, out)
+	if err := r.deployer.Cleanup(ctx, out); err != nil {
+		return err
+	}
+	return r.deleteEphemeralNamespace(ctx, out)
 }
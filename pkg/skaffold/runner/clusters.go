@@ -0,0 +1,51 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+)
+
+// kubeCluster is a cluster that Skaffold deploys to.
+type kubeCluster struct {
+	// kubeContext labels the output and the events of the cluster when Skaffold deploys to several clusters.
+	// It's empty otherwise, which stands for the active kube-context.
+	kubeContext string
+	runCtx      *runcontext.RunContext
+	kubectlCLI  *kubectl.CLI
+}
+
+// clusters returns the clusters that the pipelines deploy to.
+// There's only one, the active kube-context, unless configs target different kube-contexts.
+func (r *SkaffoldRunner) clusters() []kubeCluster {
+	kubeContexts := r.runCtx.KubeContexts()
+	if len(kubeContexts) <= 1 {
+		return []kubeCluster{{runCtx: r.runCtx, kubectlCLI: r.kubectlCLI}}
+	}
+
+	var clusters []kubeCluster
+	for _, kubeContext := range kubeContexts {
+		runCtx := r.runCtx.ForKubeContext(kubeContext)
+		clusters = append(clusters, kubeCluster{
+			kubeContext: kubeContext,
+			runCtx:      runCtx,
+			kubectlCLI:  kubectl.NewCLI(runCtx, ""),
+		})
+	}
+	return clusters
+}
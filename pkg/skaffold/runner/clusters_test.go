@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestClusters(t *testing.T) {
+	tests := []struct {
+		description     string
+		kubeContexts    []string
+		expectedLabels  []string
+		expectedKubectl []string
+	}{
+		{
+			description:     "single cluster isn't labelled",
+			kubeContexts:    []string{"", "active"},
+			expectedLabels:  []string{""},
+			expectedKubectl: []string{"active"},
+		},
+		{
+			description:     "several clusters",
+			kubeContexts:    []string{"", "other", "active"},
+			expectedLabels:  []string{"active", "other"},
+			expectedKubectl: []string{"active", "other"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var pipelines []latest.Pipeline
+			for _, kubeContext := range test.kubeContexts {
+				pipelines = append(pipelines, latest.Pipeline{Deploy: latest.DeployConfig{KubeContext: kubeContext}})
+			}
+			runCtx := &runcontext.RunContext{
+				Pipelines:   runcontext.NewPipelines(pipelines),
+				KubeContext: "active",
+			}
+			r := &SkaffoldRunner{runCtx: runCtx, kubectlCLI: kubectl.NewCLI(runCtx, "")}
+
+			var labels, kubectlContexts []string
+			for _, c := range r.clusters() {
+				labels = append(labels, c.kubeContext)
+				kubectlContexts = append(kubectlContexts, c.kubectlCLI.KubeContext)
+			}
+
+			t.CheckDeepEqual(test.expectedLabels, labels)
+			t.CheckDeepEqual(test.expectedKubectl, kubectlContexts)
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
 	deployutil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/util"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
@@ -36,10 +37,29 @@ import (
 )
 
 func (r *SkaffoldRunner) Deploy(ctx context.Context, out io.Writer, artifacts []build.Artifact) error {
+	return r.deploy(ctx, out, artifacts, nil)
+}
+
+// deploy deploys the artifacts with the named deployers, or with all of them if no name is given.
+// In render-only mode, the manifests of all the deployers are rendered, since they're written to a single output.
+func (r *SkaffoldRunner) deploy(ctx context.Context, out io.Writer, artifacts []build.Artifact, deployers []string) error {
 	if r.runCtx.RenderOnly() {
 		return r.Render(ctx, out, artifacts, false, r.runCtx.RenderOutput())
 	}
 
+	artifacts, err := r.applyImageReference(ctx, artifacts, nil)
+	if err != nil {
+		return err
+	}
+
+	if len(deployers) == 0 {
+		return r.deployWith(ctx, out, artifacts, r.deployer)
+	}
+	return r.deployWith(ctx, out, artifacts, r.selectDeployers(deployers))
+}
+
+// deployWith deploys the artifacts with the given deployer.
+func (r *SkaffoldRunner) deployWith(ctx context.Context, out io.Writer, artifacts []build.Artifact, deployer deploy.Deployer) error {
 	color.Default.Fprintln(out, "Tags used in deployment:")
 
 	for _, artifact := range artifacts {
@@ -65,8 +85,13 @@ See https://skaffold.dev/docs/pipeline-stages/taggers/#how-tagging-works`)
 	// Check that the cluster is reachable.
 	// This gives a better error message when the cluster can't
 	// be reached.
-	if err := failIfClusterIsNotReachable(); err != nil {
-		return fmt.Errorf("unable to connect to Kubernetes: %w", err)
+	for _, c := range r.clusters() {
+		if err := failIfClusterIsNotReachable(c.kubeContext); err != nil {
+			if c.kubeContext != "" {
+				return fmt.Errorf("unable to connect to Kubernetes with kube-context %q: %w", c.kubeContext, err)
+			}
+			return fmt.Errorf("unable to connect to Kubernetes: %w", err)
+		}
 	}
 
 	if len(localImages) > 0 && r.runCtx.Cluster.LoadImages {
@@ -76,13 +101,20 @@ See https://skaffold.dev/docs/pipeline-stages/taggers/#how-tagging-works`)
 		}
 	}
 
+	r.labeller.StampDeployment(time.Now())
+	r.collectExpiredResources(ctx, out)
+
+	if err := r.createEphemeralNamespace(ctx, out); err != nil {
+		return err
+	}
+
 	deployOut, postDeployFn, err := deployutil.WithLogFile(time.Now().Format(deployutil.TimeFormat)+".log", out, r.runCtx.Muted())
 	if err != nil {
 		return err
 	}
 
 	event.DeployInProgress()
-	namespaces, err := r.deployer.Deploy(ctx, deployOut, artifacts)
+	namespaces, err := deployer.Deploy(ctx, deployOut, artifacts)
 	postDeployFn()
 	if err != nil {
 		event.DeployFailed(err)
@@ -144,8 +176,8 @@ func (r *SkaffoldRunner) getCurrentContext() (*api.Context, error) {
 
 // failIfClusterIsNotReachable checks that Kubernetes is reachable.
 // This gives a clear early error when the cluster can't be reached.
-func failIfClusterIsNotReachable() error {
-	client, err := kubernetesclient.Client()
+func failIfClusterIsNotReachable(kubeContext string) error {
+	client, err := kubernetesclient.ClientForContext(kubeContext)
 	if err != nil {
 		return err
 	}
@@ -160,14 +192,21 @@ func (r *SkaffoldRunner) performStatusCheck(ctx context.Context, out io.Writer)
 		return nil
 	}
 
-	start := time.Now()
-	color.Default.Fprintln(out, "Waiting for deployments to stabilize...")
+	// Clusters are checked one after the other, so that their output doesn't interleave.
+	for _, c := range r.clusters() {
+		start := time.Now()
+		if c.kubeContext != "" {
+			color.Default.Fprintf(out, "Waiting for deployments to stabilize in kube-context %q...\n", c.kubeContext)
+		} else {
+			color.Default.Fprintln(out, "Waiting for deployments to stabilize...")
+		}
+
+		s := newStatusCheck(c.runCtx, r.labeller, c.kubeContext)
+		if err := s.Check(ctx, out); err != nil {
+			return err
+		}
 
-	s := newStatusCheck(r.runCtx, r.labeller)
-	if err := s.Check(ctx, out); err != nil {
-		return err
+		color.Default.Fprintln(out, "Deployments stabilized in", util.ShowHumanizeTime(time.Since(start)))
 	}
-
-	color.Default.Fprintln(out, "Deployments stabilized in", util.ShowHumanizeTime(time.Since(start)))
 	return nil
 }
@@ -69,7 +69,7 @@ func TestDeploy(t *testing.T) {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.SetupFakeKubernetesContext(api.Config{CurrentContext: "cluster1"})
 			t.Override(&client.Client, mockK8sClient)
-			t.Override(&newStatusCheck, func(status.Config, *label.DefaultLabeller) status.Checker {
+			t.Override(&newStatusCheck, func(status.Config, *label.DefaultLabeller, string) status.Checker {
 				return dummyStatusChecker{}
 			})
 
@@ -119,7 +119,7 @@ func TestDeployNamespace(t *testing.T) {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.SetupFakeKubernetesContext(api.Config{CurrentContext: "cluster1"})
 			t.Override(&client.Client, mockK8sClient)
-			t.Override(&newStatusCheck, func(status.Config, *label.DefaultLabeller) status.Checker {
+			t.Override(&newStatusCheck, func(status.Config, *label.DefaultLabeller, string) status.Checker {
 				return dummyStatusChecker{}
 			})
 
@@ -146,7 +146,7 @@ func TestSkaffoldDeployRenderOnly(t *testing.T) {
 			KubeContext: "does-not-exist",
 		}
 
-		deployer, err := getDeployer(runCtx, nil)
+		deployer, _, err := getDeployer(runCtx, nil, nil)
 		t.RequireNoError(err)
 		r := SkaffoldRunner{
 			runCtx:     runCtx,
@@ -156,7 +156,10 @@ func TestSkaffoldDeployRenderOnly(t *testing.T) {
 		var builds []build.Artifact
 
 		err = r.Deploy(context.Background(), ioutil.Discard, builds)
+		t.CheckNoError(err)
 
+		// a single deployer is rendered too
+		err = r.deploy(context.Background(), ioutil.Discard, builds, []string{"kubectl"})
 		t.CheckNoError(err)
 	})
 }
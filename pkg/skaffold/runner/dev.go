@@ -27,11 +27,11 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/instrumentation"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/portforward"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync"
@@ -49,15 +49,44 @@ var (
 	fileSyncSucceeded  = event.FileSyncSucceeded
 )
 
-func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *kubernetes.LogAggregator, forwarderManager portforward.Forwarder) error {
+func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger loggerMux, forwarderManager portforward.Forwarder) error {
 	if r.changeSet.needsReload {
 		return ErrorConfigurationChanged
 	}
 
+	requestedArtifacts, requestedDeployers, restartPortForward := r.intents.takeRequests()
+	if len(requestedArtifacts) > 0 {
+		g := getTransposeGraph(r.runCtx.Artifacts())
+		for _, a := range r.runCtx.Artifacts() {
+			if util.StrSliceContains(requestedArtifacts, a.ImageName) {
+				addRebuild(g, a, r.changeSet.AddRebuild, r.runCtx.Opts.IsTargetImage)
+			}
+		}
+	}
+	// The files changed while a module was paused might not be syncable anymore, so its artifacts are rebuilt
+	if resumed := r.intents.takeResumedChanges(); len(resumed) > 0 {
+		g := getTransposeGraph(r.runCtx.Artifacts())
+		for _, a := range r.runCtx.Artifacts() {
+			if util.StrSliceContains(resumed, a.ImageName) {
+				addRebuild(g, a, r.changeSet.AddRebuild, r.runCtx.Opts.IsTargetImage)
+			}
+		}
+	}
+	for _, d := range requestedDeployers {
+		r.changeSet.AddRedeploy(d)
+	}
+
 	buildIntent, syncIntent, deployIntent := r.intents.GetIntents()
 	needsSync := syncIntent && len(r.changeSet.needsResync) > 0
-	needsBuild := buildIntent && len(r.changeSet.needsRebuild) > 0
-	needsDeploy := deployIntent && r.changeSet.needsRedeploy
+	needsBuild := (buildIntent || len(requestedArtifacts) > 0) && len(r.changeSet.needsRebuild) > 0
+	deployAll := deployIntent && r.changeSet.needsRedeploy
+	needsDeploy := deployAll || len(r.changeSet.redeployOnly) > 0
+	if restartPortForward && !needsDeploy {
+		forwarderManager.Stop()
+		if err := forwarderManager.Start(ctx); err != nil {
+			logrus.Warnln("Port forwarding failed:", err)
+		}
+	}
 	if !needsSync && !needsBuild && !needsDeploy {
 		return nil
 	}
@@ -130,7 +159,11 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *kuber
 		if !meterUpdated {
 			instrumentation.AddDevIteration("deploy")
 		}
-		if err := r.Deploy(ctx, out, r.builds); err != nil {
+		var deployers []string
+		if !deployAll {
+			deployers = r.changeSet.redeployOnly
+		}
+		if err := r.deploy(ctx, out, r.builds, deployers); err != nil {
 			logrus.Warnln("Skipping deploy due to error:", err)
 			event.DevLoopFailedInPhase(r.devIteration, sErrors.Deploy, err)
 			return nil
@@ -171,6 +204,11 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 					return build.DependenciesForArtifact(ctx, artifact, r.runCtx, r.artifactStore)
 				},
 				func(e filemon.Events) {
+					if module := r.runCtx.ModuleForImage(artifact.ImageName); !r.intents.isModuleWatched(module) {
+						logrus.Debugf("Deferring changes to %s until watching module %q resumes", artifact.ImageName, module)
+						r.intents.addPausedChange(module, artifact.ImageName)
+						return
+					}
 					s, err := sync.NewItem(ctx, artifact, e, r.builds, r.runCtx, len(g[artifact.ImageName]))
 					switch {
 					case err != nil:
@@ -273,6 +311,26 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 	})
 }
 
+// selectDeployers returns the deployers with the given names.
+func (r *SkaffoldRunner) selectDeployers(names []string) deploy.DeployerMux {
+	var deployers deploy.DeployerMux
+	for _, d := range r.namedDeployers(names) {
+		deployers = append(deployers, d.Deployer)
+	}
+	return deployers
+}
+
+// namedDeployers returns the named deployers with the given names.
+func (r *SkaffoldRunner) namedDeployers(names []string) []namedDeployer {
+	var deployers []namedDeployer
+	for _, d := range r.deployers {
+		if util.StrSliceContains(names, d.name) {
+			deployers = append(deployers, d)
+		}
+	}
+	return deployers
+}
+
 // graph represents the artifact graph
 type graph map[string][]*latest.Artifact
 
@@ -609,3 +609,157 @@ func TestDevSync_WithDependencies(t *testing.T) {
 		})
 	}
 }
+
+// resumingMonitor resumes watching the unnamed module before the last cycle.
+type resumingMonitor struct {
+	*TestMonitor
+	intents *intents
+}
+
+func (m *resumingMonitor) Run(debounce bool) error {
+	if m.intents != nil && m.testBench.currentCycle == len(m.events)-1 {
+		m.intents.setModuleWatch("", true)
+	}
+	return m.TestMonitor.Run(debounce)
+}
+
+func TestDevRequests(t *testing.T) {
+	tests := []struct {
+		description     string
+		autoBuild       bool
+		autoDeploy      bool
+		pausedModule    bool
+		resumeModule    bool
+		artifacts       []string
+		deployers       []string
+		watchEvents     []filemon.Events
+		expectedActions []Actions
+	}{
+		{
+			description: "rebuild a single artifact",
+			autoDeploy:  true,
+			artifacts:   []string{"img2"},
+			watchEvents: []filemon.Events{{Modified: []string{"file1"}}},
+			expectedActions: []Actions{
+				{
+					Built:    []string{"img1:1", "img2:1"},
+					Tested:   []string{"img1:1", "img2:1"},
+					Deployed: []string{"img1:1", "img2:1"},
+				},
+				{
+					// img1 was modified too
+					Built:    []string{"img1:2", "img2:2"},
+					Tested:   []string{"img1:2", "img2:2"},
+					Deployed: []string{"img1:2", "img2:2"},
+				},
+			},
+		},
+		{
+			description: "rebuild a single artifact without changes",
+			autoDeploy:  true,
+			artifacts:   []string{"img2"},
+			watchEvents: []filemon.Events{{}},
+			expectedActions: []Actions{
+				{
+					Built:    []string{"img1:1", "img2:1"},
+					Tested:   []string{"img1:1", "img2:1"},
+					Deployed: []string{"img1:1", "img2:1"},
+				},
+				{
+					Built:    []string{"img2:2"},
+					Tested:   []string{"img2:2"},
+					Deployed: []string{"img1:1", "img2:2"},
+				},
+			},
+		},
+		{
+			description: "run a single deployer",
+			autoBuild:   true,
+			deployers:   []string{"kubectl"},
+			watchEvents: []filemon.Events{{}},
+			expectedActions: []Actions{
+				{
+					Built:    []string{"img1:1", "img2:1"},
+					Tested:   []string{"img1:1", "img2:1"},
+					Deployed: []string{"img1:1", "img2:1"},
+				},
+				{
+					Deployed: []string{"img1:1", "img2:1"},
+				},
+			},
+		},
+		{
+			description:  "paused module",
+			autoBuild:    true,
+			autoDeploy:   true,
+			pausedModule: true,
+			watchEvents:  []filemon.Events{{Modified: []string{"file1"}}},
+			expectedActions: []Actions{
+				{
+					Built:    []string{"img1:1", "img2:1"},
+					Tested:   []string{"img1:1", "img2:1"},
+					Deployed: []string{"img1:1", "img2:1"},
+				},
+				{},
+			},
+		},
+		{
+			description:  "changes of a paused module are applied when it resumes",
+			autoBuild:    true,
+			autoDeploy:   true,
+			pausedModule: true,
+			resumeModule: true,
+			watchEvents:  []filemon.Events{{Modified: []string{"file1"}}, {}},
+			expectedActions: []Actions{
+				{
+					Built:    []string{"img1:1", "img2:1"},
+					Tested:   []string{"img1:1", "img2:1"},
+					Deployed: []string{"img1:1", "img2:1"},
+				},
+				{},
+				{
+					Built:    []string{"img1:2"},
+					Tested:   []string{"img1:2"},
+					Deployed: []string{"img1:2", "img2:1"},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetupFakeKubernetesContext(api.Config{CurrentContext: "cluster1"})
+			t.Override(&client.Client, mockK8sClient)
+			testBench := &TestBench{cycles: len(test.watchEvents)}
+			artifacts := []*latest.Artifact{
+				{ImageName: "img1"},
+				{ImageName: "img2"},
+			}
+			monitor := &resumingMonitor{TestMonitor: &TestMonitor{
+				events:    test.watchEvents,
+				testBench: testBench,
+			}}
+			runner := createRunner(t, testBench, monitor, artifacts)
+			runner.intents = newIntents(test.autoBuild, true, test.autoDeploy)
+			if test.resumeModule {
+				monitor.intents = runner.intents
+			}
+			runner.intents.resetBuild()
+			runner.intents.resetSync()
+			runner.intents.resetDeploy()
+			runner.deployers = []namedDeployer{{name: "kubectl", Deployer: testBench}}
+			// the artifacts of configs without a name belong to the unnamed module
+			runner.intents.setModuleWatch("", !test.pausedModule)
+			for _, a := range test.artifacts {
+				runner.intents.addArtifact(a)
+			}
+			for _, d := range test.deployers {
+				runner.intents.addDeployer(d)
+			}
+
+			err := runner.Dev(context.Background(), ioutil.Discard, artifacts)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expectedActions, testBench.Actions())
+		})
+	}
+}
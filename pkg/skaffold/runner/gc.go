@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/gc"
+)
+
+// for testing
+var (
+	listResources           = gc.List
+	listEphemeralNamespaces = gc.ListEphemeralNamespaces
+	deleteResources         = gc.Delete
+)
+
+// collectExpiredResources deletes the resources of previous Skaffold runs that have outlived their TTL,
+// in the namespaces that this run deploys to, and their expired ephemeral namespaces.
+// Failures are only reported as warnings.
+func (r *SkaffoldRunner) collectExpiredResources(ctx context.Context, out io.Writer) {
+	if r.expiredResourcesCollected || !r.runCtx.AddSkaffoldLabels() {
+		return
+	}
+	r.expiredResourcesCollected = true
+
+	now := time.Now()
+	for _, c := range r.clusters() {
+		resources, err := listResources(ctx, c.kubeContext, c.runCtx.GetNamespaces())
+		if err != nil {
+			logrus.Warnf("Unable to look for expired resources: %v", err)
+			continue
+		}
+		// Namespaces are cluster-scoped, so they're listed separately
+		namespaces, err := listEphemeralNamespaces(ctx, c.kubeContext)
+		if err != nil {
+			logrus.Warnf("Unable to look for expired ephemeral namespaces: %v", err)
+		}
+
+		var expired []gc.Resource
+		for _, res := range append(resources, namespaces...) {
+			// The ephemeral namespace of this session may be left over by an earlier one
+			if res.Kind == "Namespace" && res.Name == r.runCtx.GetEphemeralNamespace() {
+				continue
+			}
+			if res.RunID != r.labeller.GetRunID() && res.Expired(now) {
+				expired = append(expired, res)
+			}
+		}
+		if len(expired) == 0 {
+			continue
+		}
+
+		color.Default.Fprintf(out, "Deleting %d expired resources of previous sessions...\n", len(expired))
+		for _, res := range expired {
+			logrus.Debugf("Deleting %s of run %s, deployed by %q at %s with a TTL of %s", res, res.RunID, res.User, res.Deployed, res.TTL)
+		}
+		if err := deleteResources(ctx, c.kubeContext, expired); err != nil {
+			logrus.Warnf("Unable to delete expired resources: %v", err)
+		}
+	}
+}
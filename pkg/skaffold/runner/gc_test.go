@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/gc"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestCollectExpiredResources(t *testing.T) {
+	labeller := label.NewLabeller(true, nil)
+	deployed := time.Now().Add(-2 * time.Hour)
+
+	expired := gc.Resource{Name: "expired", RunID: "previous", Deployed: deployed, TTL: time.Hour}
+	alive := gc.Resource{Name: "alive", RunID: "previous", Deployed: deployed, TTL: 24 * time.Hour}
+	noTTL := gc.Resource{Name: "no-ttl", RunID: "previous", Deployed: deployed}
+	current := gc.Resource{Name: "current", RunID: labeller.GetRunID(), Deployed: deployed, TTL: time.Hour}
+	expiredNamespace := gc.Resource{Kind: "Namespace", Name: "skaffold-jane", RunID: "previous", Deployed: deployed, TTL: time.Hour}
+	reusedNamespace := gc.Resource{Kind: "Namespace", Name: "skaffold-bob", RunID: "previous", Deployed: deployed, TTL: time.Hour}
+
+	tests := []struct {
+		description       string
+		addSkaffoldLabels bool
+		resources         []gc.Resource
+		namespaces        []gc.Resource
+		listErr           error
+		expectedDeleted   []gc.Resource
+	}{
+		{
+			description:       "expired resources of other runs are deleted",
+			addSkaffoldLabels: true,
+			resources:         []gc.Resource{expired, alive, noTTL, current},
+			expectedDeleted:   []gc.Resource{expired},
+		},
+		{
+			description:       "expired ephemeral namespaces of other runs are deleted",
+			addSkaffoldLabels: true,
+			resources:         []gc.Resource{alive},
+			namespaces:        []gc.Resource{expiredNamespace, reusedNamespace},
+			expectedDeleted:   []gc.Resource{expiredNamespace},
+		},
+		{
+			description:       "nothing expired",
+			addSkaffoldLabels: true,
+			resources:         []gc.Resource{alive, noTTL},
+		},
+		{
+			description:       "listing errors are ignored",
+			addSkaffoldLabels: true,
+			listErr:           errors.New("forbidden"),
+		},
+		{
+			description: "no skaffold labels",
+			resources:   []gc.Resource{expired},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var listed int
+			var deleted []gc.Resource
+			t.Override(&listResources, func(_ context.Context, kubeContext string, namespaces []string) ([]gc.Resource, error) {
+				listed++
+				t.CheckDeepEqual([]string{"dev"}, namespaces)
+				return test.resources, test.listErr
+			})
+			t.Override(&listEphemeralNamespaces, func(_ context.Context, kubeContext string) ([]gc.Resource, error) {
+				return test.namespaces, test.listErr
+			})
+			t.Override(&deleteResources, func(_ context.Context, kubeContext string, resources []gc.Resource) error {
+				deleted = append(deleted, resources...)
+				return nil
+			})
+			r := &SkaffoldRunner{
+				runCtx: &runcontext.RunContext{
+					Opts:               config.SkaffoldOptions{AddSkaffoldLabels: test.addSkaffoldLabels},
+					Namespaces:         []string{"dev"},
+					EphemeralNamespace: "skaffold-bob",
+				},
+				labeller: labeller,
+			}
+
+			r.collectExpiredResources(context.Background(), ioutil.Discard)
+			// Expired resources are only collected once
+			r.collectExpiredResources(context.Background(), ioutil.Discard)
+
+			t.CheckDeepEqual(test.expectedDeleted, deleted)
+			if test.addSkaffoldLabels {
+				t.CheckDeepEqual(1, listed)
+			} else {
+				t.CheckDeepEqual(0, listed)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+)
+
+const (
+	tagImageReference       = "tag"
+	digestImageReference    = "digest"
+	tagDigestImageReference = "tag@digest"
+)
+
+// For testing
+var tagWithImageID = func(ctx context.Context, cfg docker.Config, ref string) (string, error) {
+	localDocker, err := docker.NewAPIClient(cfg)
+	if err != nil {
+		return "", err
+	}
+
+	imageID, err := localDocker.ImageID(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+	if imageID == "" {
+		return "", fmt.Errorf("image %q not found in the local docker daemon", ref)
+	}
+	return localDocker.TagWithImageID(ctx, ref, imageID)
+}
+
+// validateImageReference checks the `--image-reference` mode.
+func validateImageReference(mode string) error {
+	switch mode {
+	case "", tagImageReference, digestImageReference, tagDigestImageReference:
+		return nil
+	default:
+		return fmt.Errorf("invalid image reference %q, must be one of %q, %q or %q", mode, tagImageReference, digestImageReference, tagDigestImageReference)
+	}
+}
+
+// applyImageReference rewrites the references of built images according to the `--image-reference` mode.
+// tags are the tags generated for the images, if they are known. Without a mode, the references
+// returned by the builders are left untouched.
+func (r *SkaffoldRunner) applyImageReference(ctx context.Context, artifacts []build.Artifact, tags tag.ImageTags) ([]build.Artifact, error) {
+	mode := r.runCtx.ImageReference()
+	if mode == "" {
+		return artifacts, nil
+	}
+
+	var referenced []build.Artifact
+	for _, a := range artifacts {
+		ref, err := r.imageReference(ctx, mode, a, tags[a.ImageName])
+		if err != nil {
+			return nil, err
+		}
+		referenced = append(referenced, build.Artifact{ImageName: a.ImageName, Tag: ref})
+	}
+	return referenced, nil
+}
+
+func (r *SkaffoldRunner) imageReference(ctx context.Context, mode string, a build.Artifact, generatedTag string) (string, error) {
+	parsed, err := docker.ParseReference(a.Tag)
+	if err != nil {
+		return "", fmt.Errorf("parsing image reference %q: %w", a.Tag, err)
+	}
+
+	tagged := parsed.BaseName
+	if parsed.Tag != "" {
+		tagged += ":" + parsed.Tag
+	}
+
+	switch mode {
+	case tagImageReference:
+		if generatedTag != "" {
+			return generatedTag, nil
+		}
+		if parsed.Tag == "" && parsed.Digest != "" {
+			return "", fmt.Errorf("%q has no tag to reference the image by", a.Tag)
+		}
+		return tagged, nil
+
+	case digestImageReference, tagDigestImageReference:
+		digest := parsed.Digest
+		if digest == "" && r.runCtx.HermeticRender() {
+			return "", fmt.Errorf("%q has no digest and resolving it needs network access, which --hermetic forbids", a.Tag)
+		}
+		if digest == "" {
+			isLocal, err := r.isLocalImage(a.ImageName)
+			if err != nil {
+				return "", err
+			}
+
+			// Images loaded into a local cluster don't have a registry digest.
+			// They are referenced by a unique tag computed from their image ID instead.
+			if isLocal {
+				return tagWithImageID(ctx, r.runCtx, tagged)
+			}
+
+			digest, err = docker.RemoteDigest(tagged, r.runCtx)
+			if err != nil {
+				return "", fmt.Errorf("resolving the digest of %q: %w", tagged, err)
+			}
+		}
+
+		if mode == digestImageReference {
+			return parsed.BaseName + "@" + digest, nil
+		}
+		return build.TagWithDigest(tagged, digest), nil
+
+	default:
+		return "", validateImageReference(mode)
+	}
+}
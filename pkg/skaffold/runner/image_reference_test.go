@@ -0,0 +1,142 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const testDigest = "sha256:0c8cff3a9b1c1d8e4a3fa8e1f2bf8cfbf7aa1e4a93bc2c6e0a1c4bba3c1d2e3f"
+
+func TestApplyImageReference(t *testing.T) {
+	tests := []struct {
+		description string
+		mode        string
+		artifacts   []build.Artifact
+		tags        tag.ImageTags
+		localImages []string
+		expected    []build.Artifact
+		shouldErr   bool
+	}{
+		{
+			description: "builder default",
+			artifacts:   []build.Artifact{{ImageName: "pushed", Tag: "pushed:v1@" + testDigest}},
+			expected:    []build.Artifact{{ImageName: "pushed", Tag: "pushed:v1@" + testDigest}},
+		},
+		{
+			description: "tag",
+			mode:        "tag",
+			artifacts: []build.Artifact{
+				{ImageName: "pushed", Tag: "pushed:v1@" + testDigest},
+				{ImageName: "local", Tag: "local:imageid"},
+			},
+			tags:        tag.ImageTags{"local": "local:v1"},
+			localImages: []string{"local"},
+			expected: []build.Artifact{
+				{ImageName: "pushed", Tag: "pushed:v1"},
+				{ImageName: "local", Tag: "local:v1"},
+			},
+		},
+		{
+			description: "tag of a digest-only reference",
+			mode:        "tag",
+			artifacts:   []build.Artifact{{ImageName: "pushed", Tag: "pushed@" + testDigest}},
+			shouldErr:   true,
+		},
+		{
+			description: "digest",
+			mode:        "digest",
+			artifacts: []build.Artifact{
+				{ImageName: "pushed", Tag: "gcr.io/project/pushed:v1@" + testDigest},
+				{ImageName: "remote", Tag: "remote:v1"},
+				{ImageName: "local", Tag: "local:v1"},
+			},
+			localImages: []string{"local"},
+			expected: []build.Artifact{
+				{ImageName: "pushed", Tag: "gcr.io/project/pushed@" + testDigest},
+				{ImageName: "remote", Tag: "remote@" + testDigest},
+				{ImageName: "local", Tag: "local:imageid"},
+			},
+		},
+		{
+			description: "tag@digest",
+			mode:        "tag@digest",
+			artifacts: []build.Artifact{
+				{ImageName: "pushed", Tag: "pushed:v1@" + testDigest},
+				{ImageName: "remote", Tag: "remote:v1"},
+				{ImageName: "local", Tag: "local:v1"},
+			},
+			localImages: []string{"local"},
+			expected: []build.Artifact{
+				{ImageName: "pushed", Tag: "pushed:v1@" + testDigest},
+				{ImageName: "remote", Tag: "remote:v1@" + testDigest},
+				{ImageName: "local", Tag: "local:imageid"},
+			},
+		},
+		{
+			description: "unresolved digest",
+			mode:        "digest",
+			artifacts:   []build.Artifact{{ImageName: "unknown", Tag: "unknown:v1"}},
+			shouldErr:   true,
+		},
+		{
+			description: "invalid mode",
+			mode:        "id",
+			artifacts:   []build.Artifact{{ImageName: "pushed", Tag: "pushed:v1@" + testDigest}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&docker.RemoteDigest, func(ref string, _ docker.Config) (string, error) {
+				if strings.HasPrefix(ref, "unknown") {
+					return "", errors.New("not found")
+				}
+				return testDigest, nil
+			})
+			t.Override(&tagWithImageID, func(_ context.Context, _ docker.Config, ref string) (string, error) {
+				return strings.Split(ref, ":")[0] + ":imageid", nil
+			})
+
+			runner := createRunner(t, &TestBench{}, nil, nil)
+			runner.runCtx.Opts.ImageReference = test.mode
+			runner.isLocalImage = func(imageName string) (bool, error) {
+				return util.StrSliceContains(test.localImages, imageName), nil
+			}
+
+			artifacts, err := runner.applyImageReference(context.Background(), test.artifacts, test.tags)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, artifacts)
+		})
+	}
+}
+
+func TestValidateImageReference(t *testing.T) {
+	for _, mode := range []string{"", "tag", "digest", "tag@digest"} {
+		testutil.CheckError(t, false, validateImageReference(mode))
+	}
+	testutil.CheckError(t, true, validateImageReference("id"))
+}
@@ -16,7 +16,11 @@ limitations under the License.
 
 package runner
 
-import "sync"
+import (
+	"sync"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
 
 type intents struct {
 	build      bool
@@ -26,6 +30,15 @@ type intents struct {
 	autoSync   bool
 	autoDeploy bool
 
+	// requests received through the API, taken by the dev loop
+	artifacts          []string
+	deployers          []string
+	restartPortForward bool
+	unwatchedModules   map[string]bool
+	// artifacts changed while their module was paused, by module, and those to rebuild once it resumed
+	pausedChanges    map[string][]string
+	resumedArtifacts []string
+
 	lock sync.Mutex
 }
 
@@ -123,3 +136,76 @@ func (i *intents) IsAnyAutoEnabled() bool {
 	defer i.lock.Unlock()
 	return i.autoBuild || i.autoSync || i.autoDeploy
 }
+
+func (i *intents) addArtifact(imageName string) {
+	i.lock.Lock()
+	i.artifacts = append(i.artifacts, imageName)
+	i.lock.Unlock()
+}
+
+func (i *intents) addDeployer(name string) {
+	i.lock.Lock()
+	i.deployers = append(i.deployers, name)
+	i.lock.Unlock()
+}
+
+func (i *intents) setRestartPortForward() {
+	i.lock.Lock()
+	i.restartPortForward = true
+	i.lock.Unlock()
+}
+
+// takeRequests returns and clears the artifacts to rebuild, the deployers to run
+// and whether port forwarding should be restarted, as requested through the API.
+func (i *intents) takeRequests() ([]string, []string, bool) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	artifacts, deployers, restartPortForward := i.artifacts, i.deployers, i.restartPortForward
+	i.artifacts, i.deployers, i.restartPortForward = nil, nil, false
+	return artifacts, deployers, restartPortForward
+}
+
+// setModuleWatch pauses or resumes watching a module. It returns true if artifacts
+// changed while the module was paused, and so have to be rebuilt.
+func (i *intents) setModuleWatch(module string, watch bool) bool {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if i.unwatchedModules == nil {
+		i.unwatchedModules = map[string]bool{}
+	}
+	i.unwatchedModules[module] = !watch
+	if !watch || len(i.pausedChanges[module]) == 0 {
+		return false
+	}
+	i.resumedArtifacts = append(i.resumedArtifacts, i.pausedChanges[module]...)
+	delete(i.pausedChanges, module)
+	return true
+}
+
+// addPausedChange records that an artifact of a paused module changed.
+func (i *intents) addPausedChange(module string, imageName string) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if i.pausedChanges == nil {
+		i.pausedChanges = map[string][]string{}
+	}
+	if !util.StrSliceContains(i.pausedChanges[module], imageName) {
+		i.pausedChanges[module] = append(i.pausedChanges[module], imageName)
+	}
+}
+
+// takeResumedChanges returns and clears the artifacts that changed while their module was paused,
+// once the module resumed.
+func (i *intents) takeResumedChanges() []string {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	artifacts := i.resumedArtifacts
+	i.resumedArtifacts = nil
+	return artifacts
+}
+
+func (i *intents) isModuleWatched(module string) bool {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	return !i.unwatchedModules[module]
+}
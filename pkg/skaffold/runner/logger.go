@@ -17,13 +17,18 @@ limitations under the License.
 package runner
 
 import (
+	"context"
 	"io"
+	"time"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
 )
 
-func (r *SkaffoldRunner) createLogger(out io.Writer, artifacts []build.Artifact) *kubernetes.LogAggregator {
+// loggerMux tails the logs of every cluster that Skaffold deploys to.
+type loggerMux []*kubernetes.LogAggregator
+
+func (r *SkaffoldRunner) createLogger(out io.Writer, artifacts []build.Artifact) loggerMux {
 	if !r.runCtx.Tail() {
 		return nil
 	}
@@ -33,5 +38,42 @@ func (r *SkaffoldRunner) createLogger(out io.Writer, artifacts []build.Artifact)
 		imageNames = append(imageNames, artifact.Tag)
 	}
 
-	return kubernetes.NewLogAggregator(out, r.kubectlCLI, imageNames, r.podSelector, r.runCtx.GetNamespaces(), r.runCtx)
+	var loggers loggerMux
+	for _, c := range r.clusters() {
+		loggers = append(loggers, kubernetes.NewLogAggregator(out, imageNames, r.podSelector, c.runCtx.GetNamespaces(), r.labeller.GetRunID(), c.runCtx, c.kubeContext))
+	}
+	return loggers
+}
+
+func (m loggerMux) Start(ctx context.Context) error {
+	for _, l := range m {
+		if err := l.Start(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m loggerMux) Stop() {
+	for _, l := range m {
+		l.Stop()
+	}
+}
+
+func (m loggerMux) SetSince(t time.Time) {
+	for _, l := range m {
+		l.SetSince(t)
+	}
+}
+
+func (m loggerMux) Mute() {
+	for _, l := range m {
+		l.Mute()
+	}
+}
+
+func (m loggerMux) Unmute() {
+	for _, l := range m {
+		l.Unmute()
+	}
 }
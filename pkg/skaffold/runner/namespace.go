@@ -0,0 +1,289 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// createEphemeralNamespace creates the ephemeral namespace in every cluster, if it's configured,
+// and copies the secrets and config maps of the template namespace into it.
+// A namespace of the same name is only reused if it's an ephemeral namespace left over by an earlier session.
+func (r *SkaffoldRunner) createEphemeralNamespace(ctx context.Context, out io.Writer) error {
+	name := r.runCtx.GetEphemeralNamespace()
+	if name == "" {
+		return nil
+	}
+	annotations := r.ephemeralNamespaceAnnotations()
+	if r.ephemeralNamespaceCreated {
+		// Stamp each deployment so that the namespace doesn't expire while the session is running
+		for _, c := range r.clusters() {
+			cli := kubectl.NewCLI(c.runCtx, latest.KubectlFlags{}, "")
+			if err := annotateNamespace(ctx, &cli, out, name, annotations); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	cfg := r.runCtx.EphemeralNamespaceConfig()
+
+	color.Default.Fprintf(out, "Creating ephemeral namespace %q...\n", name)
+	for _, c := range r.clusters() {
+		cli := kubectl.NewCLI(c.runCtx, latest.KubectlFlags{}, "")
+		namespace, err := namespaceManifest(name, r.labeller.Labels(), annotations)
+		if err != nil {
+			return err
+		}
+
+		// The namespace of a previous session may still be terminating
+		if err := cli.WaitForDeletions(ctx, out, namespace); err != nil {
+			return err
+		}
+
+		exists, ephemeral, err := ephemeralNamespaceExists(ctx, &cli, name)
+		if err != nil {
+			return err
+		}
+		switch {
+		case exists && !ephemeral:
+			return fmt.Errorf("creating ephemeral namespace %q: the namespace already exists and isn't labelled %q, pick another name", name, label.EphemeralNamespaceLabel)
+		case exists:
+			color.Default.Fprintf(out, "Reusing the ephemeral namespace %q of an earlier session\n", name)
+			if err := annotateNamespace(ctx, &cli, out, name, annotations); err != nil {
+				return err
+			}
+		default:
+			if err := cli.Run(ctx, namespace.Reader(), out, "create", "-f", "-"); err != nil {
+				return fmt.Errorf("creating ephemeral namespace %q: %w", name, err)
+			}
+			if r.ephemeralNamespaceCreatedIn == nil {
+				r.ephemeralNamespaceCreatedIn = map[string]bool{}
+			}
+			r.ephemeralNamespaceCreatedIn[c.kubeContext] = true
+		}
+
+		copies, err := copyFromTemplateNamespace(ctx, &cli, *cfg, name)
+		if err != nil {
+			return err
+		}
+		if len(copies) > 0 {
+			if err := cli.Apply(ctx, out, copies); err != nil {
+				return fmt.Errorf("copying resources into ephemeral namespace %q: %w", name, err)
+			}
+		}
+	}
+
+	r.ephemeralNamespaceCreated = true
+	return nil
+}
+
+// deleteEphemeralNamespace deletes the ephemeral namespace in every cluster, if it's configured,
+// and waits for the deletion to complete. Only the namespaces that this session created, or that are
+// labelled as ephemeral namespaces, are deleted.
+func (r *SkaffoldRunner) deleteEphemeralNamespace(ctx context.Context, out io.Writer) error {
+	name := r.runCtx.GetEphemeralNamespace()
+	if name == "" {
+		return nil
+	}
+
+	color.Default.Fprintf(out, "Deleting ephemeral namespace %q...\n", name)
+	for _, c := range r.clusters() {
+		// Don't block on the deletion, it's waited for below
+		cli := kubectl.NewCLI(c.runCtx, latest.KubectlFlags{Delete: []string{"--wait=false"}}, "")
+		if !r.ephemeralNamespaceCreatedIn[c.kubeContext] {
+			exists, ephemeral, err := ephemeralNamespaceExists(ctx, &cli, name)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				continue
+			}
+			if !ephemeral {
+				logrus.Warnf("Not deleting namespace %q: it isn't labelled %q", name, label.EphemeralNamespaceLabel)
+				continue
+			}
+		}
+
+		namespace, err := namespaceManifest(name, nil, nil)
+		if err != nil {
+			return err
+		}
+		if err := cli.Delete(ctx, out, namespace); err != nil {
+			return err
+		}
+		if err := cli.WaitForDeletions(ctx, out, namespace); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ephemeralNamespaceExists checks whether the namespace exists and whether it's labelled as an ephemeral namespace.
+func ephemeralNamespaceExists(ctx context.Context, cli *kubectl.CLI, name string) (bool, bool, error) {
+	buf, err := cli.RunOut(ctx, "get", "namespace", name, "--ignore-not-found", "-ojson")
+	if err != nil {
+		return false, false, fmt.Errorf("reading namespace %q: %w", name, err)
+	}
+	if len(bytes.TrimSpace(buf)) == 0 {
+		return false, false, nil
+	}
+
+	var namespace struct {
+		Metadata struct {
+			Labels map[string]string `json:"labels"`
+		} `json:"metadata"`
+	}
+	if err := json.Unmarshal(buf, &namespace); err != nil {
+		return false, false, fmt.Errorf("reading namespace %q: %w", name, err)
+	}
+	return true, namespace.Metadata.Labels[label.EphemeralNamespaceLabel] == "true", nil
+}
+
+// ephemeralNamespaceAnnotations returns the TTL of the ephemeral namespace, if there's one, and the time of the current deployment.
+func (r *SkaffoldRunner) ephemeralNamespaceAnnotations() map[string]string {
+	annotations := map[string]string{}
+	for k, v := range r.labeller.Annotations() {
+		annotations[k] = v
+	}
+	if ttl := r.runCtx.ResourceTTL(); ttl > 0 {
+		annotations[label.TTLAnnotation] = ttl.String()
+	}
+	return annotations
+}
+
+// annotateNamespace overwrites the given annotations of a namespace.
+func annotateNamespace(ctx context.Context, cli *kubectl.CLI, out io.Writer, name string, annotations map[string]string) error {
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	var pairs []string
+	for k, v := range annotations {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	args := append([]string{"namespace", name, "--overwrite"}, pairs...)
+	if err := cli.Run(ctx, nil, out, "annotate", args...); err != nil {
+		return fmt.Errorf("annotating ephemeral namespace %q: %w", name, err)
+	}
+	return nil
+}
+
+func namespaceManifest(name string, labels, annotations map[string]string) (manifest.ManifestList, error) {
+	namespaceLabels := map[string]string{label.EphemeralNamespaceLabel: "true"}
+	for k, v := range labels {
+		namespaceLabels[k] = v
+	}
+
+	metadata := map[string]interface{}{
+		"name":   name,
+		"labels": namespaceLabels,
+	}
+	if len(annotations) > 0 {
+		metadata["annotations"] = annotations
+	}
+	buf, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Namespace",
+		"metadata":   metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return manifest.ManifestList{buf}, nil
+}
+
+// copyFromTemplateNamespace reads the secrets and config maps to copy from the template namespace
+// and returns them, moved to the given namespace.
+func copyFromTemplateNamespace(ctx context.Context, cli *kubectl.CLI, cfg latest.EphemeralNamespace, namespace string) (manifest.ManifestList, error) {
+	var names []string
+	for _, name := range cfg.Secrets {
+		names = append(names, "secret/"+name)
+	}
+	for _, name := range cfg.ConfigMaps {
+		names = append(names, "configmap/"+name)
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	cmd := cli.CommandWithNamespaceArg(ctx, "get", cfg.TemplateNamespace, append(names, "-ojson")...)
+	buf, err := util.RunCmdOut(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("reading resources of template namespace %q: %w", cfg.TemplateNamespace, err)
+	}
+
+	// A single resource isn't wrapped in a list
+	var list struct {
+		Kind  string                   `json:"kind"`
+		Items []map[string]interface{} `json:"items"`
+	}
+	if err := json.Unmarshal(buf, &list); err != nil {
+		return nil, fmt.Errorf("reading resources of template namespace %q: %w", cfg.TemplateNamespace, err)
+	}
+	items := list.Items
+	if list.Kind != "List" {
+		var item map[string]interface{}
+		if err := json.Unmarshal(buf, &item); err != nil {
+			return nil, err
+		}
+		items = []map[string]interface{}{item}
+	}
+
+	var copies manifest.ManifestList
+	for _, item := range items {
+		metadata, _ := item["metadata"].(map[string]interface{})
+		copied := map[string]interface{}{
+			"apiVersion": item["apiVersion"],
+			"kind":       item["kind"],
+			"metadata": map[string]interface{}{
+				"name":      metadata["name"],
+				"namespace": namespace,
+			},
+		}
+		if labels, found := metadata["labels"]; found {
+			copied["metadata"].(map[string]interface{})["labels"] = labels
+		}
+		for _, field := range []string{"type", "data", "binaryData", "immutable"} {
+			if value, found := item[field]; found {
+				copied[field] = value
+			}
+		}
+
+		buf, err := yaml.Marshal(copied)
+		if err != nil {
+			return nil, err
+		}
+		copies = append(copies, buf)
+	}
+	return copies, nil
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const ephemeralNamespaceManifest = `apiVersion: v1
+kind: Namespace
+metadata:
+  labels:
+    skaffold.dev/ephemeral-namespace: "true"
+  name: skaffold-bob`
+
+func ephemeralNamespaceRunner(cfg latest.EphemeralNamespace) *SkaffoldRunner {
+	runCtx := &runcontext.RunContext{
+		Opts: config.SkaffoldOptions{
+			Namespace:        "skaffold-bob",
+			WaitForDeletions: config.WaitForDeletions{Enabled: true, Max: time.Second, Delay: time.Millisecond},
+		},
+		Pipelines:          runcontext.NewPipelines([]latest.Pipeline{{Deploy: latest.DeployConfig{EphemeralNamespace: &cfg}}}),
+		KubeContext:        "kubecontext",
+		EphemeralNamespace: "skaffold-bob",
+	}
+	return &SkaffoldRunner{
+		runCtx:     runCtx,
+		kubectlCLI: kubectl.NewCLI(runCtx, ""),
+		labeller:   label.NewLabeller(false, nil),
+	}
+}
+
+func TestCreateEphemeralNamespace(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         latest.EphemeralNamespace
+		commands    util.Command
+		shouldErr   bool
+		created     bool
+	}{
+		{
+			description: "create namespace",
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace skaffold-bob get -f - --ignore-not-found -ojson", "").
+				AndRunOut("kubectl --context kubecontext --namespace skaffold-bob get namespace skaffold-bob --ignore-not-found -ojson", "").
+				AndRunInput("kubectl --context kubecontext --namespace skaffold-bob create -f -", ephemeralNamespaceManifest),
+			created: true,
+		},
+		{
+			description: "reuse the ephemeral namespace of an earlier session",
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace skaffold-bob get -f - --ignore-not-found -ojson", "").
+				AndRunOut("kubectl --context kubecontext --namespace skaffold-bob get namespace skaffold-bob --ignore-not-found -ojson", `{"metadata": {"name": "skaffold-bob", "labels": {"skaffold.dev/ephemeral-namespace": "true"}}}`),
+		},
+		{
+			description: "refuse to use a namespace that isn't ephemeral",
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace skaffold-bob get -f - --ignore-not-found -ojson", "").
+				AndRunOut("kubectl --context kubecontext --namespace skaffold-bob get namespace skaffold-bob --ignore-not-found -ojson", `{"metadata": {"name": "skaffold-bob", "labels": {"team": "bob"}}}`),
+			shouldErr: true,
+		},
+		{
+			description: "copy secrets and config maps from template namespace",
+			cfg: latest.EphemeralNamespace{
+				TemplateNamespace: "shared",
+				Secrets:           []string{"registry"},
+				ConfigMaps:        []string{"settings"},
+			},
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace skaffold-bob get -f - --ignore-not-found -ojson", "").
+				AndRunOut("kubectl --context kubecontext --namespace skaffold-bob get namespace skaffold-bob --ignore-not-found -ojson", "").
+				AndRunInput("kubectl --context kubecontext --namespace skaffold-bob create -f -", ephemeralNamespaceManifest).
+				AndRunOut("kubectl --context kubecontext --namespace shared get secret/registry configmap/settings -ojson", `{"kind": "List", "items": [
+{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "registry", "namespace": "shared", "uid": "1234", "resourceVersion": "1"}, "type": "kubernetes.io/dockerconfigjson", "data": {".dockerconfigjson": "e30="}},
+{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "settings", "namespace": "shared", "labels": {"app": "web"}}, "data": {"level": "debug"}}
+]}`).
+				AndRunInput("kubectl --context kubecontext --namespace skaffold-bob apply -f -", `apiVersion: v1
+data:
+  .dockerconfigjson: e30=
+kind: Secret
+metadata:
+  name: registry
+  namespace: skaffold-bob
+type: kubernetes.io/dockerconfigjson
+---
+apiVersion: v1
+data:
+  level: debug
+kind: ConfigMap
+metadata:
+  labels:
+    app: web
+  name: settings
+  namespace: skaffold-bob`),
+			created: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			r := ephemeralNamespaceRunner(test.cfg)
+
+			err := r.createEphemeralNamespace(context.Background(), ioutil.Discard)
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.created, r.ephemeralNamespaceCreatedIn[""])
+			if test.shouldErr {
+				return
+			}
+
+			// The namespace is only created once
+			err = r.createEphemeralNamespace(context.Background(), ioutil.Discard)
+			t.CheckNoError(err)
+		})
+	}
+}
+
+func TestCreateEphemeralNamespaceWithTTL(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRunOut("kubectl --context kubecontext --namespace skaffold-bob get -f - --ignore-not-found -ojson", "").
+			AndRunOut("kubectl --context kubecontext --namespace skaffold-bob get namespace skaffold-bob --ignore-not-found -ojson", "").
+			AndRunInput("kubectl --context kubecontext --namespace skaffold-bob create -f -", `apiVersion: v1
+kind: Namespace
+metadata:
+  annotations:
+    skaffold.dev/ttl: 2h0m0s
+  labels:
+    skaffold.dev/ephemeral-namespace: "true"
+  name: skaffold-bob`).
+			AndRun("kubectl --context kubecontext --namespace skaffold-bob annotate namespace skaffold-bob --overwrite skaffold.dev/ttl=2h0m0s"))
+		r := ephemeralNamespaceRunner(latest.EphemeralNamespace{})
+		r.runCtx.Opts.ResourceTTL = 2 * time.Hour
+
+		err := r.createEphemeralNamespace(context.Background(), ioutil.Discard)
+		t.CheckNoError(err)
+
+		// The next deployments are stamped on the namespace
+		err = r.createEphemeralNamespace(context.Background(), ioutil.Discard)
+		t.CheckNoError(err)
+	})
+}
+
+func TestDeleteEphemeralNamespace(t *testing.T) {
+	tests := []struct {
+		description string
+		created     bool
+		commands    util.Command
+	}{
+		{
+			description: "delete the namespace created by the session",
+			created:     true,
+			commands: testutil.
+				CmdRunInput("kubectl --context kubecontext --namespace skaffold-bob delete --wait=false --ignore-not-found=true -f -", ephemeralNamespaceManifest).
+				AndRunOut("kubectl --context kubecontext --namespace skaffold-bob get -f - --ignore-not-found -ojson", `{"items": [{"metadata": {"name": "skaffold-bob", "deletionTimestamp": "2021-03-01T10:00:00Z"}}]}`).
+				AndRunOut("kubectl --context kubecontext --namespace skaffold-bob get -f - --ignore-not-found -ojson", ""),
+		},
+		{
+			description: "delete the ephemeral namespace of an earlier session",
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace skaffold-bob get namespace skaffold-bob --ignore-not-found -ojson", `{"metadata": {"name": "skaffold-bob", "labels": {"skaffold.dev/ephemeral-namespace": "true"}}}`).
+				AndRunInput("kubectl --context kubecontext --namespace skaffold-bob delete --wait=false --ignore-not-found=true -f -", ephemeralNamespaceManifest).
+				AndRunOut("kubectl --context kubecontext --namespace skaffold-bob get -f - --ignore-not-found -ojson", ""),
+		},
+		{
+			description: "don't delete a namespace that isn't ephemeral",
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace skaffold-bob get namespace skaffold-bob --ignore-not-found -ojson", `{"metadata": {"name": "skaffold-bob"}}`),
+		},
+		{
+			description: "namespace already deleted",
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace skaffold-bob get namespace skaffold-bob --ignore-not-found -ojson", ""),
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			r := ephemeralNamespaceRunner(latest.EphemeralNamespace{})
+			if test.created {
+				r.ephemeralNamespaceCreatedIn = map[string]bool{"": true}
+			}
+
+			err := r.deleteEphemeralNamespace(context.Background(), ioutil.Discard)
+
+			t.CheckNoError(err)
+		})
+	}
+}
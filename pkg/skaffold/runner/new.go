@@ -28,6 +28,8 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/gcb"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/local"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	debugging "github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/helm"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kpt"
@@ -38,12 +40,14 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
 	pkgkubectl "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/server"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/trigger"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
 // NewForConfig returns a new SkaffoldRunner for a SkaffoldConfig
@@ -52,6 +56,17 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 	event.LogMetaEvent()
 	kubectlCLI := pkgkubectl.NewCLI(runCtx, "")
 
+	if err := validateImageReference(runCtx.ImageReference()); err != nil {
+		return nil, err
+	}
+
+	// The manifest package keeps the transforms in a global,
+	// which must not pile up when `dev` re-creates the runner after a config change.
+	manifest.ResetTransforms()
+	if runCtx.Mode() == config.RunModes.Debug {
+		manifest.AddTransform(debugging.ApplyDebuggingTransforms)
+	}
+
 	tagger, err := tag.NewTaggerMux(runCtx)
 	if err != nil {
 		return nil, fmt.Errorf("creating tagger: %w", err)
@@ -74,8 +89,7 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 		return nil, fmt.Errorf("creating tester: %w", err)
 	}
 	syncer := getSyncer(runCtx)
-	var deployer deploy.Deployer
-	deployer, err = getDeployer(runCtx, labeller.Labels())
+	deployer, deployers, err := getDeployer(runCtx, labeller.Labels(), labeller.Annotations)
 	if err != nil {
 		return nil, fmt.Errorf("creating deployer: %w", err)
 	}
@@ -106,6 +120,8 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 
 	monitor := filemon.NewMonitor()
 	intents, intentChan := setupIntents(runCtx)
+	setupRequests(runCtx, intents, deployers, intentChan)
+	event.InitializeModules(modules(runCtx), deployerNames(deployers))
 	trigger, err := trigger.NewTrigger(runCtx, intents.IsAnyAutoEnabled)
 	if err != nil {
 		return nil, fmt.Errorf("creating watch trigger: %w", err)
@@ -130,6 +146,7 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 		cache:         artifactCache,
 		runCtx:        runCtx,
 		intents:       intents,
+		deployers:     deployers,
 		isLocalImage:  isLocalImage,
 	}, nil
 }
@@ -168,6 +185,79 @@ func setupTrigger(triggerName string, setIntent func(bool), setAutoTrigger func(
 	})
 }
 
+// setupRequests gives the server callbacks to record the requests to rebuild an artifact, pause or resume
+// watching a module, restart port forwarding or run a single deployer.
+func setupRequests(runCtx *runcontext.RunContext, intents *intents, deployers []namedDeployer, c chan<- bool) {
+	server.SetBuildArtifactCallback(func(imageName string) error {
+		if _, found := runCtx.PipelineForImage(imageName); !found {
+			return fmt.Errorf("unknown artifact %q", imageName)
+		}
+		logrus.Debugf("build request for %s received, calling back to runner", imageName)
+		intents.addArtifact(imageName)
+		notify(c)
+		return nil
+	})
+
+	server.SetWatchModuleCallback(func(module string, watch bool) error {
+		if !util.StrSliceContains(runCtx.Modules(), module) {
+			return fmt.Errorf("unknown module %q", module)
+		}
+		logrus.Debugf("watch update to %t for module %s received", watch, module)
+		if intents.setModuleWatch(module, watch) {
+			notify(c)
+		}
+		return nil
+	})
+
+	server.SetRestartPortForwardCallback(func() {
+		logrus.Debugln("port forward restart request received, calling back to runner")
+		intents.setRestartPortForward()
+		notify(c)
+	})
+
+	server.SetRedeployCallback(func(name string) error {
+		if !util.StrSliceContains(deployerNames(deployers), name) {
+			return fmt.Errorf("unknown deployer %q", name)
+		}
+		logrus.Debugf("deploy request for %s received, calling back to runner", name)
+		intents.addDeployer(name)
+		notify(c)
+		return nil
+	})
+}
+
+// notify wakes up the dev loop, unless it's already about to wake up.
+func notify(c chan<- bool) {
+	select {
+	case c <- true:
+	default:
+	}
+}
+
+// modules returns the image names of the artifacts of each named config module.
+func modules(runCtx *runcontext.RunContext) map[string][]string {
+	m := map[string][]string{}
+	for i, name := range runCtx.Modules() {
+		if name == "" {
+			continue
+		}
+		artifacts := []string{}
+		for _, a := range runCtx.GetPipelines()[i].Build.Artifacts {
+			artifacts = append(artifacts, a.ImageName)
+		}
+		m[name] = append(m[name], artifacts...)
+	}
+	return m
+}
+
+func deployerNames(deployers []namedDeployer) []string {
+	var names []string
+	for _, d := range deployers {
+		names = append(names, d.name)
+	}
+	return names
+}
+
 func isImageLocal(runCtx *runcontext.RunContext, imageName string) (bool, error) {
 	pipeline, found := runCtx.PipelineForImage(imageName)
 	if !found {
@@ -229,47 +319,133 @@ func getTester(cfg test.Config, isLocalImage func(imageName string) (bool, error
 	return tester, nil
 }
 
-func getSyncer(cfg sync.Config) sync.Syncer {
-	return sync.NewSyncer(cfg)
+// getSyncer returns a syncer for the pods of every kube-context that the pipelines deploy to.
+func getSyncer(runCtx *runcontext.RunContext) sync.Syncer {
+	kubeContexts := runCtx.KubeContexts()
+	if len(kubeContexts) <= 1 {
+		return sync.NewSyncer(runCtx)
+	}
+
+	var cfgs []sync.Config
+	for _, kubeContext := range kubeContexts {
+		cfgs = append(cfgs, runCtx.ForKubeContext(kubeContext))
+	}
+	return sync.NewSyncerForClusters(cfgs)
+}
+
+// namedDeployer is a deployer with the name it's addressed by through the API.
+type namedDeployer struct {
+	name   string
+	module string
+	// validator checks the rendered manifests before they are deployed, if validation is configured.
+	validator *manifest.Validator
+	// resourceSelector selects the custom resources transformed by the deployer, if any.
+	resourceSelector *manifest.ResourceSelector
+	deploy.Deployer
 }
 
-func getDeployer(runCtx *runcontext.RunContext, labels map[string]string) (deploy.Deployer, error) {
+// getDeployer creates a deployer from a given RunContext. It also returns the deployers it's made of,
+// named after their type and prefixed by the name of their config module if there's one.
+// The deployers stamp the annotations returned by `annotations`, if it's not nil, on the resources they deploy.
+func getDeployer(runCtx *runcontext.RunContext, labels map[string]string, annotations func() map[string]string) (deploy.Deployer, []namedDeployer, error) {
 	deployerCfg := runCtx.Deployers()
+	validations := runCtx.Validations()
+	modules := runCtx.Modules()
+	pipelines := runCtx.GetPipelines()
+	multiCluster := len(runCtx.KubeContexts()) > 1
 
 	var deployers deploy.DeployerMux
-	for _, d := range deployerCfg {
+	var named []namedDeployer
+	seen := map[string]int{}
+	var transformer *manifest.Transformer
+	var validator *manifest.Validator
+	add := func(module, deployerType string, d deploy.Deployer) {
+		name := deployerType
+		if module != "" {
+			name = module + "/" + deployerType
+		}
+		seen[name]++
+		if seen[name] > 1 {
+			name = fmt.Sprintf("%s-%d", name, seen[name])
+		}
+		deployers = append(deployers, d)
+		named = append(named, namedDeployer{name: name, module: module, validator: validator, resourceSelector: transformer.ResourceSelector(), Deployer: d})
+	}
+
+	for i, d := range deployerCfg {
+		// Deployers of configs that target other clusters use their own kube-context
+		deployCtx := runCtx
+		if multiCluster {
+			deployCtx = runCtx.ForKubeContext(runCtx.KubeContextForPipeline(pipelines[i]))
+		}
+
+		// Deployers may download manifests when they are created
+		if runCtx.HermeticRender() {
+			if err := checkHermetic(d); err != nil {
+				return nil, nil, fmt.Errorf("can't render hermetically: %w", err)
+			}
+		}
+
+		selector, err := manifest.NewResourceSelector(pipelines[i].ResourceSelector.Allow)
+		if err != nil {
+			return nil, nil, fmt.Errorf("configuring resource selector: %w", err)
+		}
+		if transformer, err = manifest.NewTransformer(deployTransforms(runCtx, pipelines[i]), selector, annotations); err != nil {
+			return nil, nil, fmt.Errorf("configuring manifest transforms: %w", err)
+		}
+
+		validator = nil
+		if validations[i] != nil {
+			if validator, err = manifest.NewValidator(*validations[i]); err != nil {
+				return nil, nil, fmt.Errorf("configuring manifest validation: %w", err)
+			}
+		}
+
 		if d.HelmDeploy != nil {
-			h, err := helm.NewDeployer(runCtx, labels, d.HelmDeploy)
+			h, err := helm.NewDeployer(deployCtx, labels, d.HelmDeploy, transformer, validator)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
-			deployers = append(deployers, h)
+			add(modules[i], "helm", h)
 		}
 
 		if d.KptDeploy != nil {
-			deployers = append(deployers, kpt.NewDeployer(runCtx, labels, d.KptDeploy))
+			add(modules[i], "kpt", kpt.NewDeployer(deployCtx, labels, d.KptDeploy, transformer, validator))
 		}
 
 		if d.KubectlDeploy != nil {
-			deployer, err := kubectl.NewDeployer(runCtx, labels, d.KubectlDeploy)
+			deployer, err := kubectl.NewDeployer(deployCtx, labels, d.KubectlDeploy, transformer, validator)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
-			deployers = append(deployers, deployer)
+			add(modules[i], "kubectl", deployer)
 		}
 
 		if d.KustomizeDeploy != nil {
-			deployer, err := kustomize.NewDeployer(runCtx, labels, d.KustomizeDeploy)
+			deployer, err := kustomize.NewDeployer(deployCtx, labels, d.KustomizeDeploy, transformer, validator)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
-			deployers = append(deployers, deployer)
+			add(modules[i], "kustomize", deployer)
 		}
 	}
 	// avoid muxing overhead when only a single deployer is configured
 	if len(deployers) == 1 {
-		return deployers[0], nil
+		return deployers[0], named, nil
 	}
 
-	return deployers, nil
+	return deployers, named, nil
+}
+
+// deployTransforms returns the transforms of a pipeline's deploy config, followed by
+// the ones that set the resource TTL and move all the resources to the ephemeral namespace.
+func deployTransforms(runCtx *runcontext.RunContext, p latest.Pipeline) []latest.ManifestTransform {
+	transforms := p.Deploy.Transforms
+	if ttl := runCtx.ResourceTTL(); ttl > 0 {
+		transforms = append(transforms[:len(transforms):len(transforms)], latest.ManifestTransform{Annotations: map[string]string{label.TTLAnnotation: ttl.String()}})
+	}
+	if ns := runCtx.GetEphemeralNamespace(); ns != "" {
+		transforms = append(transforms[:len(transforms):len(transforms)], latest.ManifestTransform{Namespace: ns})
+	}
+	return transforms
 }
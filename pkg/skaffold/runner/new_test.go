@@ -17,14 +17,22 @@ limitations under the License.
 package runner
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/helm"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kpt"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kustomize"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
@@ -63,7 +71,7 @@ func TestGetDeployer(tOuter *testing.T) {
 					Pipelines: runcontext.NewPipelines([]latest.Pipeline{{}}),
 				}, nil, &latest.KubectlDeploy{
 					Flags: latest.KubectlFlags{},
-				})).(deploy.Deployer),
+				}, nil, nil)).(deploy.Deployer),
 			},
 			{
 				description: "kustomize deployer",
@@ -72,12 +80,12 @@ func TestGetDeployer(tOuter *testing.T) {
 					Pipelines: runcontext.NewPipelines([]latest.Pipeline{{}}),
 				}, nil, &latest.KustomizeDeploy{
 					Flags: latest.KubectlFlags{},
-				})).(deploy.Deployer),
+				}, nil, nil)).(deploy.Deployer),
 			},
 			{
 				description: "kpt deployer",
 				cfg:         latest.DeployType{KptDeploy: &latest.KptDeploy{}},
-				expected:    kpt.NewDeployer(&runcontext.RunContext{}, nil, &latest.KptDeploy{}),
+				expected:    kpt.NewDeployer(&runcontext.RunContext{}, nil, &latest.KptDeploy{}, nil, nil),
 			},
 			{
 				description: "multiple deployers",
@@ -88,7 +96,7 @@ func TestGetDeployer(tOuter *testing.T) {
 				helmVersion: `version.BuildInfo{Version:"v3.0.0"}`,
 				expected: deploy.DeployerMux{
 					&helm.Deployer{},
-					kpt.NewDeployer(&runcontext.RunContext{}, nil, &latest.KptDeploy{}),
+					kpt.NewDeployer(&runcontext.RunContext{}, nil, &latest.KptDeploy{}, nil, nil),
 				},
 			},
 		}
@@ -101,13 +109,13 @@ func TestGetDeployer(tOuter *testing.T) {
 					))
 				}
 
-				deployer, err := getDeployer(&runcontext.RunContext{
+				deployer, _, err := getDeployer(&runcontext.RunContext{
 					Pipelines: runcontext.NewPipelines([]latest.Pipeline{{
 						Deploy: latest.DeployConfig{
 							DeployType: test.cfg,
 						},
 					}}),
-				}, nil)
+				}, nil, nil)
 
 				t.CheckError(test.shouldErr, err)
 				t.CheckTypeEquality(test.expected, deployer)
@@ -124,3 +132,125 @@ func TestGetDeployer(tOuter *testing.T) {
 		}
 	})
 }
+
+func TestGetDeployerNames(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		_, deployers, err := getDeployer(&runcontext.RunContext{
+			Pipelines: runcontext.NewPipelines([]latest.Pipeline{
+				{Deploy: latest.DeployConfig{DeployType: latest.DeployType{KubectlDeploy: &latest.KubectlDeploy{}, KptDeploy: &latest.KptDeploy{}}}},
+				{Deploy: latest.DeployConfig{DeployType: latest.DeployType{KubectlDeploy: &latest.KubectlDeploy{}}}},
+			}),
+		}, nil, nil)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"kpt", "kubectl", "kubectl-2"}, deployerNames(deployers))
+	})
+}
+
+func TestDeployTransforms(t *testing.T) {
+	labels := latest.ManifestTransform{Labels: map[string]string{"team": "web"}}
+	tests := []struct {
+		description        string
+		transforms         []latest.ManifestTransform
+		resourceTTL        time.Duration
+		ephemeralNamespace string
+		expected           []latest.ManifestTransform
+	}{
+		{
+			description: "none",
+		},
+		{
+			description: "transforms of the pipeline",
+			transforms:  []latest.ManifestTransform{labels},
+			expected:    []latest.ManifestTransform{labels},
+		},
+		{
+			description:        "resource ttl and ephemeral namespace come last",
+			transforms:         []latest.ManifestTransform{labels},
+			resourceTTL:        time.Hour,
+			ephemeralNamespace: "skaffold-bob",
+			expected: []latest.ManifestTransform{
+				labels,
+				{Annotations: map[string]string{label.TTLAnnotation: "1h0m0s"}},
+				{Namespace: "skaffold-bob"},
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			runCtx := &runcontext.RunContext{
+				Opts:               config.SkaffoldOptions{ResourceTTL: test.resourceTTL},
+				EphemeralNamespace: test.ephemeralNamespace,
+			}
+
+			transforms := deployTransforms(runCtx, latest.Pipeline{Deploy: latest.DeployConfig{Transforms: test.transforms}})
+
+			t.CheckDeepEqual(test.expected, transforms)
+		})
+	}
+}
+
+func TestGetDeployerTransformsPerPipeline(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.NewTempDir().
+			Write("web.yaml", "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: web").
+			Chdir()
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRunOutErr("kubectl version --client -ojson", "", errors.New("not found")).
+			AndRunOutErr("kubectl version --client -ojson", "", errors.New("not found")))
+
+		_, deployers, err := getDeployer(&runcontext.RunContext{
+			Pipelines: runcontext.NewPipelines([]latest.Pipeline{
+				{Deploy: latest.DeployConfig{
+					DeployType: latest.DeployType{KubectlDeploy: &latest.KubectlDeploy{Manifests: []string{"web.yaml"}}},
+					Transforms: []latest.ManifestTransform{{Labels: map[string]string{"team": "web"}}},
+				}},
+				{Deploy: latest.DeployConfig{
+					DeployType: latest.DeployType{KubectlDeploy: &latest.KubectlDeploy{Manifests: []string{"web.yaml"}}},
+				}},
+			}),
+		}, nil, nil)
+		t.RequireNoError(err)
+
+		var withTransforms, withoutTransforms bytes.Buffer
+		t.CheckNoError(deployers[0].Render(context.Background(), &withTransforms, nil, true, ""))
+		t.CheckNoError(deployers[1].Render(context.Background(), &withoutTransforms, nil, true, ""))
+
+		t.CheckContains("team: web", withTransforms.String())
+		t.CheckFalse(strings.Contains(withoutTransforms.String(), "team: web"))
+	})
+}
+
+func TestGetDeployerResourceSelectorPerPipeline(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.NewTempDir().
+			Write("rollout.yaml", "apiVersion: argoproj.io/v1alpha1\nkind: Rollout\nmetadata:\n  name: web\nspec:\n  image: app").
+			Chdir()
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRunOutErr("kubectl version --client -ojson", "", errors.New("not found")).
+			AndRunOutErr("kubectl version --client -ojson", "", errors.New("not found")))
+
+		_, deployers, err := getDeployer(&runcontext.RunContext{
+			Pipelines: runcontext.NewPipelines([]latest.Pipeline{
+				{
+					Deploy: latest.DeployConfig{DeployType: latest.DeployType{KubectlDeploy: &latest.KubectlDeploy{Manifests: []string{"rollout.yaml"}}}},
+					ResourceSelector: latest.ResourceSelectorConfig{
+						Allow: []latest.ResourceFilter{{GroupKind: "Rollout.argoproj.io", Image: []string{".spec.image"}}},
+					},
+				},
+				{
+					Deploy: latest.DeployConfig{DeployType: latest.DeployType{KubectlDeploy: &latest.KubectlDeploy{Manifests: []string{"rollout.yaml"}}}},
+				},
+			}),
+		}, nil, nil)
+		t.RequireNoError(err)
+
+		builds := []build.Artifact{{ImageName: "app", Tag: "app:123"}}
+		var withSelector, withoutSelector bytes.Buffer
+		t.CheckNoError(deployers[0].Render(context.Background(), &withSelector, builds, true, ""))
+		t.CheckNoError(deployers[1].Render(context.Background(), &withoutSelector, builds, true, ""))
+
+		t.CheckContains("image: app:123", withSelector.String())
+		t.CheckFalse(strings.Contains(withoutSelector.String(), "app:123"))
+	})
+}
@@ -27,11 +27,22 @@ func (r *SkaffoldRunner) createForwarder(out io.Writer) *portforward.ForwarderMa
 		return nil
 	}
 
-	return portforward.NewForwarderManager(out,
-		r.kubectlCLI,
-		r.podSelector,
-		r.runCtx.GetNamespaces(),
-		r.labeller.RunIDSelector(),
-		r.runCtx.Opts.PortForward,
-		r.runCtx.PortForwardResources())
+	var forwarderManager *portforward.ForwarderManager
+	for _, c := range r.clusters() {
+		m := portforward.NewForwarderManager(out,
+			c.kubectlCLI,
+			r.podSelector,
+			c.runCtx.GetNamespaces(),
+			r.labeller.RunIDSelector(),
+			r.runCtx.Opts.PortForward,
+			c.runCtx.PortForwardResources(),
+			c.kubeContext)
+		if forwarderManager == nil {
+			forwarderManager = m
+		} else {
+			// Clusters share the local ports
+			forwarderManager.Add(m)
+		}
+	}
+	return forwarderManager
 }
@@ -17,16 +17,32 @@ limitations under the License.
 package runner
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/helm"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kpt"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kustomize"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
 
 func (r *SkaffoldRunner) Render(ctx context.Context, out io.Writer, builds []build.Artifact, offline bool, filepath string) error {
+	hermetic := r.runCtx.HermeticRender()
+	if hermetic {
+		if r.runCtx.DigestSource() == remoteDigestSource {
+			return errors.New("--digest-source=remote needs access to the registry and can't be used with --hermetic")
+		}
+		offline = true
+	}
+
 	// Fetch the digest and append it to the tag with the format of "tag@digest"
 	if r.runCtx.DigestSource() == remoteDigestSource {
 		for i, a := range builds {
@@ -40,5 +56,104 @@ func (r *SkaffoldRunner) Render(ctx context.Context, out io.Writer, builds []bui
 	if r.runCtx.DigestSource() == noneDigestSource {
 		color.Default.Fprintln(out, "--digest-source set to 'none', tags listed in Kubernetes manifests will be used for render")
 	}
-	return r.deployer.Render(ctx, out, builds, offline, filepath)
+
+	builds, err := r.applyImageReference(ctx, builds, nil)
+	if err != nil {
+		return err
+	}
+
+	dir := r.runCtx.RenderOutputDir()
+	if dir == "" && !hermetic && !r.validatesManifests() {
+		return r.deployer.Render(ctx, out, builds, offline, filepath)
+	}
+
+	modules, err := r.renderModules(ctx, builds, offline)
+	if err != nil {
+		return err
+	}
+	if dir != "" {
+		return writeHydrated(out, dir, modules, manifest.HydrateOptions{
+			Kustomization: r.runCtx.RenderKustomization(),
+			Builds:        builds,
+		})
+	}
+
+	var manifests manifest.ManifestList
+	for _, m := range modules {
+		manifests = append(manifests, m.Manifests...)
+	}
+	if hermetic {
+		if manifests, err = manifests.SortResources(); err != nil {
+			return err
+		}
+	}
+	return manifest.Write(manifests.String(), filepath, out)
+}
+
+// renderModules renders the manifests of each deployer separately so that they can be grouped by module.
+func (r *SkaffoldRunner) renderModules(ctx context.Context, builds []build.Artifact, offline bool) ([]manifest.HydratedModule, error) {
+	var modules []manifest.HydratedModule
+	for _, d := range r.deployers {
+		var buf bytes.Buffer
+		if err := d.Render(ctx, &buf, builds, offline, "" /* never write to files */); err != nil {
+			return nil, err
+		}
+
+		manifests, err := manifest.Load(&buf)
+		if err != nil {
+			return nil, fmt.Errorf("reading manifests rendered by %s: %w", d.name, err)
+		}
+		if d.validator != nil {
+			if err := d.validator.Validate(manifests); err != nil {
+				return nil, fmt.Errorf("validating manifests of %s: %w", d.name, err)
+			}
+		}
+		modules = append(modules, manifest.HydratedModule{Name: d.module, Manifests: manifests, ResourceSelector: d.resourceSelector})
+	}
+	return modules, nil
+}
+
+// validatesManifests returns true if any deployer has validation configured.
+func (r *SkaffoldRunner) validatesManifests() bool {
+	for _, d := range r.deployers {
+		if d.validator != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func writeHydrated(out io.Writer, dir string, modules []manifest.HydratedModule, opts manifest.HydrateOptions) error {
+	index, err := manifest.WriteHydrated(dir, modules, opts)
+	if err != nil {
+		return err
+	}
+
+	color.Default.Fprintf(out, "Wrote %d resources to %s\n", len(index.Files), dir)
+	return nil
+}
+
+// checkHermetic returns an error if any of the given deployers would need network access to render.
+func checkHermetic(d latest.DeployType) error {
+	if d.HelmDeploy != nil {
+		if err := helm.CheckHermetic(d.HelmDeploy); err != nil {
+			return fmt.Errorf("helm: %w", err)
+		}
+	}
+	if d.KptDeploy != nil {
+		if err := kpt.CheckHermetic(d.KptDeploy); err != nil {
+			return fmt.Errorf("kpt: %w", err)
+		}
+	}
+	if d.KubectlDeploy != nil {
+		if err := kubectl.CheckHermetic(d.KubectlDeploy); err != nil {
+			return fmt.Errorf("kubectl: %w", err)
+		}
+	}
+	if d.KustomizeDeploy != nil {
+		if err := kustomize.CheckHermetic(d.KustomizeDeploy); err != nil {
+			return fmt.Errorf("kustomize: %w", err)
+		}
+	}
+	return nil
 }
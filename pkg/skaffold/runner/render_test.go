@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type renderingDeployer struct {
+	*TestBench
+	manifests string
+}
+
+func (d *renderingDeployer) Render(_ context.Context, out io.Writer, _ []build.Artifact, _ bool, _ string) error {
+	_, err := fmt.Fprintln(out, d.manifests)
+	return err
+}
+
+func TestRenderOutputDir(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		dir := t.NewTempDir()
+		testBench := &TestBench{}
+		runner := createRunner(t, testBench, nil, nil)
+		runner.runCtx.Opts.RenderOutputDir = dir.Root()
+		runner.deployers = []namedDeployer{
+			{name: "frontend/kubectl", module: "frontend", Deployer: &renderingDeployer{TestBench: testBench, manifests: "apiVersion: v1\nkind: Service\nmetadata:\n  name: web\n  namespace: ns\n---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: web\n  namespace: ns"}},
+			{name: "helm", Deployer: &renderingDeployer{TestBench: testBench, manifests: "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: ns"}},
+		}
+
+		var out bytes.Buffer
+		err := runner.Render(context.Background(), &out, nil, true, "")
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(fmt.Sprintf("Wrote 3 resources to %s\n", dir.Root()), out.String())
+		for _, file := range []string{"_cluster/namespace/ns.yaml", "frontend/ns/configmap/web.yaml", "frontend/ns/service/web.yaml", "skaffold-render.json"} {
+			_, err := os.Stat(dir.Path(file))
+			t.CheckNoError(err)
+		}
+	})
+}
+
+func TestRenderHermetic(t *testing.T) {
+	tests := []struct {
+		description    string
+		digestSource   string
+		imageReference string
+		builds         []build.Artifact
+		expected       string
+		shouldErr      bool
+	}{
+		{
+			description: "sorted resources",
+			builds:      []build.Artifact{{ImageName: "web", Tag: "web:v1"}},
+			expected:    "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: ns\n---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: web\n  namespace: ns\n---\napiVersion: v1\nkind: Service\nmetadata:\n  name: web\n  namespace: ns\n",
+		},
+		{
+			description:  "remote digest source",
+			digestSource: "remote",
+			shouldErr:    true,
+		},
+		{
+			description:    "digest to resolve",
+			imageReference: "digest",
+			builds:         []build.Artifact{{ImageName: "web", Tag: "web:v1"}},
+			shouldErr:      true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			testBench := &TestBench{}
+			runner := createRunner(t, testBench, nil, nil)
+			runner.runCtx.Opts.RenderHermetic = true
+			runner.runCtx.Opts.DigestSource = test.digestSource
+			runner.runCtx.Opts.ImageReference = test.imageReference
+			runner.deployers = []namedDeployer{
+				{name: "kubectl", Deployer: &renderingDeployer{TestBench: testBench, manifests: "apiVersion: v1\nkind: Service\nmetadata:\n  name: web\n  namespace: ns\n---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: web\n  namespace: ns"}},
+				{name: "helm", Deployer: &renderingDeployer{TestBench: testBench, manifests: "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: ns"}},
+			}
+
+			var out bytes.Buffer
+			err := runner.Render(context.Background(), &out, test.builds, false, "")
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, out.String())
+		})
+	}
+}
+
+func TestRenderValidatesManifests(t *testing.T) {
+	tests := []struct {
+		description string
+		manifests   string
+		shouldErr   bool
+	}{
+		{
+			description: "valid",
+			manifests:   "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: web\ndata:\n  key: value",
+		},
+		{
+			description: "invalid",
+			manifests:   "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: web\ndata: [value]",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			validator, err := manifest.NewValidator(latest.ValidateConfig{})
+			t.CheckNoError(err)
+			testBench := &TestBench{}
+			runner := createRunner(t, testBench, nil, nil)
+			runner.deployers = []namedDeployer{
+				{name: "kubectl", validator: validator, Deployer: &renderingDeployer{TestBench: testBench, manifests: test.manifests}},
+			}
+
+			var out bytes.Buffer
+			err = runner.Render(context.Background(), &out, nil, true, "")
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.manifests+"\n", out.String())
+			}
+		})
+	}
+}
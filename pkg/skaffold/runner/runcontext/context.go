@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
@@ -38,6 +39,7 @@ type RunContext struct {
 	Pipelines          Pipelines
 	KubeContext        string
 	Namespaces         []string
+	EphemeralNamespace string
 	WorkingDir         string
 	InsecureRegistries map[string]bool
 	Cluster            config.Cluster
@@ -47,6 +49,7 @@ type RunContext struct {
 type Pipelines struct {
 	pipelines            []latest.Pipeline
 	pipelinesByImageName map[string]latest.Pipeline
+	modules              []string
 }
 
 // All returns all config pipelines.
@@ -65,6 +68,27 @@ func (ps Pipelines) Select(imageName string) (latest.Pipeline, bool) {
 	return p, found
 }
 
+// Modules returns the names of the config modules, in the order of the pipelines.
+// Configs without a name have an empty module name.
+func (ps Pipelines) Modules() []string {
+	if ps.modules == nil {
+		return make([]string, len(ps.pipelines))
+	}
+	return ps.modules
+}
+
+// ModuleForImage returns the name of the config module that defines the artifact `imageName`.
+func (ps Pipelines) ModuleForImage(imageName string) string {
+	for i, p := range ps.pipelines {
+		for _, a := range p.Build.Artifacts {
+			if a.ImageName == imageName {
+				return ps.Modules()[i]
+			}
+		}
+	}
+	return ""
+}
+
 func (ps Pipelines) PortForwardResources() []*latest.PortForwardResource {
 	var pf []*latest.PortForwardResource
 	for _, p := range ps.pipelines {
@@ -89,6 +113,15 @@ func (ps Pipelines) Deployers() []latest.DeployType {
 	return deployers
 }
 
+// Validations returns the `validate` configuration of each pipeline, nil when it's not configured.
+func (ps Pipelines) Validations() []*latest.ValidateConfig {
+	var validations []*latest.ValidateConfig
+	for _, p := range ps.pipelines {
+		validations = append(validations, p.Deploy.Validate)
+	}
+	return validations
+}
+
 func (ps Pipelines) TestCases() []*latest.TestCase {
 	var tests []*latest.TestCase
 	for _, p := range ps.pipelines {
@@ -117,6 +150,11 @@ func NewPipelines(pipelines []latest.Pipeline) Pipelines {
 	return Pipelines{pipelines: pipelines, pipelinesByImageName: m}
 }
 
+// EphemeralNamespaceConfig returns the configuration of the ephemeral namespace, nil when it's not configured.
+func (rc *RunContext) EphemeralNamespaceConfig() *latest.EphemeralNamespace {
+	return ephemeralNamespaceConfig(rc.Pipelines.All())
+}
+
 func (rc *RunContext) PipelineForImage(imageName string) (latest.Pipeline, bool) {
 	return rc.Pipelines.Select(imageName)
 }
@@ -127,8 +165,16 @@ func (rc *RunContext) PortForwardResources() []*latest.PortForwardResource {
 
 func (rc *RunContext) Artifacts() []*latest.Artifact { return rc.Pipelines.Artifacts() }
 
+func (rc *RunContext) Modules() []string { return rc.Pipelines.Modules() }
+
+func (rc *RunContext) ModuleForImage(imageName string) string {
+	return rc.Pipelines.ModuleForImage(imageName)
+}
+
 func (rc *RunContext) Deployers() []latest.DeployType { return rc.Pipelines.Deployers() }
 
+func (rc *RunContext) Validations() []*latest.ValidateConfig { return rc.Pipelines.Validations() }
+
 func (rc *RunContext) TestCases() []*latest.TestCase { return rc.Pipelines.TestCases() }
 
 func (rc *RunContext) StatusCheckDeadlineSeconds() int {
@@ -138,6 +184,7 @@ func (rc *RunContext) StatusCheckDeadlineSeconds() int {
 func (rc *RunContext) DefaultPipeline() latest.Pipeline          { return rc.Pipelines.Head() }
 func (rc *RunContext) GetKubeContext() string                    { return rc.KubeContext }
 func (rc *RunContext) GetNamespaces() []string                   { return rc.Namespaces }
+func (rc *RunContext) GetEphemeralNamespace() string             { return rc.EphemeralNamespace }
 func (rc *RunContext) GetPipelines() []latest.Pipeline           { return rc.Pipelines.All() }
 func (rc *RunContext) GetInsecureRegistries() map[string]bool    { return rc.InsecureRegistries }
 func (rc *RunContext) GetWorkingDir() string                     { return rc.WorkingDir }
@@ -149,24 +196,34 @@ func (rc *RunContext) AutoSync() bool                            { return rc.Opt
 func (rc *RunContext) CacheArtifacts() bool                      { return rc.Opts.CacheArtifacts }
 func (rc *RunContext) CacheFile() string                         { return rc.Opts.CacheFile }
 func (rc *RunContext) ConfigurationFile() string                 { return rc.Opts.ConfigurationFile }
+func (rc *RunContext) ConfigurationFilter() []string             { return rc.Opts.ConfigurationFilter }
 func (rc *RunContext) CustomLabels() []string                    { return rc.Opts.CustomLabels }
 func (rc *RunContext) CustomTag() string                         { return rc.Opts.CustomTag }
 func (rc *RunContext) DefaultRepo() *string                      { return rc.Opts.DefaultRepo.Value() }
 func (rc *RunContext) Mode() config.RunMode                      { return rc.Opts.Mode() }
+func (rc *RunContext) Profiles() []string                        { return rc.Opts.Profiles }
 func (rc *RunContext) DigestSource() string                      { return rc.Opts.DigestSource }
 func (rc *RunContext) DryRun() bool                              { return rc.Opts.DryRun }
+func (rc *RunContext) ImageReference() string                    { return rc.Opts.ImageReference }
 func (rc *RunContext) ForceDeploy() bool                         { return rc.Opts.Force }
 func (rc *RunContext) GetKubeConfig() string                     { return rc.Opts.KubeConfig }
 func (rc *RunContext) GetKubeNamespace() string                  { return rc.Opts.Namespace }
 func (rc *RunContext) GlobalConfig() string                      { return rc.Opts.GlobalConfig }
 func (rc *RunContext) MinikubeProfile() string                   { return rc.Opts.MinikubeProfile }
 func (rc *RunContext) Muted() config.Muted                       { return rc.Opts.Muted }
+func (rc *RunContext) Logs() config.LogsOptions                  { return rc.Opts.Logs }
 func (rc *RunContext) NoPruneChildren() bool                     { return rc.Opts.NoPruneChildren }
 func (rc *RunContext) Notification() bool                        { return rc.Opts.Notification }
 func (rc *RunContext) PortForward() bool                         { return rc.Opts.PortForward.Enabled }
 func (rc *RunContext) Prune() bool                               { return rc.Opts.Prune() }
+func (rc *RunContext) RemoteCacheOnly() bool                     { return rc.Opts.RemoteCacheOnly }
 func (rc *RunContext) RenderOnly() bool                          { return rc.Opts.RenderOnly }
 func (rc *RunContext) RenderOutput() string                      { return rc.Opts.RenderOutput }
+func (rc *RunContext) RenderOutputDir() string                   { return rc.Opts.RenderOutputDir }
+func (rc *RunContext) RenderKustomization() bool                 { return rc.Opts.RenderKustomization }
+func (rc *RunContext) HermeticRender() bool                      { return rc.Opts.RenderHermetic }
+func (rc *RunContext) RepoCacheDir() string                      { return rc.Opts.RepoCacheDir }
+func (rc *RunContext) ResourceTTL() time.Duration                { return rc.Opts.ResourceTTL }
 func (rc *RunContext) SkipRender() bool                          { return rc.Opts.SkipRender }
 func (rc *RunContext) SkipTests() bool                           { return rc.Opts.SkipTests }
 func (rc *RunContext) StatusCheck() bool                         { return rc.Opts.StatusCheck }
@@ -175,7 +232,14 @@ func (rc *RunContext) Trigger() string                           { return rc.Opt
 func (rc *RunContext) WaitForDeletions() config.WaitForDeletions { return rc.Opts.WaitForDeletions }
 func (rc *RunContext) WatchPollInterval() int                    { return rc.Opts.WatchPollInterval }
 
-func GetRunContext(opts config.SkaffoldOptions, pipelines []latest.Pipeline) (*RunContext, error) {
+func GetRunContext(opts config.SkaffoldOptions, configs []*latest.SkaffoldConfig) (*RunContext, error) {
+	var pipelines []latest.Pipeline
+	var modules []string
+	for _, cfg := range configs {
+		pipelines = append(pipelines, cfg.Pipeline)
+		modules = append(modules, cfg.Metadata.Name)
+	}
+
 	kubeConfig, err := kubectx.CurrentConfig()
 	if err != nil {
 		return nil, fmt.Errorf("getting current cluster context: %w", err)
@@ -189,6 +253,23 @@ func GetRunContext(opts config.SkaffoldOptions, pipelines []latest.Pipeline) (*R
 		return nil, fmt.Errorf("finding current directory: %w", err)
 	}
 
+	// The ephemeral namespace is used as if it was set with `--namespace`
+	var ephemeralNamespace string
+	if cfg := ephemeralNamespaceConfig(pipelines); cfg != nil {
+		if opts.Namespace != "" {
+			logrus.Infof("Ignoring the ephemeral namespace since the namespace is set to %q", opts.Namespace)
+		} else {
+			ephemeralNamespace = opts.EphemeralNamespace
+			if ephemeralNamespace == "" {
+				if ephemeralNamespace, err = ephemeralNamespaceName(*cfg, cwd); err != nil {
+					return nil, err
+				}
+			}
+			logrus.Infof("Using ephemeral namespace: %s", ephemeralNamespace)
+			opts.Namespace = ephemeralNamespace
+		}
+	}
+
 	namespaces, err := runnerutil.GetAllPodNamespaces(opts.Namespace, pipelines)
 	if err != nil {
 		return nil, fmt.Errorf("getting namespace list: %w", err)
@@ -210,6 +291,7 @@ func GetRunContext(opts config.SkaffoldOptions, pipelines []latest.Pipeline) (*R
 		insecureRegistries[r] = true
 	}
 	ps := NewPipelines(pipelines)
+	ps.modules = modules
 
 	// TODO(https://github.com/GoogleContainerTools/skaffold/issues/3668):
 	// remove minikubeProfile from here and instead detect it by matching the
@@ -225,6 +307,7 @@ func GetRunContext(opts config.SkaffoldOptions, pipelines []latest.Pipeline) (*R
 		WorkingDir:         cwd,
 		KubeContext:        kubeContext,
 		Namespaces:         namespaces,
+		EphemeralNamespace: ephemeralNamespace,
 		InsecureRegistries: insecureRegistries,
 		Cluster:            cluster,
 	}, nil
@@ -251,3 +334,45 @@ func (rc *RunContext) UpdateNamespaces(ns []string) {
 	sort.Strings(updated)
 	rc.Namespaces = updated
 }
+
+// KubeContexts returns the distinct kube-contexts that the pipelines deploy to, in the order of the pipelines.
+// Pipelines that don't set `deploy.kubeContext` use the active kube-context, as do all the pipelines
+// when the kube-context is set on the command line.
+func (rc *RunContext) KubeContexts() []string {
+	var kubeContexts []string
+	seen := map[string]bool{}
+	for _, p := range rc.Pipelines.All() {
+		kubeContext := rc.KubeContextForPipeline(p)
+		if !seen[kubeContext] {
+			seen[kubeContext] = true
+			kubeContexts = append(kubeContexts, kubeContext)
+		}
+	}
+	return kubeContexts
+}
+
+// ForKubeContext returns a copy of the RunContext restricted to the pipelines that deploy to the given kube-context.
+func (rc *RunContext) ForKubeContext(kubeContext string) *RunContext {
+	var pipelines []latest.Pipeline
+	var modules []string
+	for i, p := range rc.Pipelines.All() {
+		if rc.KubeContextForPipeline(p) == kubeContext {
+			pipelines = append(pipelines, p)
+			modules = append(modules, rc.Pipelines.Modules()[i])
+		}
+	}
+
+	scoped := *rc
+	scoped.KubeContext = kubeContext
+	scoped.Pipelines = NewPipelines(pipelines)
+	scoped.Pipelines.modules = modules
+	return &scoped
+}
+
+// KubeContextForPipeline returns the kube-context that a pipeline deploys to.
+func (rc *RunContext) KubeContextForPipeline(p latest.Pipeline) string {
+	if rc.Opts.KubeContext == "" && p.Deploy.KubeContext != "" {
+		return p.Deploy.KubeContext
+	}
+	return rc.KubeContext
+}
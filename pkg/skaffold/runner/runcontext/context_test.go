@@ -19,6 +19,8 @@ package runcontext
 import (
 	"testing"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -90,3 +92,60 @@ func TestRunContext_UpdateNamespaces(t *testing.T) {
 		})
 	}
 }
+
+func TestRunContext_KubeContexts(t *testing.T) {
+	pipeline := func(kubeContext string) latest.Pipeline {
+		return latest.Pipeline{Deploy: latest.DeployConfig{KubeContext: kubeContext}}
+	}
+	tests := []struct {
+		description     string
+		cliKubeContext  string
+		pipelines       []latest.Pipeline
+		modules         []string
+		expected        []string
+		expectedModules map[string][]string
+	}{
+		{
+			description:     "single pipeline",
+			pipelines:       []latest.Pipeline{pipeline("")},
+			modules:         []string{"app"},
+			expected:        []string{"active"},
+			expectedModules: map[string][]string{"active": {"app"}},
+		},
+		{
+			description:     "pipelines targeting different kube-contexts",
+			pipelines:       []latest.Pipeline{pipeline(""), pipeline("other"), pipeline("active"), pipeline("other")},
+			modules:         []string{"app1", "app2", "app3", "app4"},
+			expected:        []string{"active", "other"},
+			expectedModules: map[string][]string{"active": {"app1", "app3"}, "other": {"app2", "app4"}},
+		},
+		{
+			description:     "kube-context set on the command line",
+			cliKubeContext:  "active",
+			pipelines:       []latest.Pipeline{pipeline(""), pipeline("other")},
+			modules:         []string{"app1", "app2"},
+			expected:        []string{"active"},
+			expectedModules: map[string][]string{"active": {"app1", "app2"}},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			ps := NewPipelines(test.pipelines)
+			ps.modules = test.modules
+			runCtx := &RunContext{
+				Opts:        config.SkaffoldOptions{KubeContext: test.cliKubeContext},
+				Pipelines:   ps,
+				KubeContext: "active",
+			}
+
+			kubeContexts := runCtx.KubeContexts()
+
+			t.CheckDeepEqual(test.expected, kubeContexts)
+			for _, kubeContext := range kubeContexts {
+				scoped := runCtx.ForKubeContext(kubeContext)
+				t.CheckDeepEqual(kubeContext, scoped.GetKubeContext())
+				t.CheckDeepEqual(test.expectedModules[kubeContext], scoped.Modules())
+			}
+		})
+	}
+}
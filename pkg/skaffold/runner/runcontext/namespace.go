@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runcontext
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+const (
+	defaultEphemeralNamespacePrefix = "skaffold-"
+	maxNamespaceLength              = 63
+)
+
+var (
+	invalidNamespaceChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+	// for testing
+	currentUser   = util.CurrentUsername
+	currentBranch = currentGitBranch
+	currentRunID  = func() string { return label.NewLabeller(false, nil).GetRunID() }
+)
+
+// ephemeralNamespaceConfig returns the `ephemeralNamespace` configuration of the first pipeline that sets it.
+func ephemeralNamespaceConfig(pipelines []latest.Pipeline) *latest.EphemeralNamespace {
+	for _, p := range pipelines {
+		if p.Deploy.EphemeralNamespace != nil {
+			return p.Deploy.EphemeralNamespace
+		}
+	}
+	return nil
+}
+
+// ephemeralNamespaceName derives the name of the ephemeral namespace of a session.
+func ephemeralNamespaceName(cfg latest.EphemeralNamespace, workingDir string) (string, error) {
+	var suffix string
+	var err error
+	switch cfg.NameFrom {
+	case "", "user":
+		suffix, err = currentUser()
+	case "branch":
+		suffix, err = currentBranch(workingDir)
+	case "runId":
+		suffix = currentRunID()
+	default:
+		return "", fmt.Errorf("unknown value %q for `nameFrom`, expected `user`, `branch` or `runId`", cfg.NameFrom)
+	}
+	if err != nil {
+		return "", fmt.Errorf("naming the ephemeral namespace: %w", err)
+	}
+
+	prefix := cfg.Prefix
+	if prefix == "" {
+		prefix = defaultEphemeralNamespacePrefix
+	}
+	name := sanitizeNamespace(prefix + suffix)
+	if name == "" {
+		return "", fmt.Errorf("invalid ephemeral namespace name %q", prefix+suffix)
+	}
+	return name, nil
+}
+
+// sanitizeNamespace turns a string into a valid namespace name, which is a DNS-1123 label.
+func sanitizeNamespace(name string) string {
+	name = invalidNamespaceChars.ReplaceAllString(strings.ToLower(name), "-")
+	if len(name) > maxNamespaceLength {
+		name = name[:maxNamespaceLength]
+	}
+	return strings.Trim(name, "-")
+}
+
+func currentGitBranch(workingDir string) (string, error) {
+	cmd := exec.Command("git", "rev-parse", "--abbrev-ref", "HEAD")
+	cmd.Dir = workingDir
+	out, err := util.RunCmdOut(cmd)
+	if err != nil {
+		return "", fmt.Errorf("getting the current git branch: %w", err)
+	}
+	branch := strings.TrimSpace(string(out))
+	if branch == "HEAD" {
+		return "", errors.New("the git HEAD is detached")
+	}
+	return branch, nil
+}
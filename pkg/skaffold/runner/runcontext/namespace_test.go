@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runcontext
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestEphemeralNamespaceName(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         latest.EphemeralNamespace
+		branchErr   error
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "user by default",
+			expected:    "skaffold-jane-doe",
+		},
+		{
+			description: "branch with custom prefix",
+			cfg:         latest.EphemeralNamespace{NameFrom: "branch", Prefix: "dev-"},
+			expected:    "dev-feature-login-page",
+		},
+		{
+			description: "run id",
+			cfg:         latest.EphemeralNamespace{NameFrom: "runId"},
+			expected:    "skaffold-0f6a2c1e-5d4b-4c3a-9b8e-7f6d5c4b3a21",
+		},
+		{
+			description: "truncated to a valid namespace name",
+			cfg:         latest.EphemeralNamespace{NameFrom: "branch", Prefix: "a-very-long-prefix-for-the-namespaces-of-the-team--"},
+			expected:    "a-very-long-prefix-for-the-namespaces-of-the-team--feature-logi",
+		},
+		{
+			description: "not on a branch",
+			cfg:         latest.EphemeralNamespace{NameFrom: "branch"},
+			branchErr:   errors.New("the git HEAD is detached"),
+			shouldErr:   true,
+		},
+		{
+			description: "unknown source",
+			cfg:         latest.EphemeralNamespace{NameFrom: "host"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&currentUser, func() (string, error) { return "Jane.Doe", nil })
+			t.Override(&currentBranch, func(string) (string, error) { return "feature/Login_Page", test.branchErr })
+			t.Override(&currentRunID, func() string { return "0f6a2c1e-5d4b-4c3a-9b8e-7f6d5c4b3a21" })
+
+			name, err := ephemeralNamespaceName(test.cfg, ".")
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, name)
+		})
+	}
+}
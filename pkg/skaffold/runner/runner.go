@@ -80,6 +80,14 @@ type SkaffoldRunner struct {
 	hasDeployed  bool
 	intents      *intents
 	devIteration int
+	// deployers are the deployers that can be run individually through the API
+	deployers []namedDeployer
+	// ephemeralNamespaceCreated is true once the ephemeral namespace of the session has been created
+	ephemeralNamespaceCreated bool
+	// ephemeralNamespaceCreatedIn are the kube-contexts whose ephemeral namespace was created by this session
+	ephemeralNamespaceCreatedIn map[string]bool
+	// expiredResourcesCollected is true once the expired resources of previous sessions have been deleted
+	expiredResourcesCollected bool
 }
 
 // for testing
@@ -32,6 +32,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kustomize"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/defaults"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -43,6 +44,7 @@ import (
 
 type Actions struct {
 	Built    []string
+	Retagged []string
 	Synced   []string
 	Tested   []string
 	Deployed []string
@@ -97,6 +99,12 @@ func (t *TestBench) TestDependencies() ([]string, error)              { return n
 func (t *TestBench) Dependencies() ([]string, error)                  { return nil, nil }
 func (t *TestBench) Cleanup(ctx context.Context, out io.Writer) error { return nil }
 func (t *TestBench) Prune(ctx context.Context, out io.Writer) error   { return nil }
+func (t *TestBench) Retag(_ context.Context, _ io.Writer, artifacts []build.Artifact, tags tag.AdditionalTags) error {
+	for _, artifact := range artifacts {
+		t.currentActions.Retagged = append(t.currentActions.Retagged, tags[artifact.ImageName]...)
+	}
+	return nil
+}
 
 func (t *TestBench) enterNewCycle() {
 	t.actions = append(t.actions, t.currentActions)
@@ -419,6 +427,53 @@ func TestNewForConfig(t *testing.T) {
 	}
 }
 
+func TestNewForConfigResetsTransforms(t *testing.T) {
+	tests := []struct {
+		description string
+		command     string
+		expected    int
+	}{
+		{
+			description: "dev",
+			command:     "dev",
+		},
+		{
+			description: "debugging transforms are added once",
+			command:     "debug",
+			expected:    1,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetupFakeKubernetesContext(api.Config{CurrentContext: "cluster1"})
+			t.Override(&util.DefaultExecCommand, testutil.CmdRunWithOutput("kubectl version --client -ojson", "v1.5.6"))
+			manifest.ResetTransforms()
+			runCtx := &runcontext.RunContext{
+				Pipelines: runcontext.NewPipelines([]latest.Pipeline{{
+					Build: latest.BuildConfig{
+						TagPolicy: latest.TagPolicy{ShaTagger: &latest.ShaTagger{}},
+						BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}},
+					},
+					Deploy: latest.DeployConfig{
+						DeployType: latest.DeployType{KubectlDeploy: &latest.KubectlDeploy{}},
+						Transforms: []latest.ManifestTransform{{Labels: map[string]string{"team": "web"}}},
+					},
+				}}),
+				Opts: config.SkaffoldOptions{Trigger: "polling", Command: test.command},
+			}
+
+			// `dev` re-creates the runner when the config changes
+			for i := 0; i < 2; i++ {
+				_, err := NewForConfig(runCtx)
+				t.CheckNoError(err)
+			}
+
+			t.CheckDeepEqual(test.expected, len(manifest.GetTransforms()))
+			manifest.ResetTransforms()
+		})
+	}
+}
+
 func TestTriggerCallbackAndIntents(t *testing.T) {
 	var tests = []struct {
 		description          string
@@ -73,6 +73,36 @@ type Pipeline struct {
 
 	// PortForward describes user defined resources to port-forward.
 	PortForward []*PortForwardResource `yaml:"portForward,omitempty"`
+
+	// ResourceSelector *alpha* describes the custom resources whose images are replaced and that are labelled by Skaffold.
+	ResourceSelector ResourceSelectorConfig `yaml:"resourceSelector,omitempty"`
+}
+
+// ResourceSelectorConfig *alpha* describes the custom resources whose images are replaced and that are labelled by Skaffold,
+// next to the built-in Kubernetes workloads.
+type ResourceSelectorConfig struct {
+	// Allow lists the custom resource kinds that Skaffold transforms.
+	Allow []ResourceFilter `yaml:"allow,omitempty"`
+}
+
+// ResourceFilter describes where Skaffold finds the images and the metadata of a custom resource kind.
+// Paths are JSONPath-like, with `*` matching all the items of a list or all the fields of an object.
+type ResourceFilter struct {
+	// GroupKind is the kind and the API group of the resource.
+	// For example: `Rollout.argoproj.io`.
+	GroupKind string `yaml:"groupKind" yamltags:"required"`
+
+	// Image lists the paths of the image fields, whatever their name. A path can also point to the items of a list of images.
+	// For example: `.spec.template.spec.containers.*.image` or `.spec.images.*`.
+	Image []string `yaml:"image,omitempty"`
+
+	// Labels lists the paths of the metadata that get Skaffold's labels, next to the resource's own metadata.
+	// For example: `.spec.template.metadata`.
+	Labels []string `yaml:"labels,omitempty"`
+
+	// Recursive transforms every image and metadata field of the resource, like for the built-in workloads.
+	// Image and label paths are then ignored.
+	Recursive bool `yaml:"recursive,omitempty"`
 }
 
 // GitInfo contains information on the origin of skaffold configurations cloned from a git repository.
@@ -88,6 +118,55 @@ type GitInfo struct {
 
 	// Sync when set to `true` will reset the cached repository to the latest commit from remote on every run. To use the cached repository with uncommitted changes or unpushed commits, it needs to be set to `false`.
 	Sync *bool `yaml:"sync,omitempty"`
+
+	// Commit is the full git commit SHA the package should be cloned at. The checked out commit is verified against this value on every run. When set, `ref` is ignored.
+	Commit string `yaml:"commit,omitempty"`
+
+	// Sparse when set to `true` only checks out the directory containing the skaffold configuration file referenced by `path`, which avoids downloading the entire contents of large repositories.
+	Sparse bool `yaml:"sparse,omitempty"`
+
+	// Auth describes the credentials used to access the git repository.
+	Auth *GitAuth `yaml:"auth,omitempty"`
+}
+
+// GitAuth describes the credentials used to access a remote git repository.
+type GitAuth struct {
+	// TokenEnv is the name of an environment variable containing an access token used for HTTPS authentication. e.g. `GITHUB_TOKEN`.
+	TokenEnv string `yaml:"tokenEnv,omitempty"`
+
+	// Username is the user name presented along with the access token read from `tokenEnv`. Defaults to `git`.
+	Username string `yaml:"username,omitempty"`
+
+	// CredentialHelper is the git credential helper used to retrieve credentials for the repository. e.g. `store` or `gcloud.sh`.
+	CredentialHelper string `yaml:"credentialHelper,omitempty"`
+
+	// SSHKey is the path to a private key used for SSH authentication. e.g. `~/.ssh/id_ed25519`.
+	SSHKey string `yaml:"sshKey,omitempty"`
+}
+
+// OCIArtifactInfo contains information on the origin of skaffold configurations pulled from an OCI artifact.
+// The layers of the artifact are extracted on top of each other, like the filesystem of a container image.
+type OCIArtifactInfo struct {
+	// Image is the reference to the artifact, either by tag or by digest. e.g. `gcr.io/k8s-skaffold/modules:v1.2.0`.
+	Image string `yaml:"image" yamltags:"required"`
+
+	// Path is the relative path from the artifact root to the skaffold configuration file. eg. `getting-started/skaffold.yaml`.
+	Path string `yaml:"path,omitempty"`
+
+	// Digest pins the content of the artifact. The digest `image` resolves to is verified against this value. e.g. `sha256:9f3e...`.
+	Digest string `yaml:"digest,omitempty"`
+}
+
+// ArchiveInfo contains information on the origin of skaffold configurations downloaded as a tarball.
+type ArchiveInfo struct {
+	// URL is the location of the tarball, optionally gzipped. e.g. `https://example.com/modules-v1.2.0.tar.gz`.
+	URL string `yaml:"url" yamltags:"required"`
+
+	// SHA256 is the expected checksum of the tarball.
+	SHA256 string `yaml:"sha256" yamltags:"required"`
+
+	// Path is the relative path from the tarball root to the skaffold configuration file. eg. `getting-started/skaffold.yaml`.
+	Path string `yaml:"path,omitempty"`
 }
 
 // ConfigDependency describes a dependency on another skaffold configuration.
@@ -101,6 +180,12 @@ type ConfigDependency struct {
 	// GitRepo describes a remote git repository containing the required configs.
 	GitRepo *GitInfo `yaml:"git,omitempty" yamltags:"oneOf=paths"`
 
+	// OCIArtifact describes an OCI artifact in a container registry containing the required configs.
+	OCIArtifact *OCIArtifactInfo `yaml:"oci,omitempty" yamltags:"oneOf=paths"`
+
+	// Archive describes a remote tarball containing the required configs.
+	Archive *ArchiveInfo `yaml:"archive,omitempty" yamltags:"oneOf=paths"`
+
 	// ActiveProfiles describes the list of profiles to activate when resolving the required configs. These profiles must exist in the imported config.
 	ActiveProfiles []ProfileDependency `yaml:"activeProfiles,omitempty"`
 }
@@ -177,6 +262,12 @@ type TagPolicy struct {
 
 	// CustomTemplateTagger *beta* tags images with a configurable template string *composed of other taggers*.
 	CustomTemplateTagger *CustomTemplateTagger `yaml:"customTemplate,omitempty" yamltags:"oneOf=tag"`
+
+	// GitDescribeTagger *alpha* tags images with the nearest annotated git tag, as described by `git describe`.
+	GitDescribeTagger *GitDescribeTagger `yaml:"gitDescribe,omitempty" yamltags:"oneOf=tag"`
+
+	// SemVerTagger *alpha* tags images with the semantic version of the nearest annotated git tag.
+	SemVerTagger *SemVerTagger `yaml:"semver,omitempty" yamltags:"oneOf=tag"`
 }
 
 // ShaTagger *beta* tags images with their sha256 digest.
@@ -222,6 +313,37 @@ type DateTimeTagger struct {
 	TimeZone string `yaml:"timezone,omitempty"`
 }
 
+// GitDescribeTagger *alpha* tags images with the nearest annotated git tag, as described by `git describe`.
+// Commits after the tag are tagged like `v1.4.2-3-g1a2b3c4`.
+type GitDescribeTagger struct {
+	// Match only considers the git tags matching this glob pattern.
+	// For example: `v*`.
+	Match string `yaml:"match,omitempty"`
+
+	// IgnoreChanges specifies whether to omit the `-dirty` postfix if there are uncommitted changes.
+	IgnoreChanges bool `yaml:"ignoreChanges,omitempty"`
+}
+
+// SemVerTagger *alpha* tags images with the semantic version of the nearest annotated git tag.
+// A tagged commit `v1.4.2` is tagged `1.4.2`. Commits after the tag, or uncommitted changes, are tagged
+// with a pre-release of the next patch version and the commit in the build metadata, like `1.4.3-dev.3_g1a2b3c4`.
+type SemVerTagger struct {
+	// Match only considers the git tags matching this glob pattern.
+	// For example: `v*`.
+	Match string `yaml:"match,omitempty"`
+
+	// PreRelease is the pre-release identifier for commits that aren't tagged.
+	// Defaults to `dev`.
+	PreRelease string `yaml:"preRelease,omitempty"`
+
+	// Aliases specifies whether released versions are also tagged with their minor and major versions.
+	// For example: `1.4` and `1` for `1.4.2`.
+	Aliases bool `yaml:"aliases,omitempty"`
+
+	// IgnoreChanges specifies whether uncommitted changes are ignored.
+	IgnoreChanges bool `yaml:"ignoreChanges,omitempty"`
+}
+
 // CustomTemplateTagger *beta* tags images with a configurable template string.
 type CustomTemplateTagger struct {
 	// Template used to produce the image name and tag.
@@ -488,11 +610,154 @@ type DeployConfig struct {
 	StatusCheckDeadlineSeconds int `yaml:"statusCheckDeadlineSeconds,omitempty"`
 
 	// KubeContext is the Kubernetes context that Skaffold should deploy to.
+	// Configs can deploy to different contexts. The `--kube-context` flag overrides it for all of them.
 	// For example: `minikube`.
 	KubeContext string `yaml:"kubeContext,omitempty"`
 
 	// Logs configures how container logs are printed as a result of a deployment.
 	Logs LogsConfig `yaml:"logs,omitempty"`
+
+	// Validate *alpha* checks the rendered manifests against Kubernetes schemas and policies before they are deployed.
+	Validate *ValidateConfig `yaml:"validate,omitempty"`
+
+	// Transforms *alpha* are applied in order to the manifests rendered by every deployer, before they are validated and deployed.
+	Transforms []ManifestTransform `yaml:"transforms,omitempty"`
+
+	// EphemeralNamespace *alpha* creates a namespace for the Skaffold session, deploys all the resources into it
+	// and deletes it on cleanup. It's ignored when `--namespace` is set.
+	EphemeralNamespace *EphemeralNamespace `yaml:"ephemeralNamespace,omitempty"`
+}
+
+// EphemeralNamespace *alpha* configures the namespace created for a Skaffold session.
+type EphemeralNamespace struct {
+	// NameFrom is what the name of the namespace is derived from:
+	// `user` for the current user, `branch` for the current git branch or `runId` for a new namespace on each session.
+	// Defaults to `user`.
+	NameFrom string `yaml:"nameFrom,omitempty"`
+
+	// Prefix is prepended to the name of the namespace. Defaults to `skaffold-`.
+	Prefix string `yaml:"prefix,omitempty"`
+
+	// TemplateNamespace is the namespace that secrets and config maps are copied from.
+	TemplateNamespace string `yaml:"templateNamespace,omitempty"`
+
+	// Secrets are the names of the secrets copied from the template namespace.
+	Secrets []string `yaml:"secrets,omitempty"`
+
+	// ConfigMaps are the names of the config maps copied from the template namespace.
+	ConfigMaps []string `yaml:"configMaps,omitempty"`
+}
+
+// ManifestTransform *alpha* changes the rendered manifests. Only one of its fields can be set.
+type ManifestTransform struct {
+	// Namespace sets the namespace of all the namespaced resources.
+	Namespace string `yaml:"namespace,omitempty" yamltags:"oneOf=transform"`
+
+	// Labels are added to all the resources and to the pods they create.
+	Labels map[string]string `yaml:"labels,omitempty" yamltags:"oneOf=transform"`
+
+	// Annotations are added to all the resources.
+	Annotations map[string]string `yaml:"annotations,omitempty" yamltags:"oneOf=transform"`
+
+	// Patch modifies the resources that match its target.
+	Patch *ManifestPatch `yaml:"patch,omitempty" yamltags:"oneOf=transform"`
+
+	// Sidecar adds a container to the pods of the resources that match its target.
+	Sidecar *SidecarTransform `yaml:"sidecar,omitempty" yamltags:"oneOf=transform"`
+
+	// EnvFile is a `.env` file of `KEY=VALUE` lines. The containers' environment variables named after a key get its value.
+	EnvFile string `yaml:"envFile,omitempty" skaffold:"filepath" yamltags:"oneOf=transform"`
+
+	// Function runs a KRM function on all the resources.
+	Function *KRMFunction `yaml:"function,omitempty" yamltags:"oneOf=transform"`
+}
+
+// TransformTarget selects the resources a transform applies to. Empty fields match all the resources.
+type TransformTarget struct {
+	// Kind is the kind of the resources.
+	// For example: `Deployment`.
+	Kind string `yaml:"kind,omitempty"`
+
+	// Name is the name of the resources.
+	Name string `yaml:"name,omitempty"`
+
+	// Namespace is the namespace of the resources.
+	Namespace string `yaml:"namespace,omitempty"`
+
+	// Labels are the labels the resources must have.
+	Labels map[string]string `yaml:"labels,omitempty"`
+}
+
+// ManifestPatch *alpha* patches the resources that match its target.
+type ManifestPatch struct {
+	// Target selects the resources to patch.
+	Target TransformTarget `yaml:"target,omitempty"`
+
+	// Type is the type of patch: `strategic` for a strategic merge patch or `json` for a JSON patch (RFC 6902).
+	// Strategic merge patches of custom resources are applied as JSON merge patches.
+	// Defaults to `strategic`.
+	Type string `yaml:"type,omitempty"`
+
+	// Patch is the patch, in yaml or json.
+	Patch string `yaml:"patch,omitempty" yamltags:"oneOf=patch"`
+
+	// Path is the path to a file containing the patch.
+	Path string `yaml:"path,omitempty" skaffold:"filepath" yamltags:"oneOf=patch"`
+}
+
+// SidecarTransform *alpha* adds a container to the pods of the resources that match its target.
+type SidecarTransform struct {
+	// Target selects the resources to add the container to.
+	Target TransformTarget `yaml:"target,omitempty"`
+
+	// Container is the definition of the container. It replaces any container with the same name.
+	Container util.HelmOverrides `yaml:"container,omitempty" yamltags:"required"`
+
+	// Init adds the container to the init containers.
+	Init bool `yaml:"init,omitempty"`
+}
+
+// KRMFunction *alpha* is a function that transforms a list of resources, following the KRM functions specification.
+// The function reads a `ResourceList` on stdin and writes the transformed `ResourceList` to stdout.
+type KRMFunction struct {
+	// Image is a container image that runs the function. It must be available locally. The container runs without network access.
+	Image string `yaml:"image,omitempty" yamltags:"oneOf=function"`
+
+	// Exec is the path to an executable that runs the function.
+	Exec string `yaml:"exec,omitempty" skaffold:"filepath" yamltags:"oneOf=function"`
+
+	// Config is the `functionConfig` passed to the function.
+	Config util.HelmOverrides `yaml:"config,omitempty"`
+}
+
+// ValidateConfig *alpha* configures how rendered manifests are validated before they are deployed.
+type ValidateConfig struct {
+	// KubernetesVersion is the `major.minor` Kubernetes version to validate resources against.
+	// Skaffold bundles the schemas of Kubernetes 1.20, other versions need a `kubernetesSchema`.
+	// Defaults to the version of the `kubernetesSchema`, or to 1.20.
+	KubernetesVersion string `yaml:"kubernetesVersion,omitempty"`
+
+	// KubernetesSchema is the path to the OpenAPI document (`swagger.json`) of the Kubernetes version to validate against.
+	KubernetesSchema string `yaml:"kubernetesSchema,omitempty" skaffold:"filepath"`
+
+	// CRDs are files or glob patterns of files that contain the `CustomResourceDefinition`s that custom resources are validated against.
+	// Definitions that are part of the rendered manifests are used as well.
+	CRDs []string `yaml:"crds,omitempty" skaffold:"filepath"`
+
+	// IgnoreUnknownKinds skips resources without a schema instead of reporting them.
+	IgnoreUnknownKinds bool `yaml:"ignoreUnknownKinds,omitempty"`
+
+	// Policies are rules that resources must follow.
+	Policies ValidationPolicies `yaml:"policies,omitempty"`
+}
+
+// ValidationPolicies are rules that rendered resources must follow.
+type ValidationPolicies struct {
+	// RequireResourceLimits requires every container to set cpu and memory limits.
+	RequireResourceLimits bool `yaml:"requireResourceLimits,omitempty"`
+
+	// ForbidLatestTag forbids images that are referenced without a tag or digest, or with the `latest` tag.
+	ForbidLatestTag bool `yaml:"forbidLatestTag,omitempty"`
 }
 
 // DeployType contains the specific implementation and parameters needed
@@ -628,12 +893,13 @@ type KptFn struct {
 	SinkDir string `yaml:"sinkDir,omitempty" skaffold:"filepath"`
 }
 
-// KptLive adds additional configurations used when calling `kpt live`.
+// KptLive adds additional configurations used when applying the resources,
+// which Skaffold does like `kpt live` without requiring the `kpt` binary.
 type KptLive struct {
 	// Apply sets the kpt inventory directory.
 	Apply KptApplyInventory `yaml:"apply,omitempty"`
 
-	// Options adds additional configurations for `kpt live apply` commands.
+	// Options adds additional configurations for applying the resources.
 	Options KptApplyOptions `yaml:"options,omitempty"`
 }
 
@@ -652,7 +918,7 @@ type KptApplyInventory struct {
 	InventoryNamespace string `yaml:"inventoryNamespace,omitempty"`
 }
 
-// KptApplyOptions adds additional configurations used when calling `kpt live apply`.
+// KptApplyOptions adds additional configurations used when applying the resources, like `kpt live apply`.
 type KptApplyOptions struct {
 	// PollPeriod sets for the polling period for resource statuses. Default to 2s.
 	PollPeriod string `yaml:"pollPeriod,omitempty"`
@@ -793,6 +1059,51 @@ type LogsConfig struct {
 	// `none`: don't add a prefix.
 	// Defaults to `auto`.
 	Prefix string `yaml:"prefix,omitempty"`
+
+	// Filter selects the containers and the log lines that are shown.
+	Filter *LogsFilter `yaml:"filter,omitempty"`
+
+	// JSONParse configures how log lines that are JSON objects are printed.
+	// When not set, JSON log lines are printed as-is.
+	JSONParse *JSONParseConfig `yaml:"jsonParse,omitempty"`
+
+	// Dir is a directory where the full logs of each container are also written,
+	// in a `<pod>_<container>.log` file. Filters don't apply to these files.
+	Dir string `yaml:"dir,omitempty"`
+}
+
+// LogsFilter selects the containers and the log lines that are shown.
+type LogsFilter struct {
+	// Images are patterns for the images of the containers whose logs are shown.
+	// Follows the same matching rules as profile activations: prefixing with `!` negates the match.
+	// Defaults to all the images.
+	// For example: `["leeroy-web"]`.
+	Images []string `yaml:"images,omitempty"`
+
+	// Containers are patterns for the names of the containers whose logs are shown.
+	// Follows the same matching rules as `images`. Defaults to all the containers.
+	Containers []string `yaml:"containers,omitempty"`
+
+	// Include are regular expressions for the log lines that are shown. A line is shown if it matches any of them.
+	Include []string `yaml:"include,omitempty"`
+
+	// Exclude are regular expressions for the log lines that are hidden. A line is hidden if it matches any of them.
+	Exclude []string `yaml:"exclude,omitempty"`
+
+	// Level is the minimum level of the JSON structured log lines that are shown.
+	// The level is read from the `level`, `severity` or `lvl` field. Lines without a level are always shown.
+	// Valid values are `trace`, `debug`, `info`, `warn`, `error` and `fatal`.
+	// For example: `warn`.
+	Level string `yaml:"level,omitempty"`
+}
+
+// JSONParseConfig configures how log lines that are JSON objects are printed.
+type JSONParseConfig struct {
+	// Fields are the fields of the JSON object that are printed, in order. The `msg` and `message`
+	// fields are printed as plain text, other fields as `key=value`.
+	// Defaults to the message followed by all the other fields, sorted by name.
+	// For example: `["level", "msg", "error"]`.
+	Fields []string `yaml:"fields,omitempty"`
 }
 
 // Artifact are the items that need to be built, along with the context in which
@@ -817,6 +1128,12 @@ type Artifact struct {
 
 	// Dependencies describes build artifacts that this artifact depends on.
 	Dependencies []*ArtifactDependency `yaml:"requires,omitempty"`
+
+	// AdditionalTags *alpha* lists tags applied to the image after it's built, next to the tag generated by the tag policy.
+	// They are pushed along with the image but never used in deployed manifests.
+	// Each tag can reference environment variables and `{{.IMAGE_NAME}}`.
+	// For example: `["latest", "{{.BRANCH}}"]`.
+	AdditionalTags []string `yaml:"additionalTags,omitempty"`
 }
 
 // Sync *beta* specifies what files to sync into the container.
@@ -911,6 +1228,32 @@ type Activation struct {
 	// Command is a Skaffold command for which the profile is auto-activated.
 	// For example: `dev`.
 	Command string `yaml:"command,omitempty"`
+
+	// GitBranch is a pattern for the git branch checked out in the directory of the
+	// `skaffold.yaml` for which the profile is auto-activated. It follows the same
+	// matching rules as `env`. For example: `release-.*`.
+	GitBranch string `yaml:"gitBranch,omitempty"`
+
+	// FileExists is a file or directory whose presence auto-activates the profile.
+	// Relative paths are resolved against the directory of the `skaffold.yaml`.
+	// If the path starts with `!`, activation happens if the file is _not_ present.
+	// For example: `.env.local`.
+	FileExists string `yaml:"fileExists,omitempty"`
+
+	// ClusterType is a pattern for the type of the cluster of the current
+	// Kubernetes context for which the profile is auto-activated. The detected type
+	// is one of `kind`, `k3d`, `minikube`, `docker-desktop` or `remote`. It follows
+	// the same matching rules as `env`. For example: `kind|k3d`.
+	ClusterType string `yaml:"clusterType,omitempty"`
+
+	// AnyOf auto-activates the profile if at least one of the nested conditions is met.
+	AnyOf []Activation `yaml:"anyOf,omitempty"`
+
+	// AllOf auto-activates the profile if all of the nested conditions are met.
+	AllOf []Activation `yaml:"allOf,omitempty"`
+
+	// Not auto-activates the profile if the nested condition is _not_ met.
+	Not *Activation `yaml:"not,omitempty"`
 }
 
 // ArtifactType describes how to build an artifact.
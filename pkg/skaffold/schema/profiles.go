@@ -19,13 +19,17 @@ package schema
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 
 	yamlpatch "github.com/krishicks/yaml-patch"
 	"github.com/sirupsen/logrus"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/cluster"
 	cfg "github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/git"
 	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
@@ -37,6 +41,12 @@ import (
 // ApplyProfiles modifies the input skaffold configuration by the application
 // of a list of profiles, and returns the list of applied profiles.
 func ApplyProfiles(c *latest.SkaffoldConfig, opts cfg.SkaffoldOptions, namedProfiles []string) ([]string, error) {
+	return ApplyProfilesWithTrace(c, opts, namedProfiles, nil)
+}
+
+// ApplyProfilesWithTrace is like ApplyProfiles, but additionally calls `trace` after each profile
+// overlay and each profile patch is applied, with a description of what was applied.
+func ApplyProfilesWithTrace(c *latest.SkaffoldConfig, opts cfg.SkaffoldOptions, namedProfiles []string, trace func(source string) error) ([]string, error) {
 	byName := profilesByName(c.Profiles)
 
 	profiles, contextSpecificProfiles, err := activatedProfiles(c.Profiles, opts, namedProfiles)
@@ -49,7 +59,7 @@ func ApplyProfiles(c *latest.SkaffoldConfig, opts cfg.SkaffoldOptions, namedProf
 			return nil, fmt.Errorf("couldn't find profile %s", name)
 		}
 
-		if err := applyProfile(c, profile); err != nil {
+		if err := applyProfile(c, profile, trace); err != nil {
 			return nil, fmt.Errorf("applying profile %q: %w", name, err)
 		}
 	}
@@ -90,28 +100,30 @@ func activatedProfiles(profiles []latest.Profile, opts cfg.SkaffoldOptions, name
 	var contextSpecificProfiles []string
 
 	if opts.ProfileAutoActivation {
+		// relative paths and the git branch are resolved against the directory of the skaffold config.
+		var dir string
+		if !skutil.IsURL(opts.ConfigurationFile) {
+			dir = filepath.Dir(opts.ConfigurationFile)
+		}
+
 		// Auto-activated profiles
 		for _, profile := range profiles {
-			for _, cond := range profile.Activation {
-				command := isCommand(cond.Command, opts)
-
-				env, err := isEnv(cond.Env)
+			for i, cond := range profile.Activation {
+				met, reason, err := isActivated(cond, opts, dir)
 				if err != nil {
 					return nil, nil, err
 				}
-
-				kubeContext, err := isKubeContext(cond.KubeContext, opts)
-				if err != nil {
-					return nil, nil, err
+				if !met {
+					logrus.Debugf("profile %q not activated by activation %d: %s", profile.Name, i, reason)
+					continue
 				}
 
-				if command && env && kubeContext {
-					if cond.KubeContext != "" {
-						contextSpecificProfiles = append(contextSpecificProfiles, profile.Name)
-					}
-					activated = append(activated, profile.Name)
-					break
+				logrus.Infof("profile %q activated by activation %d: %s", profile.Name, i, reason)
+				if usesKubeContext(cond) {
+					contextSpecificProfiles = append(contextSpecificProfiles, profile.Name)
 				}
+				activated = append(activated, profile.Name)
+				break
 			}
 		}
 	}
@@ -127,6 +139,106 @@ func activatedProfiles(profiles []latest.Profile, opts cfg.SkaffoldOptions, name
 	return activated, contextSpecificProfiles, nil
 }
 
+// activationCheck evaluates a single activation criterion. It returns whether the criterion is met
+// and a description of the state it was evaluated against.
+type activationCheck func(pattern string, opts cfg.SkaffoldOptions, dir string) (bool, string, error)
+
+// isActivated checks if all the criteria of an activation condition are met, and returns a
+// description of why it is or isn't.
+func isActivated(cond latest.Activation, opts cfg.SkaffoldOptions, dir string) (bool, string, error) {
+	criteria := []struct {
+		name    string
+		pattern string
+		check   activationCheck
+	}{
+		{"env", cond.Env, isEnv},
+		{"kubeContext", cond.KubeContext, isKubeContext},
+		{"command", cond.Command, isCommand},
+		{"gitBranch", cond.GitBranch, isGitBranch},
+		{"fileExists", cond.FileExists, isFileExists},
+		{"clusterType", cond.ClusterType, isClusterType},
+	}
+
+	var reasons []string
+	for _, c := range criteria {
+		if c.pattern == "" {
+			continue
+		}
+		met, state, err := c.check(c.pattern, opts, dir)
+		if err != nil {
+			return false, "", err
+		}
+		if !met {
+			return false, fmt.Sprintf("%s %q is not met (%s)", c.name, c.pattern, state), nil
+		}
+		reasons = append(reasons, fmt.Sprintf("%s %q is met (%s)", c.name, c.pattern, state))
+	}
+
+	for _, nested := range cond.AllOf {
+		met, reason, err := isActivated(nested, opts, dir)
+		if err != nil {
+			return false, "", err
+		}
+		if !met {
+			return false, "allOf: " + reason, nil
+		}
+		reasons = append(reasons, "allOf: "+reason)
+	}
+
+	if len(cond.AnyOf) > 0 {
+		var unmet []string
+		for _, nested := range cond.AnyOf {
+			met, reason, err := isActivated(nested, opts, dir)
+			if err != nil {
+				return false, "", err
+			}
+			if met {
+				reasons = append(reasons, "anyOf: "+reason)
+				break
+			}
+			unmet = append(unmet, reason)
+		}
+		if len(unmet) == len(cond.AnyOf) {
+			return false, fmt.Sprintf("anyOf: none of the conditions is met (%s)", strings.Join(unmet, "; ")), nil
+		}
+	}
+
+	if cond.Not != nil {
+		met, reason, err := isActivated(*cond.Not, opts, dir)
+		if err != nil {
+			return false, "", err
+		}
+		if met {
+			return false, "not: " + reason, nil
+		}
+		reasons = append(reasons, "not: "+reason)
+	}
+
+	if len(reasons) == 0 {
+		return true, "no criteria", nil
+	}
+	return true, strings.Join(reasons, " and "), nil
+}
+
+// usesKubeContext checks if an activation condition depends on the current kube-context,
+// either directly or through the type of cluster, which is derived from the kube-context.
+func usesKubeContext(cond latest.Activation) bool {
+	if cond.KubeContext != "" || cond.ClusterType != "" {
+		return true
+	}
+	for _, nested := range cond.AllOf {
+		if usesKubeContext(nested) {
+			return true
+		}
+	}
+	for _, nested := range cond.AnyOf {
+		if usesKubeContext(nested) {
+			return true
+		}
+	}
+	return cond.Not != nil && usesKubeContext(*cond.Not)
+}
+
 func removeValue(values []string, value string) []string {
 	var updated []string
 
@@ -139,57 +251,103 @@ func removeValue(values []string, value string) []string {
 	return updated
 }
 
-func isEnv(env string) (bool, error) {
-	if env == "" {
-		return true, nil
-	}
-
+func isEnv(env string, _ cfg.SkaffoldOptions, _ string) (bool, string, error) {
 	keyValue := strings.SplitN(env, "=", 2)
 	if len(keyValue) != 2 {
-		return false, fmt.Errorf("invalid env variable format: %s, should be KEY=VALUE", env)
+		return false, "", fmt.Errorf("invalid env variable format: %s, should be KEY=VALUE", env)
 	}
 
 	key := keyValue[0]
 	value := keyValue[1]
 
 	envValue := os.Getenv(key)
+	state := fmt.Sprintf("%s=%q", key, envValue)
 
 	// Special case, since otherwise the regex substring check (`re.Compile("").MatchString(envValue)`)
 	// would always match which is most probably not what the user wanted.
 	if value == "" {
-		return envValue == "", nil
+		return envValue == "", state, nil
 	}
 
-	return skutil.RegexEqual(value, envValue), nil
+	return skutil.RegexEqual(value, envValue), state, nil
 }
 
-func isCommand(command string, opts cfg.SkaffoldOptions) bool {
-	if command == "" {
-		return true
+func isCommand(command string, opts cfg.SkaffoldOptions, _ string) (bool, string, error) {
+	return skutil.RegexEqual(command, opts.Command), fmt.Sprintf("command is %q", opts.Command), nil
+}
+
+func isKubeContext(kubeContext string, opts cfg.SkaffoldOptions, _ string) (bool, string, error) {
+	current, err := currentKubeContext(opts)
+	if err != nil {
+		return false, "", err
 	}
 
-	return skutil.RegexEqual(command, opts.Command)
+	return skutil.RegexEqual(kubeContext, current), fmt.Sprintf("kube-context is %q", current), nil
 }
 
-func isKubeContext(kubeContext string, opts cfg.SkaffoldOptions) (bool, error) {
-	if kubeContext == "" {
-		return true, nil
+func isGitBranch(branch string, _ cfg.SkaffoldOptions, dir string) (bool, string, error) {
+	current, err := git.CurrentBranch(dir)
+	if err != nil {
+		logrus.Debugf("unable to read the git branch: %v", err)
+		return false, "not in a git repository", nil
+	}
+
+	return skutil.RegexEqual(branch, current), fmt.Sprintf("git branch is %q", current), nil
+}
+
+func isFileExists(path string, _ cfg.SkaffoldOptions, dir string) (bool, string, error) {
+	negate := strings.HasPrefix(path, "!")
+	path = strings.TrimPrefix(path, "!")
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		return negate, fmt.Sprintf("%s doesn't exist", path), nil
+	}
+	return !negate, fmt.Sprintf("%s exists", path), nil
+}
+
+func isClusterType(clusterType string, opts cfg.SkaffoldOptions, _ string) (bool, string, error) {
+	current, err := currentKubeContext(opts)
+	if err != nil {
+		return false, "", err
 	}
 
+	detected := detectClusterType(current)
+	return skutil.RegexEqual(clusterType, detected), fmt.Sprintf("cluster type of kube-context %q is %q", current, detected), nil
+}
+
+// detectClusterType returns the type of the cluster that `kubeContext` is talking to.
+func detectClusterType(kubeContext string) string {
+	switch {
+	case cfg.IsKindCluster(kubeContext):
+		return "kind"
+	case cfg.IsK3dCluster(kubeContext):
+		return "k3d"
+	case kubeContext == constants.DefaultDockerDesktopContext || kubeContext == constants.DefaultDockerForDesktopContext:
+		return "docker-desktop"
+	case cluster.GetClient().IsMinikube(kubeContext):
+		return "minikube"
+	default:
+		return "remote"
+	}
+}
+
+func currentKubeContext(opts cfg.SkaffoldOptions) (string, error) {
 	// cli flag takes precedence
 	if opts.KubeContext != "" {
-		return skutil.RegexEqual(kubeContext, opts.KubeContext), nil
+		return opts.KubeContext, nil
 	}
 
 	currentKubeConfig, err := kubectx.CurrentConfig()
 	if err != nil {
-		return false, fmt.Errorf("getting current cluster context: %w", err)
+		return "", fmt.Errorf("getting current cluster context: %w", err)
 	}
-
-	return skutil.RegexEqual(kubeContext, currentKubeConfig.CurrentContext), nil
+	return currentKubeConfig.CurrentContext, nil
 }
 
-func applyProfile(config *latest.SkaffoldConfig, profile latest.Profile) error {
+func applyProfile(config *latest.SkaffoldConfig, profile latest.Profile, trace func(string) error) error {
 	logrus.Infof("applying profile: %s", profile.Name)
 
 	// Apply profile, field by field
@@ -203,6 +361,11 @@ func applyProfile(config *latest.SkaffoldConfig, profile latest.Profile) error {
 		merged := overlayProfileField(name, configV.FieldByName(name).Interface(), profileV.FieldByName(name).Interface())
 		mergedV.FieldByName(name).Set(reflect.ValueOf(merged))
 	}
+	if trace != nil {
+		if err := trace(fmt.Sprintf("profile %s", profile.Name)); err != nil {
+			return err
+		}
+	}
 
 	if len(profile.Patches) == 0 {
 		return nil
@@ -241,13 +404,32 @@ func applyProfile(config *latest.SkaffoldConfig, profile latest.Profile) error {
 		patches = append(patches, patch)
 	}
 
-	buf, err = yamlpatch.Patch(patches).Apply(buf)
-	if err != nil {
-		return err
+	if trace == nil {
+		buf, err = yamlpatch.Patch(patches).Apply(buf)
+		if err != nil {
+			return err
+		}
+
+		*config = latest.SkaffoldConfig{}
+		return yaml.Unmarshal(buf, config)
 	}
 
-	*config = latest.SkaffoldConfig{}
-	return yaml.Unmarshal(buf, config)
+	// apply patches one by one so that the changes of each patch can be traced.
+	for i, patch := range patches {
+		buf, err = yamlpatch.Patch([]yamlpatch.Operation{patch}).Apply(buf)
+		if err != nil {
+			return err
+		}
+
+		*config = latest.SkaffoldConfig{}
+		if err := yaml.Unmarshal(buf, config); err != nil {
+			return err
+		}
+		if err := trace(fmt.Sprintf("profile %s patch %d (%s %s)", profile.Name, i, patch.Op, patch.Path)); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // tryPatch is here to verify patches one by one before we
@@ -18,13 +18,16 @@ package schema
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	yamlpatch "github.com/krishicks/yaml-patch"
 	"k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/kaniko"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/cluster"
 	cfg "github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/git"
 	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
@@ -78,6 +81,48 @@ profiles:
 	})
 }
 
+func TestApplyProfilesWithTrace(t *testing.T) {
+	config := `build:
+  artifacts:
+  - image: example
+profiles:
+- name: traced
+  build:
+    tagPolicy:
+      sha256: {}
+  patches:
+  - path: /build/artifacts/0/image
+    value: replacement
+  - op: add
+    path: /build/artifacts/-
+    value:
+      image: second
+`
+
+	testutil.Run(t, "", func(t *testutil.T) {
+		setupFakeKubeConfig(t, api.Config{CurrentContext: "prod-context"})
+		tmpDir := t.NewTempDir().
+			Write("skaffold.yaml", addVersion(config))
+
+		parsed, err := ParseConfig(tmpDir.Path("skaffold.yaml"))
+		t.RequireNoError(err)
+
+		skaffoldConfig := parsed[0].(*latest.SkaffoldConfig)
+		var traced []string
+		var images []int
+		_, err = ApplyProfilesWithTrace(skaffoldConfig, cfg.SkaffoldOptions{}, []string{"traced"}, func(source string) error {
+			traced = append(traced, source)
+			images = append(images, len(skaffoldConfig.Build.Artifacts))
+			return nil
+		})
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"profile traced", "profile traced patch 0 (replace /build/artifacts/0/image)", "profile traced patch 1 (add /build/artifacts/-)"}, traced)
+		t.CheckDeepEqual([]int{1, 1, 2}, images)
+		t.CheckDeepEqual("replacement", skaffoldConfig.Build.Artifacts[0].ImageName)
+		t.CheckDeepEqual("second", skaffoldConfig.Build.Artifacts[1].ImageName)
+	})
+}
+
 func TestApplyInvalidPatch(t *testing.T) {
 	config := `build:
   artifacts:
@@ -769,6 +814,162 @@ func TestActivatedProfiles(t *testing.T) {
 	}
 }
 
+func TestIsActivated(t *testing.T) {
+	tests := []struct {
+		description string
+		cond        latest.Activation
+		kubeContext string
+		branch      string
+		expected    bool
+		reason      string
+	}{
+		{
+			description: "no criteria",
+			expected:    true,
+			reason:      "no criteria",
+		},
+		{
+			description: "git branch",
+			cond:        latest.Activation{GitBranch: "release-.*"},
+			branch:      "release-1.0",
+			expected:    true,
+			reason:      `gitBranch "release-.*" is met (git branch is "release-1.0")`,
+		},
+		{
+			description: "other git branch",
+			cond:        latest.Activation{GitBranch: "release-.*"},
+			branch:      "main",
+			reason:      `gitBranch "release-.*" is not met (git branch is "main")`,
+		},
+		{
+			description: "not a git repository",
+			cond:        latest.Activation{GitBranch: "main"},
+			reason:      `gitBranch "main" is not met (not in a git repository)`,
+		},
+		{
+			description: "file exists",
+			cond:        latest.Activation{FileExists: ".env.local"},
+			expected:    true,
+			reason:      `fileExists ".env.local" is met ({{dir}}/.env.local exists)`,
+		},
+		{
+			description: "file doesn't exist",
+			cond:        latest.Activation{FileExists: "missing"},
+			reason:      `fileExists "missing" is not met ({{dir}}/missing doesn't exist)`,
+		},
+		{
+			description: "negated file existence",
+			cond:        latest.Activation{FileExists: "!missing"},
+			expected:    true,
+			reason:      `fileExists "!missing" is met ({{dir}}/missing doesn't exist)`,
+		},
+		{
+			description: "kind cluster",
+			cond:        latest.Activation{ClusterType: "kind|k3d"},
+			kubeContext: "kind-dev",
+			expected:    true,
+			reason:      `clusterType "kind|k3d" is met (cluster type of kube-context "kind-dev" is "kind")`,
+		},
+		{
+			description: "minikube cluster",
+			cond:        latest.Activation{ClusterType: "minikube"},
+			kubeContext: "minikube",
+			expected:    true,
+			reason:      `clusterType "minikube" is met (cluster type of kube-context "minikube" is "minikube")`,
+		},
+		{
+			description: "remote cluster",
+			cond:        latest.Activation{ClusterType: "!remote"},
+			kubeContext: "gke_project_zone_cluster",
+			reason:      `clusterType "!remote" is not met (cluster type of kube-context "gke_project_zone_cluster" is "remote")`,
+		},
+		{
+			description: "all criteria must be met",
+			cond:        latest.Activation{Command: "dev", GitBranch: "main"},
+			branch:      "feature",
+			reason:      `gitBranch "main" is not met (git branch is "feature")`,
+		},
+		{
+			description: "any of",
+			cond:        latest.Activation{AnyOf: []latest.Activation{{GitBranch: "main"}, {FileExists: ".env.local"}}},
+			branch:      "feature",
+			expected:    true,
+			reason:      `anyOf: fileExists ".env.local" is met ({{dir}}/.env.local exists)`,
+		},
+		{
+			description: "none of any of",
+			cond:        latest.Activation{AnyOf: []latest.Activation{{GitBranch: "main"}, {Command: "run"}}},
+			branch:      "feature",
+			reason:      `anyOf: none of the conditions is met (gitBranch "main" is not met (git branch is "feature"); command "run" is not met (command is "dev"))`,
+		},
+		{
+			description: "all of",
+			cond:        latest.Activation{AllOf: []latest.Activation{{Command: "dev"}, {GitBranch: "main"}}},
+			branch:      "main",
+			expected:    true,
+			reason:      `allOf: command "dev" is met (command is "dev") and allOf: gitBranch "main" is met (git branch is "main")`,
+		},
+		{
+			description: "not",
+			cond:        latest.Activation{Command: "dev", Not: &latest.Activation{ClusterType: "kind"}},
+			kubeContext: "docker-desktop",
+			expected:    true,
+			reason:      `command "dev" is met (command is "dev") and not: clusterType "kind" is not met (cluster type of kube-context "docker-desktop" is "docker-desktop")`,
+		},
+		{
+			description: "negated condition is met",
+			cond:        latest.Activation{Not: &latest.Activation{Command: "dev"}},
+			reason:      `not: command "dev" is met (command is "dev")`,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Touch(".env.local")
+			t.Override(&git.CurrentBranch, func(dir string) (string, error) {
+				if test.branch == "" {
+					return "", fmt.Errorf("not a git repository")
+				}
+				return test.branch, nil
+			})
+			t.Override(&cluster.GetClient, func() cluster.Client { return fakeClusterClient{} })
+
+			opts := cfg.SkaffoldOptions{Command: "dev", KubeContext: test.kubeContext}
+			activated, reason, err := isActivated(test.cond, opts, tmpDir.Root())
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, activated)
+			t.CheckDeepEqual(strings.ReplaceAll(test.reason, "{{dir}}", tmpDir.Root()), reason)
+		})
+	}
+}
+
+type fakeClusterClient struct {
+	cluster.Client
+}
+
+func (fakeClusterClient) IsMinikube(kubeContext string) bool { return kubeContext == "minikube" }
+
+func TestUsesKubeContext(t *testing.T) {
+	tests := []struct {
+		description string
+		cond        latest.Activation
+		expected    bool
+	}{
+		{description: "kube-context", cond: latest.Activation{KubeContext: "minikube"}, expected: true},
+		{description: "cluster type", cond: latest.Activation{ClusterType: "local"}, expected: true},
+		{description: "nested in allOf", cond: latest.Activation{AllOf: []latest.Activation{{Env: "A=B"}, {ClusterType: "local"}}}, expected: true},
+		{description: "nested in anyOf", cond: latest.Activation{AnyOf: []latest.Activation{{KubeContext: "kind"}}}, expected: true},
+		{description: "nested in not", cond: latest.Activation{Not: &latest.Activation{KubeContext: "prod"}}, expected: true},
+		{description: "deeply nested in not", cond: latest.Activation{Not: &latest.Activation{AnyOf: []latest.Activation{{ClusterType: "remote"}}}}, expected: true},
+		{description: "independent of the kube-context", cond: latest.Activation{Env: "A=B", Not: &latest.Activation{Command: "dev"}}},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, usesKubeContext(test.cond))
+		})
+	}
+}
+
 func TestYamlAlias(t *testing.T) {
 	config := `
 .activation_common: &activation_common
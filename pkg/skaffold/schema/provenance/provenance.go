@@ -0,0 +1,193 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package provenance tracks where the values of an effective skaffold configuration come from.
+package provenance
+
+import (
+	"fmt"
+	"strings"
+
+	yamlv3 "gopkg.in/yaml.v3"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// Default is the source of values set by skaffold defaults.
+const Default = "default"
+
+// Tracker records, for each config, the source of every value.
+type Tracker struct {
+	traces map[*latest.SkaffoldConfig]*trace
+	order  []*latest.SkaffoldConfig
+}
+
+type trace struct {
+	file    string
+	values  map[string]string
+	sources map[string]string
+}
+
+// NewTracker creates a Tracker.
+func NewTracker() *Tracker {
+	return &Tracker{traces: make(map[*latest.SkaffoldConfig]*trace)}
+}
+
+// Start begins tracking a config parsed from `file`. All its current values are attributed to that file.
+func (t *Tracker) Start(c *latest.SkaffoldConfig, file string) error {
+	t.traces[c] = &trace{file: file, values: map[string]string{}, sources: map[string]string{}}
+	t.order = append(t.order, c)
+	return t.Observe(c, file)
+}
+
+// Observe attributes every value of the config that changed since the last observation to `source`.
+func (t *Tracker) Observe(c *latest.SkaffoldConfig, source string) error {
+	return t.observe(c, func(tr *trace, path string) { tr.sources[path] = source })
+}
+
+// Refresh records the current values of the config without changing their sources.
+// This is used for transformations, such as making file paths absolute, that don't change where a value comes from.
+func (t *Tracker) Refresh(c *latest.SkaffoldConfig) error {
+	return t.observe(c, func(tr *trace, path string) {
+		if _, found := tr.sources[path]; !found {
+			tr.sources[path] = tr.file
+		}
+	})
+}
+
+func (t *Tracker) observe(c *latest.SkaffoldConfig, changed func(*trace, string)) error {
+	tr, found := t.traces[c]
+	if !found {
+		return fmt.Errorf("config %q isn't tracked", c.Metadata.Name)
+	}
+	node, err := toNode(c)
+	if err != nil {
+		return err
+	}
+	values := map[string]string{}
+	walk(node, "", func(path string, n *yamlv3.Node) { values[path] = leafValue(n) })
+
+	for path, v := range values {
+		if previous, found := tr.values[path]; !found || previous != v {
+			changed(tr, path)
+		}
+	}
+	for path := range tr.sources {
+		if _, found := values[path]; !found {
+			delete(tr.sources, path)
+		}
+	}
+	tr.values = values
+	return nil
+}
+
+// Sources returns the source of each value of a config, keyed by the path of the value. e.g. `build.artifacts[0].image`.
+func (t *Tracker) Sources(c *latest.SkaffoldConfig) map[string]string {
+	tr, found := t.traces[c]
+	if !found {
+		return nil
+	}
+	return tr.sources
+}
+
+// File returns the file a config was parsed from.
+func (t *Tracker) File(c *latest.SkaffoldConfig) string {
+	if tr, found := t.traces[c]; found {
+		return tr.file
+	}
+	return ""
+}
+
+// AnnotatedYAML returns the YAML representation of the configs with the source of each value as a line comment.
+func (t *Tracker) AnnotatedYAML(configs []*latest.SkaffoldConfig) ([]byte, error) {
+	var docs []string
+	for _, c := range configs {
+		node, err := toNode(c)
+		if err != nil {
+			return nil, err
+		}
+		sources := t.Sources(c)
+		walk(node, "", func(path string, n *yamlv3.Node) {
+			if source, found := sources[path]; found {
+				n.LineComment = "from: " + source
+			}
+		})
+		node.HeadComment = "Source: " + t.File(c)
+		buf, err := yaml.Marshal(node)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, string(buf))
+	}
+	return []byte(strings.Join(docs, "---\n")), nil
+}
+
+func toNode(c *latest.SkaffoldConfig) (*yamlv3.Node, error) {
+	buf, err := yaml.Marshal(c)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling config: %w", err)
+	}
+	var doc yamlv3.Node
+	if err := yamlv3.Unmarshal(buf, &doc); err != nil {
+		return nil, fmt.Errorf("unmarshalling config: %w", err)
+	}
+	if doc.Kind == yamlv3.DocumentNode && len(doc.Content) > 0 {
+		return doc.Content[0], nil
+	}
+	return &doc, nil
+}
+
+// walk calls `visit` on every leaf node: scalars, and empty mappings or sequences.
+func walk(n *yamlv3.Node, path string, visit func(string, *yamlv3.Node)) {
+	switch n.Kind {
+	case yamlv3.MappingNode:
+		if len(n.Content) == 0 {
+			visit(path, n)
+			return
+		}
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			key := n.Content[i].Value
+			if path != "" {
+				key = path + "." + key
+			}
+			walk(n.Content[i+1], key, visit)
+		}
+	case yamlv3.SequenceNode:
+		if len(n.Content) == 0 {
+			visit(path, n)
+			return
+		}
+		for i, child := range n.Content {
+			walk(child, fmt.Sprintf("%s[%d]", path, i), visit)
+		}
+	case yamlv3.AliasNode:
+		walk(n.Alias, path, visit)
+	default:
+		visit(path, n)
+	}
+}
+
+func leafValue(n *yamlv3.Node) string {
+	switch n.Kind {
+	case yamlv3.MappingNode:
+		return "{}"
+	case yamlv3.SequenceNode:
+		return "[]"
+	default:
+		return n.Value
+	}
+}
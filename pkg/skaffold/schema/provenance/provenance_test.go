@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provenance
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestTracker(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		c := &latest.SkaffoldConfig{
+			APIVersion: latest.Version,
+			Kind:       "Config",
+			Metadata:   latest.Metadata{Name: "app"},
+			Pipeline: latest.Pipeline{
+				Build: latest.BuildConfig{Artifacts: []*latest.Artifact{{ImageName: "app"}}},
+			},
+		}
+		tracker := NewTracker()
+		t.CheckNoError(tracker.Start(c, "skaffold.yaml"))
+
+		c.Build.Artifacts[0].ImageName = "app-prod"
+		t.CheckNoError(tracker.Observe(c, "profile prod"))
+
+		c.Build.Artifacts[0].Workspace = "."
+		t.CheckNoError(tracker.Observe(c, Default))
+
+		c.Build.Artifacts[0].Workspace = "/abs"
+		c.Metadata.Name = ""
+		t.CheckNoError(tracker.Refresh(c))
+
+		t.CheckDeepEqual("skaffold.yaml", tracker.File(c))
+		t.CheckDeepEqual(map[string]string{
+			"apiVersion":                 "skaffold.yaml",
+			"kind":                       "skaffold.yaml",
+			"build.artifacts[0].image":   "profile prod",
+			"build.artifacts[0].context": Default,
+		}, tracker.Sources(c))
+
+		buf, err := tracker.AnnotatedYAML([]*latest.SkaffoldConfig{c, c})
+		t.CheckNoError(err)
+		doc := `# Source: skaffold.yaml
+apiVersion: ` + latest.Version + ` # from: skaffold.yaml
+kind: Config # from: skaffold.yaml
+build:
+  artifacts:
+  - image: app-prod # from: profile prod
+    context: /abs # from: default
+`
+		t.CheckDeepEqual(doc+"---\n"+doc, string(buf))
+	})
+}
+
+func TestObserveUntracked(t *testing.T) {
+	err := NewTracker().Observe(&latest.SkaffoldConfig{}, Default)
+	testutil.CheckError(t, true, err)
+}
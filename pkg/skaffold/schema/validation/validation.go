@@ -54,11 +54,11 @@ func Process(configs []*latest.SkaffoldConfig) error {
 		errs = append(errs, validatePortForwardResources(config.PortForward)...)
 		errs = append(errs, validateJibPluginTypes(config.Build.Artifacts)...)
 		errs = append(errs, validateLogPrefix(config.Deploy.Logs)...)
+		errs = append(errs, validateEphemeralNamespace(config.Deploy.EphemeralNamespace)...)
 		errs = append(errs, validateArtifactTypes(config.Build)...)
 		errs = append(errs, validateTaggingPolicy(config.Build)...)
 	}
 	errs = append(errs, validateArtifactDependencies(configs)...)
-	errs = append(errs, validateSingleKubeContext(configs)...)
 	if len(errs) == 0 {
 		return nil
 	}
@@ -467,15 +467,17 @@ func validateLogPrefix(lc latest.LogsConfig) []error {
 	return nil
 }
 
-func validateSingleKubeContext(configs []*latest.SkaffoldConfig) []error {
-	if len(configs) < 2 {
+func validateEphemeralNamespace(en *latest.EphemeralNamespace) []error {
+	if en == nil {
 		return nil
 	}
-	k := configs[0].Deploy.KubeContext
-	for _, c := range configs {
-		if c.Deploy.KubeContext != k {
-			return []error{errors.New("all configs should have the same value for `deploy.kubeContext`")}
-		}
+
+	var errs []error
+	if !util.StrSliceContains([]string{"", "user", "branch", "runId"}, en.NameFrom) {
+		errs = append(errs, fmt.Errorf("invalid ephemeral namespace nameFrom '%s'. Valid values are 'user', 'branch' or 'runId'", en.NameFrom))
 	}
-	return nil
+	if en.TemplateNamespace == "" && (len(en.Secrets) > 0 || len(en.ConfigMaps) > 0) {
+		errs = append(errs, errors.New("ephemeral namespace copies secrets or config maps but has no templateNamespace"))
+	}
+	return errs
 }
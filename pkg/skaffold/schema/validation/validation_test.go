@@ -18,7 +18,6 @@ package validation
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"testing"
 
@@ -954,6 +953,38 @@ func TestValidateLogsConfig(t *testing.T) {
 	}
 }
 
+func TestValidateEphemeralNamespace(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         latest.EphemeralNamespace
+		shouldErr   bool
+	}{
+		{description: "defaults", cfg: latest.EphemeralNamespace{}},
+		{description: "name from branch", cfg: latest.EphemeralNamespace{NameFrom: "branch"}},
+		{description: "name from run id", cfg: latest.EphemeralNamespace{NameFrom: "runId"}},
+		{description: "unknown name source", cfg: latest.EphemeralNamespace{NameFrom: "host"}, shouldErr: true},
+		{description: "copy from template", cfg: latest.EphemeralNamespace{TemplateNamespace: "shared", Secrets: []string{"registry"}}},
+		{description: "copy without template", cfg: latest.EphemeralNamespace{ConfigMaps: []string{"settings"}}, shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// disable yamltags validation
+			t.Override(&validateYamltags, func(interface{}) error { return nil })
+
+			err := Process(
+				[]*latest.SkaffoldConfig{{
+					Pipeline: latest.Pipeline{
+						Deploy: latest.DeployConfig{
+							EphemeralNamespace: &test.cfg,
+						},
+					},
+				}})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
 func TestValidateAcyclicDependencies(t *testing.T) {
 	tests := []struct {
 		description string
@@ -1082,76 +1113,6 @@ func TestValidateUniqueDependencyAliases(t *testing.T) {
 	testutil.CheckDeepEqual(t, expected, errs, cmp.Comparer(errorsComparer))
 }
 
-func TestValidateSingleKubeContext(t *testing.T) {
-	tests := []struct {
-		description string
-		configs     []*latest.SkaffoldConfig
-		err         []error
-	}{
-		{
-			description: "different kubeContext specified",
-			configs: []*latest.SkaffoldConfig{
-				{
-					Pipeline: latest.Pipeline{
-						Deploy: latest.DeployConfig{
-							KubeContext: "",
-						},
-					},
-				},
-				{
-					Pipeline: latest.Pipeline{
-						Deploy: latest.DeployConfig{
-							KubeContext: "foo",
-						},
-					},
-				},
-			},
-			err: []error{errors.New("all configs should have the same value for `deploy.kubeContext`")},
-		},
-		{
-			description: "same kubeContext specified",
-			configs: []*latest.SkaffoldConfig{
-				{
-					Pipeline: latest.Pipeline{
-						Deploy: latest.DeployConfig{
-							KubeContext: "foo",
-						},
-					},
-				},
-				{
-					Pipeline: latest.Pipeline{
-						Deploy: latest.DeployConfig{
-							KubeContext: "foo",
-						},
-					},
-				},
-			},
-		},
-		{
-			description: "no kubeContext specified",
-			configs: []*latest.SkaffoldConfig{
-				{
-					Pipeline: latest.Pipeline{
-						Deploy: latest.DeployConfig{},
-					},
-				},
-				{
-					Pipeline: latest.Pipeline{
-						Deploy: latest.DeployConfig{},
-					},
-				},
-			},
-		},
-	}
-
-	for _, test := range tests {
-		testutil.Run(t, test.description, func(t *testutil.T) {
-			errs := validateSingleKubeContext(test.configs)
-			t.CheckDeepEqual(test.err, errs, cmp.Comparer(errorsComparer))
-		})
-	}
-}
-
 func TestValidateValidDependencyAliases(t *testing.T) {
 	cfgs := []*latest.SkaffoldConfig{
 		{
@@ -0,0 +1,53 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/rakyll/statik/fs"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/server/dashboard/statik"
+)
+
+// dashboardPath is where the web dashboard is served by the HTTP server.
+const dashboardPath = "/dashboard/"
+
+// For testing
+var dashboardFS = func() (http.FileSystem, error) {
+	return fs.NewWithNamespace(statik.Dashboard)
+}
+
+// withDashboard serves the web dashboard next to the REST gateway. The root path redirects to the dashboard.
+func withDashboard(gateway http.Handler) (http.Handler, error) {
+	assets, err := dashboardFS()
+	if err != nil {
+		return nil, fmt.Errorf("loading dashboard assets: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(dashboardPath, http.StripPrefix(dashboardPath, http.FileServer(assets)))
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/" {
+			http.Redirect(w, r, dashboardPath, http.StatusFound)
+			return
+		}
+		gateway.ServeHTTP(w, r)
+	})
+	return mux, nil
+}
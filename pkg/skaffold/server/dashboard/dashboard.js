@@ -0,0 +1,204 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+'use strict';
+
+// Number of log lines kept per container.
+const maxLogLines = 2000;
+
+const logs = new Map();
+let selectedContainer = null;
+let refreshTimer = null;
+
+function element(tag, text, className) {
+  const e = document.createElement(tag);
+  if (text !== undefined) {
+    e.textContent = text;
+  }
+  if (className) {
+    e.className = className;
+  }
+  return e;
+}
+
+function status(text) {
+  return element('span', text || 'Not Started', 'status ' + (text || '').replace(/ /g, '-'));
+}
+
+function row(...cells) {
+  const tr = element('tr');
+  for (const cell of cells) {
+    const td = element('td');
+    td.append(cell);
+    tr.append(td);
+  }
+  return tr;
+}
+
+function call(method, path, body) {
+  return fetch(path, {method: method, body: body && JSON.stringify(body)}).then((response) => {
+    if (!response.ok) {
+      response.json().then((e) => alert(e.error || response.statusText));
+    }
+  });
+}
+
+function renderState(state) {
+  const buildState = state.buildState || {};
+  const artifacts = buildState.artifacts || {};
+  const moduleOf = {};
+  for (const [module, m] of Object.entries(state.modules || {})) {
+    for (const artifact of m.artifacts || []) {
+      moduleOf[artifact] = module;
+    }
+  }
+
+  const artifactRows = document.getElementById('artifacts');
+  artifactRows.replaceChildren();
+  for (const artifact of Object.keys(artifacts).sort()) {
+    const rebuild = element('button', 'Rebuild');
+    rebuild.onclick = () => call('POST', '/v1/build/artifact', {artifact: artifact});
+    artifactRows.append(row(moduleOf[artifact] || '', artifact, status(artifacts[artifact]), rebuild));
+  }
+
+  document.getElementById('deploy-status').replaceWith(Object.assign(status((state.deployState || {}).status), {id: 'deploy-status'}));
+  const statusCheck = state.statusCheckState || {};
+  document.getElementById('status-check-status').replaceWith(Object.assign(status(statusCheck.status), {id: 'status-check-status'}));
+  const resourceRows = document.getElementById('resources');
+  resourceRows.replaceChildren();
+  for (const resource of Object.keys(statusCheck.resources || {}).sort()) {
+    resourceRows.append(row(resource, status(statusCheck.resources[resource])));
+  }
+
+  const portRows = document.getElementById('ports');
+  portRows.replaceChildren();
+  for (const port of Object.values(state.forwardedPorts || {})) {
+    const address = (port.address || '127.0.0.1') + ':' + port.localPort;
+    const link = element('a', address);
+    link.href = 'http://' + address;
+    link.target = '_blank';
+    const target = port.targetPort ? (port.targetPort.strVal || port.targetPort.intVal) : port.remotePort;
+    portRows.append(row(port.resourceType + '/' + port.resourceName, port.namespace || '', String(target || ''), link));
+  }
+
+  const triggers = {
+    build: buildState.autoTrigger,
+    sync: (state.fileSyncState || {}).autoTrigger,
+    deploy: (state.deployState || {}).autoTrigger,
+  };
+  for (const checkbox of document.querySelectorAll('input[data-trigger]')) {
+    checkbox.checked = !!triggers[checkbox.dataset.trigger];
+  }
+}
+
+function refreshState() {
+  clearTimeout(refreshTimer);
+  refreshTimer = setTimeout(() => {
+    fetch('/v1/state').then((response) => response.json()).then(renderState);
+  }, 100);
+}
+
+function renderContainers() {
+  const containers = document.getElementById('containers');
+  containers.replaceChildren();
+  for (const name of logs.keys()) {
+    const button = element('button', name, name === selectedContainer ? 'selected' : '');
+    button.onclick = () => {
+      selectedContainer = name;
+      renderContainers();
+      renderLogs();
+    };
+    containers.append(button);
+  }
+}
+
+function renderLogs() {
+  const pre = document.getElementById('log-lines');
+  const atBottom = pre.scrollTop + pre.clientHeight >= pre.scrollHeight - 5;
+  pre.textContent = (logs.get(selectedContainer) || []).join('');
+  if (atBottom) {
+    pre.scrollTop = pre.scrollHeight;
+  }
+}
+
+function addLogLine(e) {
+  const name = e.podName + '/' + e.containerName;
+  if (!logs.has(name)) {
+    logs.set(name, []);
+    if (selectedContainer === null) {
+      selectedContainer = name;
+    }
+    renderContainers();
+  }
+
+  const lines = logs.get(name);
+  lines.push(e.message);
+  if (lines.length > maxLogLines) {
+    lines.splice(0, lines.length - maxLogLines);
+  }
+  if (name === selectedContainer) {
+    renderLogs();
+  }
+}
+
+function setConnection(text) {
+  document.getElementById('connection').replaceWith(Object.assign(status(text), {id: 'connection'}));
+}
+
+// streamEvents reads the newline separated JSON events, and reconnects when the stream ends.
+function streamEvents() {
+  const decoder = new TextDecoder();
+  let buffered = '';
+
+  fetch('/v1/events').then((response) => {
+    setConnection('Connected');
+    refreshState();
+    const reader = response.body.getReader();
+    const read = () => reader.read().then(({done, value}) => {
+      if (done) {
+        throw new Error('stream ended');
+      }
+      buffered += decoder.decode(value, {stream: true});
+      const lines = buffered.split('\n');
+      buffered = lines.pop();
+      for (const line of lines) {
+        const entry = line && JSON.parse(line).result;
+        if (!entry || !entry.event) {
+          continue;
+        }
+        if (entry.event.applicationLogEvent) {
+          addLogLine(entry.event.applicationLogEvent);
+        } else {
+          refreshState();
+        }
+      }
+      return read();
+    });
+    return read();
+  }).catch(() => {
+    setConnection('Disconnected');
+    setTimeout(streamEvents, 2000);
+  });
+}
+
+for (const button of document.querySelectorAll('button[data-intent]')) {
+  button.onclick = () => call('POST', '/v1/execute', {intent: {[button.dataset.intent]: true}});
+}
+for (const checkbox of document.querySelectorAll('input[data-trigger]')) {
+  checkbox.onchange = () => call('PUT', '/v1/' + checkbox.dataset.trigger + '/auto_execute', {state: {enabled: checkbox.checked}});
+}
+
+streamEvents();
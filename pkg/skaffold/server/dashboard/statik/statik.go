@@ -0,0 +1,15 @@
+// Code generated by statik. DO NOT EDIT.
+
+// Package statik contains the assets of the web dashboard.
+package statik
+
+import (
+	"github.com/rakyll/statik/fs"
+)
+
+const Dashboard = "dashboard" // static asset namespace
+
+func init() {
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0d\x00	\x00dashboard.cssUT\x05\x00\x01\x80Cm8tU\xefo\xdb6\x10\xfd\xce\xbf\xe2!\xc1\x80\xb6\xb0dY\xc9\xb6L\xf9\xe4%)f\xacp\x868]\xd1\x8f\x14y\x92\x88R\xa4FR\x93\x8d \xff{A\xd9Nl7\x85\x7f\xc9\xe4\xe9\xbdw\xef\x8e\xa7\xe9\x07vc\xbb\x8dSu\x13\x90g\xf9\x0c\x8f\x0da\xf5\x8dW\x95\xd5\x12\xf3>4\xd6y\xc6>)A\xc6\x93Do$9\x84\x860\xef\xb8h\x08\xbb\x9d	\xfe%\xe7\x955\xc8\xd3\x0c\xefb\xc0\xd9n\xeb\xec\xfd5\xdb\xd8\x1e-\xdf\xc0\xd8\x80\xde\x13B\xa3<*\xa5	\xb4\x16\xd4\x05(\x03a\xdbN+n\x04aP\xa1\x19Iv\x10)\xfb\xba\x03\xb0e\xe0\xca\x80C\xd8n\x03[\x1dF\x81\x07\xc6\x00\xa0	\xa1+\xa6\xd3a\x18R>\xaaL\xad\xab\xa7z\x1b\xe5\xa7\x9f\x167w\xcb\xd5]\x92\xa7\x19c\x9f\x8d&\xef\xe1\xe8\xbf^9\x92(7\xe0]\xa7\x95\xe0\xa5&h>\xc0:\xf0\xda\x11I\x04\x1bu\x0eN\x05e\xea	\xbc\xad\xc2\xc0\x1d1\xa9|p\xaa\xec\xc3\x91A{U\xca\xe30\xc0\x1ap\x83\xb3\xf9\n\x8b\xd5\x19\xfe\x9c\xaf\x16\xab	\xfb\xb2x\xfc\xeb\xfe\xf3#\xbe\xcc\x1f\x1e\xe6\xcb\xc7\xc5\xdd\n\xf7\x0f\xb8\xb9_\xde.\x1e\x17\xf7\xcb\x15\xee?b\xbe\xfc\x8a\xbf\x17\xcb\xdb	H\x85\x86\x1ch\xdd\xb9\xa8\xdd:\xa8h\x1d\xc9\x94\xad\x88\x8e\xc8+\xbb\xad\x96\xefH\xa8J	hn\xea\x9e\xd7\x84\xda\xfeO\xce(S\xa3#\xd7*\x1f\x8b\xe7\xc1\x8ddZ\xb5*\xf00\xfe\x7f\xad\xf7\x0e1e\x1f\xa6\x8c\x95Vn\xf0\xc4\x80\x96\xbbZ\x99\x02\xd95\x03*kBR\xf1V\xe9M\x01\xcf\x8dO<9U\xc5-a\xb5u\x05\xcec\x8f\xe5\x97q\xa5\xe4\xe2[\xedlod\x81\xf3\xea\xaa\xfa\xa3\xe2\xd7\xec\x99\xb1\x86xl\xb1\x08.\x95\xef4\xdf\x14\xa84\xad\xe3=\\\xab\xda$*P\xeb\x0b\x082\x81\\\\\xaeyW`Fm\xbc\xee\xb8\x94\xca\xd4\x052\xcc\xd2_\xa9= \x1f\x1a\x15\xe8\x07\xea\x19\xff\xfd\x82\xaeF\xea6\xb6\xd6\x11q\xed\x94\x8cw\xc4\xdf$P\xdbi\x1e(\x11V\xf7\xad\xf1\x05f\x95\x8b\x9f\x9fj\x98Q\xbbW\xf1\xcc\x98'\x11M\xc5\xd3\x89\xcc\x18D\xed\xa9'\xafj\xad\x93\xe4\x12\xc7\xa5\xea}\x81\xcbn\xb4\xa2\xb4\xeb\xc47\\\xdaaL\xb5[#\xef\xd6pu\xc9\xdfe\x13\xec\xdei\xfe~d>\xd7\xb6\xf6#\xef\x98\xc8V\x7f\x81\x19\xa6\xf0\x1d7\xc8\xc7\xa8\xd4\xd9\xe1m\xdf\xa3\xff\xc9\xe0\xa2\xcd\xf1\xfb%\xdfl\xef\xf0K\x1b\xc4\x85\xd8\x0c\xcf\x8c\x85\xf1\x04E\xbcA\xc9\xd0\x14\x98e\xd9/\x07		\xab5\xef<\x15\xd8_\x8d*B3A\x90'&\xa5\x17[\x83\x02\xadC2vA\x01MU8\x80+m\x08\xb6-F+\xbc\xd5J\xe2\x9c\xb2\xf8\x1aQS\x1fx\xe8\xfd)l\xf4\xfe%\x89\x13\xa3/\xba\xf5iM\xde@Lo\xe2\xd8\xa2@\x13\xec8\xd2U/\x04\x91$\xf9\xbatc\x8d!\x11\xe7\xc3\xd3)\xa4 \xe2\xf2\xb7C\x91\xe9\xc2$\xff8[;\xf2\xfe\x005p\xf7&\x80\xcc\xe9\xa2\x12G\x00\x1f\xb9\xd2\x87\xf4\xb7\xca\x8b\x9f+\xa8\xb8\xccE5\x02\x9c\x0bk\xe2\x80%\xe7Q\xf6!X\x93z\xd2\xaf\xca\xc7\x13>P|\\\x14(\xad\x96/\xed\x95he\xc8\x8f\xea\x9a\xdd\xfeE\xb6\xf55\x0e\x9aJ\xc7F\xe5}\xb0G\xedr|b\xf7\x85\xd8\x0f\x0b\xba\"N\xf2\x87\x13\x9bg\xf9,\xbf\xbcf\xcf\xec\xfb\x00PK\x07\x08\xd0\x93\x164f\x03\x00\x00\xbf\x06\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0c\x00	\x00dashboard.jsUT\x05\x00\x01\x80Cm8\x9cYoo\xdc6\xd2\x7f\xafO1\xc9\x8bJje\xad\x13\xe0\xc1\x03\xac\xb1-\xdc\xc4\x87\xfa.g\x17Y\xb7E\xe1\x0b\nZ\x9a\x95TsI\x95\xa4\xb2^8\xfb\xdd\x0f\xc3?Zj\xd7\x8e\xef./l\x8b\x9c\x19\xce\x0c\x7f3\xfc\x91\x99}\x9b\xbc\x93\xfdVuMk\xe0\xed\xe9\xdb7p\xd3\",\xef\xd9j%y\x0d\xe7\x83i\xa5\xd2I\xf2\xa1\xabPh\xaca\x105*0-\xc2y\xcf\xaa\x16\xc1\xcf\x14\xf0+*\xddI\x01o\xcbS\xc8H\xe0\xb5\x9fz\x9d\x9f%[9\xc0\x9amAH\x03\x83F0m\xa7a\xd5q\x04|\xa8\xb07\xd0	\xa8\xe4\xba\xe7\x1d\x13\x15\xc2\xa63\xad]\xc4\x9b(\x93\xdf\xbd\x01ygX'\x80A%\xfb-\xc8U,\x05\xcc$	\x00@kL?\x9f\xcd6\x9bM\xc9\xac\x97\xa5T\xcd\x8c;)=\xfbp\xf9\xee\xe2jyq\xf2\xb6<M\x92_\x04G\xadA\xe1_C\xa7\xb0\x86\xbb-\xb0\xbe\xe7]\xc5\xee8\x02g\x1b\x90\nX\xa3\x10k0\x92\xfc\xdc\xa8\xcet\xa2)@\xcb\x95\xd90\x85I\xddi\xa3\xba\xbb\xc1L\x12\x14\xbc\xea4\xc4\x02R\x00\x13\xf0\xfa|	\x97\xcb\xd7\xf0\xe3\xf9\xf2rY$\xbf]\xde\xfct\xfd\xcb\x0d\xfcv\xfe\xf1\xe3\xf9\xd5\xcd\xe5\xc5\x12\xae?\xc2\xbb\xeb\xab\xf7\x977\x97\xd7WK\xb8\xfe\x1b\x9c_\xfd\x0e\xff\xb8\xbcz_\x00v\xa6E\x05\xf8\xd0+\xf2]*\xe8(uX\x97\xc9\x12q\xb2\xf8J\xba\xdd\xd2=V\xdd\xaa\xab\x803\xd1\x0c\xacAh\xe4gT\xa2\x13\x0d\xf4\xa8\xd6\x9d\xa6\xcd\xd3\xc0D\x9d\xf0n\xdd\x19f\xec\xf7~\xbf\xbd\xc52\xf9v\x96$)m\"\x05]\x99\xf4,If3\xb8\x1a\xd6w\xa8hG\xb8l\x80w\x025\xdc\xd3\xce\xf6\xa8\xa0\x92\x82\xb6\x0dU\x99TRh\x03k\xf6\xf0A6\x1f\xac\xd4\x02\xde\x9e\x9e\x9e\x9e%~\x8a\xcb\x86\xc6\x04n\xe0\x9f\xac\xcf\xf2\xb3\x84\xa3\x01\x8d\x1c+\x83\xf5\xbb`\x89D\x06\xce\xdd\xac\xc2\x95B\xdd\xdet\xebh\"Y\x0d\xa2\xa2(\x009\xaeQ\x98\xcc\xb0\xa6\x00\x83\x0f\xa6\x80\x8a3\xad\xaf\xd8\x1asxL\x80\x1c\xd4\x06\x10\x16P\xcbj \xe1\xb2R\xc8\x0c^\xecU\xf3\xb3\x04\xa0[AF\x16\xe0\xd5ba\x93\xb3\xea\x04\xd6\xce\x08\x00\x964G>\xa20\xb0\xb0k\x91\xd6\xcek\x1e\xacJ\n\xe3\x10,\xf6N\x05\x1d\x85fP\x02\xf0,\xd9E\xe1h\xc3\xcc\xa0\xad\x1b\xceN\x10\xf3\xbe\xa6\xbag\"u\x91\xc2\x97/\x90^I\x03K\xc3\x94\xc1:- u\xfa\x90\xc2w>\x16\x92I\xf3Ra\xcfY\x85\xd9\x0cfM\x01\xe9I\x9a\xe7\xd3\x85\x95\xdcdeYV\xc8\xb9\x8e\xf3f(\xe9\xe3\xeaF\xa56U\x04\xbd\xcc	\x90\x06A#\xd2\x0c97\xf5D\xb7v\xba\x00\xa6.Y\xdf\xa3\xa83R\n\x83*\x0c\x9a:?H\x92QSg+\xc6y\xb6F\xd3\xca\xba\x80\x9e\x99\xb6\x80;Yo'\x19[\xa1\xa9\xda\xccM>:\xd99\x04\x1d\x92\x9e\xdb\x9f\xf0\xcd7\xf0\xf7\xe5\xf5UI\x88\x17M\xb7\xdaf4\x9c\xef\xf2\xd2\xb4(\xb2L\xa1\xee\xa5\xd0\x98\xc3\xe2{\x0f\x05\xda\xeeWa\xbc\x94\xf7!j\x80q\xf0O-E\x16L8]\xc6Q\x99\x0cKTJ*\xda\x97\xd1\x82\xdb\xb4\x1b|0\xb9O\x06\x81jw\xb8CH\xf5\xba4\xcc`F\x1a\x13x\xdf\x0d\x1d\xaf\xed\x1c,,\x88\xb0\x8c\x86\xbe|\x81\xc7\xdd\xd9X\x0bL\x99n\xc5*C\xb5\xb8\x97*\xf7\xc3\x07\xf2kY\x0f\x1c\xafW\xb0\xf0\xc3\xd1\xf6\xdf\xba\xc9\x02\xd6\x9f\x08\x05\xd7w\x7fbeJ\x14Fu\xa8\x9d\x9f\xa5\x13\xf1f\xf3\x90\xad\xc8HX\x99,\xac\xa7~\xdc~\xdag7\xf8q\x1b$>\xc1\xc2\x0fFiK\x8e\xe2\xfc(7:.\xff\x06\x8d\xaf\xfd\x1f\xb7\x97u\x96\x8e\x0b:\x80\x86OR\x0b\x85\xf3\xae\xedx\xadPd\x87\xf0\x0f\xc2Q\xf0\xf7\xb8\xd5Y\x18\xd7y\xa9\xa52\xd9\x18\xb6sM\xa1M|\\\x1fw\x831\x92j;\xfd\xe8&C\xb9x\xd9R\x8a\x8aw\xd5=, \xb3\x80\xb2U\x90\xfe|\xbd\xbc!\xa5\xd9\xe773\xab6\x0bK\xa7\x05<\x86\xbf\xe7\xa3\xa3;o5|\xdb(}\xe5Q\x13x\"\xc9\xb6\x87\x14\xa3F\x11\xbaT\x18\xd0{\xd9\xbc\x08\xee\xe6\xbe\x88\x13x>\xf15\xf6\\nO\x9c\xb9}\x97\xfa\xad3m\xe6\x91\xc4\xb4\xee\x1a\x91\xf9\x15=\xa0\x9c^\x84\xed\xdc\xd7P^\xc0cW\xcf\xe1\xc0\xf2\xce9\xe3R\xef$\xdf\xb5hSI_\xe8\xb5\xed\xd8A\xc5<\xeb\xbbS9\xa9H\xe7\xbf\x88 Z\xe9\xd0\xe7\xa7,N<W\xa8\xe5\xa0*|	\xcfA\xce\xe39V{\x11\xcfA\xf8\x10\xcf\xb1\xdfA&T\xf4\x01\xc2\xc3\xf4!\xb2\xc2\xf8\x88\x9f'm\xde\x06\xb1Oy\x04!\xe7\\/\xd5\x8b\xc5L2>\xf0 \xfeb\xd0$\x18\x05\xfc\x99\xf1al^+\xa96L\xd5X\xffL\x86}\xc4\xd3bfum\xc9\xda\x022\xb2T\x86o\xaa\x9b7o\xff\xbf<-O\xcb7i\x0e\xdfA:\xa7\x93\xd9\nqY1N6\xcf\xa2\x13\x93w\xe2>\xee	\x8c\xca\xceY\xf3eK\x12e\xab\x90\x9aq\xea\xc90\xd9\xf4R\x91\x90a\xaaAb*\xe9\x1fw\x9c\x89\xfb4^h\x9c\xb4\xbe\xb8/r\x06~\x80\xec`\x88N\xc6_\x19\xa7h\x0e\x84\xcbN\x98_\x19\xcfa\xeef\x14\xae\xa5\xc1}Lc\xfe#\x10xA\x07\xe4\x9bm\x8f\x94\x95\xd9\x98\x950CL\xa9pC\x82\xadQ\xf7\xac\xc2\xd0\x87\x96\xf6\xa4\xce|\x08v0/lb\x8e\x00cT\xd74\xa8hk\x1c6mk\x9aON\xbd\xc1\xc8\x1b'VX\x11\xbd\x15\xd5\x1c\xc2\xeew\x1c\x97[QMz\xcd\x91\x8a\xeb6sx\xbe=\x1d\xa8\x1c\x9e\xa2\xb6\x8b\xdc\xc9\x07\x02\xe1\x88\xec\xbf\x06T\xdb\xa5%\xc7R\x9ds\x9e\xa5\x9d\xe8\x07s[3\xc3N|d\x9f\xd2=\x16\xbd\x8d\xd2\xfe\x81t\xb6\xbcz\x15\x12p;\xce\x92\xb6FS\x06\x03\xe4\xc9\xee\x80hX\xc6mC\xce<\xc9\xe0\xc8\x14Qp9\x98,f\xe4\xbe\xc1L(\xbaF\x13D\xb3\x8859Bf\xcf)\x9b\xa6\xf4I\x865\x92\"\xc7\xa0\xbcL\xc4}\xec\x8a\xbb\x02\xde\x9c\x9e>I\x90\xc6[\x84\x0e\xbe[$\x8c\xd7\x94\xaf2\x81\xbdT\x1a\xfa\xae\xff~\xb1\x87\x10L\xfd\x15I;\np\xd0%\xdc\xf9\xfe\xe4\x89O\xba\xee',\x16\x8b'.D?@\x1a\x06S\x98C\x1a\xa8\x81\xb3p\xc4\x0c\x02a:6\xb4\xb0\xab\x9c\xf9\xf9\xe3\x8cMg>\xc8f\x1c\xdb\x8d\xed#d\xc4W\xb5\xf3!\x7f\nG{\x1b\xd1N\xf4\n\xbf\xd6\xbf\xb9lN\xec\x15s\xdc\x01br\xe6Gi\x8c\\S\xc3RX\xeaJI\xceodOmCaY\xf1\x0e\x85\xf9	\xedS\xc7\xf7\xb1\x8c\x1f;\x81\xff#\xffzux\x8b\xcb\xe8JJ0\xc8\x8er\x95S\xed\xde~\xca\xcb?e'2\x9fr\xa2\xfe\xc1\x99\xb0\xbdS\x8f\x8eW?\xce\x0c\xabk\x7fE\xce&,\x9e\xf6\x86\x00R\xf6\xb2\xa6\x0e8\xf6F,G$^\xf9\xfd#O^Y\xef[\xa63\xd2\x1c\xf1fG5\x1a;ZP\x0cg\xe3\xbd\xe5	H,\xdc\xad:h\xbf\x04\x9b]\xf2<t\"\xf2\xcd\xfd\x03\xc0\x98`r\xc6\n\xd9\x99\xb2\x1ft\x9ba\xb9F\xadY\x83cr\xdd$G\xd1\x98\x16\xbe\x8f\x1f\x13\xc6\xe8\xac\x84\xa6w\x1c\xccN\x0b\x98h\x9cL4\xc25\x92r\xf5|u\x05\xc3\x87\x98\x9fvE\x8dt\xf9\x17hw0\xba\xa0?KD\xaaQ\xfa?a\xb6\xd6``\x82\x91\xea\xce_\xd4g3z\x93A\xb6\xbe\xf8\x8c\xc2\xd0\xa3\x16\xab\xb5}\xfe\x11\xb8\xa1\x94\x80\xc6\x9e)F\xefTt\x9f\x05\xb4r\x05\xbd\xfa\x80BoQ\xc3\xa6Ea\xd5\x9c5@Q\xebr\x8f\xccx\x8dI\xd5\xd6XIz\x1e\\\xd0zp\x83\x0f\xe6\xbd\x1bq\xfd\x81\xdeq\xee\x86\xd5\n\xe9\x9dm\x01)\xbd\x1cMZ\xbes\xe7\xe9\x9eO\xab\xc0A\x82S\x9fl\x8c\xaeA\xf1\xb1\x14\x93\x19\x85\xcc\xb96\x1e\x1et\x85\xa7\xaa\xfehgB\x0b\x0bW/V\x8f\x9d\x92>P\x95\xf4k\xbc\xae?\xd6R`\x01\x96\x05\xee\"\x07\x1d\x8eh2 \x86\xfe\x99V\xc9\x8dM\xca\x05]\xec\xb3t\x9f\xd8\xbd\xef\xa1j`\x9f\xa4\xef\x16!\xa7\xa5\xfb\x9d\xd9\x05\x0bxt\x06\xe6`\x14\xad\x1f:\xf2\xb4\xaa\x82\x19[\x07&K\xff%\xf6K\x8dK,B\xa9\xc9>\xa4`rd\xd1\xac=\xb2\xe2\xf2\xda\xa7\x95.\xf1[od|&\xe9\x99\xd2h\xab\x94@\xad\x07\xee\xf9k\xe8/\xaf\x9c\xd6\x97/\xe0\xfe*\xed\xc6\xc7	\xb3\xa1\x98N\x0c\xe31\xb4\xcf\x8eKq\xa4X\xfa\x07[\xaa\x85\x0f\xb2\xb986\x16\xf7\xd2\x17\xf4\xa2\xf5\x00\xb9\xc6\x89\x9d\xa7\xe0\x15o\\p\xd1?I9\xc4\xf8\x8e\xe8\x7f\x1fM\xed\xf2\xb2b\xc4z\xb2\xe7q\xfe\xbe\xd3\xbe8\xf7p\x89\x18T\\\x90\x85}M\xcd\xcf\xa2\xc7\xa1=\xff\xf0\xf4\xe2\xeb\x04\xd2	9\x06\xd9\xd93p$\x90\xcfP\x89\xe3G\x06|\xc0j0H\xaf\x0b\xce\xc4\x1c\x1eo\xbdv \x97\xde\xb6\xc7\xb0s6\xf25\x90\xd1\xff\x9d\xee\x8etV\x8a\xaae\xa2\xc1C\x87\x7f\x19\x1fE\xe8n1\x8a\x1f\xb0_{\xc0\x127\xff#\x8a\xca\xb2\xd39<\xa2\xa0\xff'\xa8\xe7G\xcc\xda\x07\x94L\x9b\xe5Y\xf2\xef\x01\x00PK\x07\x08\xb3I\x03\xc0/	\x00\x00r\x19\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00index.htmlUT\x05\x00\x01\x80Cm8\xa4U\xdfs\xdb6\x0c~\xd7_\x81\xeai\xbb\x8b\xad\xc4O\xbb\x8e\xd6\x9d\x9bd7\xdf2\xbb\x17\xbb\xeb\xe5\x91&a\x91\x0bMr$\x14G\xff\xfd\x8e\xfa\xe1\xc9\xcd\xaeM\x1b\xbfX \x88\x0f\xf8\xa0\x0f\x10{w\xb3\xbe\xde>|\xbc\x05E\x07Sf\xec\xddd\x92];\xdf\x04])\x82\xd9\xe5\xec\n\xb6\na\xf3\xc8\xf7{g$,jR.\xc4,\xbb\xd3\x02mD	\xb5\x95\x18\x80\x14\xc2\xc2s\xa1\x10z\xcf\x05\xfc\x85!jga6\xbd\x84\x9fH!\xe4\xbd+\xff\xf9\xd7\xacq5\x1cx\x03\xd6\x11\xd4\x11\x81\x94\x8e\xb0\xd7\x06\x01\x9f\x05z\x02mA\xb8\x837\x9a[\x81p\xd4\xa4\xda$=\xc44{\xe8\x01\xdc\x8e\xb8\xb6\xc0A8\xdf\x80\xdb\x8fo\x01\xa7,\x03\x00PD\xfe}Q\x1c\x8f\xc7)o\xab\x9c\xbaP\x15\xa6\xbb\x15\x8b\xbb\xe5\xf5\xedjs;\x99M/\xb3\xec\x935\x18#\x04\xfc\xa7\xd6\x01%\xec\x1a\xe0\xde\x1b-\xf8\xce \x18~\x04\x17\x80W\x01Q\x02\xb9T\xe71h\xd2\xb6\xba\x80\xe8\xf6t\xe4\x013\xa9#\x05\xbd\xab\xe9\xacACU:\xc2\xf8\x82\xb3\xc0-\xe4\x8b\x0d,79|Xl\x96\x9b\x8b\xec\xf3r\xfb\xfb\xfa\xd3\x16>/\xee\xef\x17\xab\xed\xf2v\x03\xeb{\xb8^\xafn\x96\xdb\xe5z\xb5\x81\xf5o\xb0X=\xc0\x1f\xcb\xd5\xcd\x05\xa0&\x85\x01\xf0\xd9\x87T\xbb\x0b\xa0S\xebPN\xb3\x0d\xe2Y\xf2\xbd\x0b\xad\x1d=\n\xbd\xd7\x02\x0c\xb7U\xcd+\x84\xca=a\xb0\xdaV\xe01\x1ctL//\x02\xb723\xfa\xa0\x89Sk\xbf\xa03\xcd&\x932cI@-\xd6<G\x9b\xa7\x03\xe4\xb2\xcc\x00\xd8\x01\x89\x83P<D\xa4y^\xd3~\xf2K\xde:H\x93\xc1r\xd0\x16+:;y\x8c\xb6\x8f\x10\xd0\xcc\xf3H\x8d\xc1\xa8\x10)\x07\x15p?\xcf%\x8fj\xe7x\x90S\x11cJTt\x99\xd8\xce\xc9\xa6\x8dN6\x86\xf4\x08\xc0\xd4\xd5(\x83\xba\xeaO\xa3\xe7\x16\xb4\x9c\xe7\xc2Y\x8b\"Q\xcbA\x18\x1ecJ\xc9\xa9\x8eyy\xdd\xbbl5\x9dNY\x91BRt\x97/\xe1'\xe3\xc0\xb5\x1d0\xd3ew\x82\xa5\xe0L\xaa/\xe9/\xd51K\x80\xed!+\xd4\xect.\xf5\xd3\x908\xb8\xe3\xe9>\x00\xdb\xd5D\xce\x82\xe4\xc4'\xda\x12Z\x9a\xe7\xbbZ\x1b\x99\x97\x1f\xd2\x1f+\xba\x1b\xdf\x08\x89\x8d\x15y\xb9i\xacxe\x80Do\\\x93\x977\xed\xff\x97A\xac\x90\xfa\xe9\x15\xd5\x1b\xbeCS2m}M@\x8d\xc7y.\x14\x8a\xc7\x9d{\xce;N\x14tUa8\x91J\xcb\xc5A\xcb\x90\x15]\xf8\x0f\xc1u\x84;\xb4\xf4\xfc&\xb0\xa1\x19\x1d\x9c\xec[r\x068\xea\x08+z\x11\x94\xd9KM\xf44\xfb2\x98\x9a\x0d\xafq\xa4\x06JKf\xb0\x00\x18%-\x97\x8cB\xc9H\x95\x7f:Y\x1bd\x05\xa9\xd6\\\x04\xd2{.\xe8t\xb0i\x95{2\xbb\x87\"\x05\x174\x8cc\x9f\x9d\xd2\xb4\xb4R\xe5=J\xccS\xc00D\xe9\xc7\x8aQ9_\xa56ti\x08T\xb3\x93zF\xe4|\x7f\xf8~4\x7f]\xe4\xa4\x9f\xb9/G\xb0\x9f:V\xf8\x11H\xc7\x12Z5\x8d\xa1:\x8cI{\xfe\x1d\x80_o\xf9=FW\x07\x81\xff\xdb\xe3o\xb56\xf4\xc1oi\xadw\x81&{\x17\x8e<\xc8\xf3u\xf2\xd1\x05\x82\xc1s\xb6S\xbe\x93\xd2\x8a\x1f0z>\"\x99\xa0O\xc6B\xca\x80\xf1\x95\x94S\xb9o\xa1k\\u\xce\xf2\xceU/\x17\xe6\xb0b\xb9\xb6\x18\xfe\x93M\xbb@\xcf\x17\x94\x0f8\xe0N\x8c\xb6\xdd\xab\xf0\xe1\xa5\xa8\x93\xc2\xbbe\x9e\x1e\xa3\x08\xda\x13\xc4 \xc6\x1f\x9c\xbf\xdb\xe8\xce\x97><\x1dEV(:\x982\xfbw\x00PK\x07\x08\xecc\xbd\xa9\xa0\x03\x00\x00M	\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd0\x93\x164f\x03\x00\x00\xbf\x06\x00\x00\x0d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00dashboard.cssUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xb3I\x03\xc0/	\x00\x00r\x19\x00\x00\x0c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xaa\x03\x00\x00dashboard.jsUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xecc\xbd\xa9\xa0\x03\x00\x00M	\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x1c\x0d\x00\x00index.htmlUT\x05\x00\x01\x80Cm8PK\x05\x06\x00\x00\x00\x00\x03\x00\x03\x00\xc8\x00\x00\x00\xfd\x10\x00\x00\x00\x00"
+	fs.RegisterWithNamespace("dashboard", data)
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestWithDashboard(t *testing.T) {
+	tests := []struct {
+		description      string
+		path             string
+		expectedCode     int
+		expectedLocation string
+		expectedBody     string
+	}{
+		{
+			description:  "dashboard",
+			path:         "/dashboard/",
+			expectedCode: http.StatusOK,
+			expectedBody: `<script src="dashboard.js"></script>`,
+		},
+		{
+			description:  "dashboard script",
+			path:         "/dashboard/dashboard.js",
+			expectedCode: http.StatusOK,
+			expectedBody: "function streamEvents()",
+		},
+		{
+			description:      "root redirects to the dashboard",
+			path:             "/",
+			expectedCode:     http.StatusFound,
+			expectedLocation: "/dashboard/",
+		},
+		{
+			description:  "api",
+			path:         "/v1/state",
+			expectedCode: http.StatusTeapot,
+			expectedBody: "gateway",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			gateway := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusTeapot)
+				w.Write([]byte("gateway"))
+			})
+			handler, err := withDashboard(gateway)
+			t.CheckNoError(err)
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, test.path, nil))
+
+			t.CheckDeepEqual(test.expectedCode, recorder.Code)
+			t.CheckDeepEqual(test.expectedLocation, recorder.Header().Get("Location"))
+			t.CheckContains(test.expectedBody, recorder.Body.String())
+		})
+	}
+}
@@ -24,7 +24,9 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
 	"github.com/GoogleContainerTools/skaffold/proto/v1"
+	protoV2 "github.com/GoogleContainerTools/skaffold/proto/v2"
 )
 
 func (s *server) GetState(context.Context, *empty.Empty) (*proto.State, error) {
@@ -80,6 +82,49 @@ func (s *server) AutoSync(ctx context.Context, request *proto.TriggerRequest) (r
 	return executeAutoTrigger("sync", request, event.UpdateStateAutoSyncTrigger, func() {}, s.autoSyncCallback)
 }
 
+func (s *server) BuildArtifact(ctx context.Context, request *proto.ArtifactRequest) (*empty.Empty, error) {
+	if request.GetArtifact() == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing required parameter 'artifact'")
+	}
+	if err := s.buildArtifactCallback(request.GetArtifact()); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *server) WatchModule(ctx context.Context, request *proto.ModuleWatchRequest) (*empty.Empty, error) {
+	if request.GetModule() == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing required parameter 'module'")
+	}
+	v, ok := request.GetState().GetVal().(*proto.TriggerState_Enabled)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "missing required boolean parameter 'enabled'")
+	}
+	if err := s.watchModuleCallback(request.GetModule(), v.Enabled); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	event.UpdateStateModuleWatch(request.GetModule(), v.Enabled)
+	return &empty.Empty{}, nil
+}
+
+func (s *server) RestartPortForward(context.Context, *empty.Empty) (*empty.Empty, error) {
+	go func() {
+		s.restartPortForwardCallback()
+	}()
+	return &empty.Empty{}, nil
+}
+
+func (s *server) Redeploy(ctx context.Context, request *proto.DeployerRequest) (*empty.Empty, error) {
+	if request.GetDeployer() == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing required parameter 'deployer'")
+	}
+	if err := s.redeployCallback(request.GetDeployer()); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	event.ResetStateOnDeploy()
+	return &empty.Empty{}, nil
+}
+
 func executeAutoTrigger(triggerName string, request *proto.TriggerRequest, updateTriggerStateFunc func(bool), resetPhaseStateFunc func(), serverCallback func(bool)) (res *empty.Empty, err error) {
 	res = &empty.Empty{}
 	v, ok := request.GetState().GetVal().(*proto.TriggerState_Enabled)
@@ -107,3 +152,10 @@ func executeAutoTrigger(triggerName string, request *proto.TriggerRequest, updat
 	}()
 	return
 }
+
+// v2Server serves the version 2 of the API.
+type v2Server struct{}
+
+func (s *v2Server) Events(_ *empty.Empty, stream protoV2.SkaffoldV2Service_EventsServer) error {
+	return eventV2.ForEachEvent(stream.Send)
+}
@@ -31,9 +31,10 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/proto/v1"
+	protoV2 "github.com/GoogleContainerTools/skaffold/proto/v2"
 )
 
 const maxTryListen = 10
@@ -52,6 +53,11 @@ type server struct {
 	autoBuildCallback    func(bool)
 	autoSyncCallback     func(bool)
 	autoDeployCallback   func(bool)
+
+	buildArtifactCallback      func(string) error
+	watchModuleCallback        func(string, bool) error
+	restartPortForwardCallback func()
+	redeployCallback           func(string) error
 }
 
 func SetBuildCallback(callback func()) {
@@ -90,22 +96,56 @@ func SetAutoSyncCallback(callback func(bool)) {
 	}
 }
 
+func SetBuildArtifactCallback(callback func(string) error) {
+	if srv != nil {
+		srv.buildArtifactCallback = callback
+	}
+}
+
+func SetWatchModuleCallback(callback func(string, bool) error) {
+	if srv != nil {
+		srv.watchModuleCallback = callback
+	}
+}
+
+func SetRestartPortForwardCallback(callback func()) {
+	if srv != nil {
+		srv.restartPortForwardCallback = callback
+	}
+}
+
+func SetRedeployCallback(callback func(string) error) {
+	if srv != nil {
+		srv.redeployCallback = callback
+	}
+}
+
 // Initialize creates the gRPC and HTTP servers for serving the state and event log.
 // It returns a shutdown callback for tearing down the grpc server,
 // which the runner is responsible for calling.
 func Initialize(opts config.SkaffoldOptions) (func() error, error) {
+	return initialize(opts, true)
+}
+
+// InitializeV2 creates the gRPC and HTTP servers for serving only the v2 API.
+// The v1 API is not available: its calls fail with an `Unimplemented` error.
+func InitializeV2(opts config.SkaffoldOptions) (func() error, error) {
+	return initialize(opts, false)
+}
+
+func initialize(opts config.SkaffoldOptions, withV1 bool) (func() error, error) {
 	if !opts.EnableRPC || opts.RPCPort == -1 {
 		return func() error { return nil }, nil
 	}
 
 	var usedPorts util.PortSet
 
-	grpcCallback, rpcPort, err := newGRPCServer(opts.RPCPort, &usedPorts)
+	grpcCallback, rpcPort, err := newGRPCServer(opts.RPCPort, &usedPorts, withV1)
 	if err != nil {
 		return grpcCallback, fmt.Errorf("starting gRPC server: %w", err)
 	}
 
-	httpCallback, err := newHTTPServer(opts.RPCHTTPPort, rpcPort, &usedPorts)
+	httpCallback, err := newHTTPServer(opts.RPCHTTPPort, rpcPort, &usedPorts, withV1)
 	callback := func() error {
 		httpErr := httpCallback()
 		grpcErr := grpcCallback()
@@ -117,7 +157,7 @@ func Initialize(opts config.SkaffoldOptions) (func() error, error) {
 			errStr += fmt.Sprintf("http callback error: %s\n", httpErr.Error())
 		}
 		if opts.EventLogFile != "" {
-			logFileErr := event.SaveEventsToFile(opts.EventLogFile)
+			logFileErr := eventV2.SaveEventsToFile(opts.EventLogFile)
 			if logFileErr != nil {
 				errStr += fmt.Sprintf("event log file error: %s\n", logFileErr.Error())
 			}
@@ -131,7 +171,7 @@ func Initialize(opts config.SkaffoldOptions) (func() error, error) {
 	return callback, nil
 }
 
-func newGRPCServer(preferredPort int, usedPorts *util.PortSet) (func() error, int, error) {
+func newGRPCServer(preferredPort int, usedPorts *util.PortSet, withV1 bool) (func() error, int, error) {
 	l, port, err := listenOnAvailablePort(preferredPort, usedPorts)
 	if err != nil {
 		return func() error { return nil }, 0, fmt.Errorf("creating listener: %w", err)
@@ -151,8 +191,16 @@ func newGRPCServer(preferredPort int, usedPorts *util.PortSet) (func() error, in
 		autoBuildCallback:    func(bool) {},
 		autoSyncCallback:     func(bool) {},
 		autoDeployCallback:   func(bool) {},
+
+		buildArtifactCallback:      func(string) error { return nil },
+		watchModuleCallback:        func(string, bool) error { return nil },
+		restartPortForwardCallback: func() {},
+		redeployCallback:           func(string) error { return nil },
+	}
+	if withV1 {
+		proto.RegisterSkaffoldServiceServer(s, srv)
 	}
-	proto.RegisterSkaffoldServiceServer(s, srv)
+	protoV2.RegisterSkaffoldV2ServiceServer(s, &v2Server{})
 
 	go func() {
 		if err := s.Serve(l); err != nil {
@@ -178,10 +226,16 @@ func newGRPCServer(preferredPort int, usedPorts *util.PortSet) (func() error, in
 	}, port, nil
 }
 
-func newHTTPServer(preferredPort, proxyPort int, usedPorts *util.PortSet) (func() error, error) {
+func newHTTPServer(preferredPort, proxyPort int, usedPorts *util.PortSet, withV1 bool) (func() error, error) {
 	mux := runtime.NewServeMux(runtime.WithProtoErrorHandler(errorHandler))
 	opts := []grpc.DialOption{grpc.WithInsecure()}
-	err := proto.RegisterSkaffoldServiceHandlerFromEndpoint(context.Background(), mux, fmt.Sprintf("%s:%d", util.Loopback, proxyPort), opts)
+	if withV1 {
+		err := proto.RegisterSkaffoldServiceHandlerFromEndpoint(context.Background(), mux, fmt.Sprintf("%s:%d", util.Loopback, proxyPort), opts)
+		if err != nil {
+			return func() error { return nil }, err
+		}
+	}
+	err := protoV2.RegisterSkaffoldV2ServiceHandlerFromEndpoint(context.Background(), mux, fmt.Sprintf("%s:%d", util.Loopback, proxyPort), opts)
 	if err != nil {
 		return func() error { return nil }, err
 	}
@@ -197,8 +251,19 @@ func newHTTPServer(preferredPort, proxyPort int, usedPorts *util.PortSet) (func(
 		logrus.Infof("starting gRPC HTTP server on port %d", port)
 	}
 
+	var handler http.Handler = mux
+	if withV1 {
+		// The dashboard uses the v1 API.
+		handler, err = withDashboard(mux)
+		if err != nil {
+			l.Close()
+			return func() error { return nil }, err
+		}
+		logrus.Infof("web dashboard available at http://%s:%d%s", util.Loopback, port, dashboardPath)
+	}
+
 	server := &http.Server{
-		Handler: mux,
+		Handler: handler,
 	}
 
 	go server.Serve(l)
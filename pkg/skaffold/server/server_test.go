@@ -17,11 +17,15 @@ limitations under the License.
 package server
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"testing"
 
+	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/proto/v1"
@@ -66,3 +70,22 @@ func TestServerStartup(t *testing.T) {
 		httpConn.Close()
 	}
 }
+
+func TestServerStartupV2Only(t *testing.T) {
+	shutdown, err := InitializeV2(config.SkaffoldOptions{
+		EnableRPC:   true,
+		RPCPort:     rpcAddr + 1,
+		RPCHTTPPort: httpAddr + 1,
+	})
+	defer shutdown()
+	testutil.CheckError(t, false, err)
+
+	conn, err := grpc.Dial(fmt.Sprintf(":%d", rpcAddr+1), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("unable to establish skaffold grpc connection")
+	}
+	defer conn.Close()
+
+	_, err = proto.NewSkaffoldServiceClient(conn).GetState(context.Background(), &empty.Empty{})
+	testutil.CheckDeepEqual(t, codes.Unimplemented, status.Code(err))
+}
@@ -41,6 +41,9 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
+// errNoSync is returned when no pod runs the image whose files are synced.
+var errNoSync = errors.New("didn't sync any files")
+
 // For testing
 var (
 	WorkingDir = docker.RetrieveWorkingDir
@@ -255,7 +258,7 @@ func (s *podSyncer) Sync(ctx context.Context, item *Item) error {
 	if len(item.Copy) > 0 {
 		logrus.Infoln("Copying files:", item.Copy, "to", item.Image)
 
-		if err := Perform(ctx, item.Image, item.Copy, s.copyFileFn, s.namespaces); err != nil {
+		if err := Perform(ctx, s.kubeContext, item.Image, item.Copy, s.copyFileFn, s.namespaces); err != nil {
 			return fmt.Errorf("copying files: %w", err)
 		}
 	}
@@ -263,7 +266,7 @@ func (s *podSyncer) Sync(ctx context.Context, item *Item) error {
 	if len(item.Delete) > 0 {
 		logrus.Infoln("Deleting files:", item.Delete, "from", item.Image)
 
-		if err := Perform(ctx, item.Image, item.Delete, s.deleteFileFn, s.namespaces); err != nil {
+		if err := Perform(ctx, s.kubeContext, item.Image, item.Delete, s.deleteFileFn, s.namespaces); err != nil {
 			return fmt.Errorf("deleting files: %w", err)
 		}
 	}
@@ -271,14 +274,16 @@ func (s *podSyncer) Sync(ctx context.Context, item *Item) error {
 	return nil
 }
 
-func Perform(ctx context.Context, image string, files syncMap, cmdFn func(context.Context, v1.Pod, v1.Container, syncMap) *exec.Cmd, namespaces []string) error {
+// Perform runs the sync command against the containers of the running pods of the given image,
+// in the cluster of the given kube-context. An empty kube-context stands for the active one.
+func Perform(ctx context.Context, kubeContext string, image string, files syncMap, cmdFn func(context.Context, v1.Pod, v1.Container, syncMap) *exec.Cmd, namespaces []string) error {
 	if len(files) == 0 {
 		return nil
 	}
 
 	errs, ctx := errgroup.WithContext(ctx)
 
-	client, err := kubernetesclient.Client()
+	client, err := kubernetesclient.ClientForContext(kubeContext)
 	if err != nil {
 		return fmt.Errorf("getting Kubernetes client: %w", err)
 	}
@@ -315,11 +320,33 @@ func Perform(ctx context.Context, image string, files syncMap, cmdFn func(contex
 	}
 
 	if numSynced == 0 {
-		return errors.New("didn't sync any files")
+		return errNoSync
 	}
 	return nil
 }
 
+// Sync syncs the files to the pods of every cluster. It only fails to find the pods of the image
+// if none of the clusters run them.
+func (s clusterSyncer) Sync(ctx context.Context, item *Item) error {
+	var noSyncErr error
+	synced := false
+	for _, syncer := range s {
+		err := syncer.Sync(ctx, item)
+		switch {
+		case err == nil:
+			synced = true
+		case errors.Is(err, errNoSync):
+			noSyncErr = err
+		default:
+			return err
+		}
+	}
+	if synced {
+		return nil
+	}
+	return noSyncErr
+}
+
 func Init(ctx context.Context, artifacts []*latest.Artifact) error {
 	for _, a := range artifacts {
 		if a.Sync == nil {
@@ -34,6 +34,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/jib"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
+	pkgkubectl "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
@@ -962,7 +963,59 @@ func TestPerform(t *testing.T) {
 				return fake.NewSimpleClientset(test.pod), test.clientErr
 			})
 
-			err := Perform(context.Background(), test.image, test.files, test.cmdFn, []string{""})
+			err := Perform(context.Background(), "", test.image, test.files, test.cmdFn, []string{""})
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, cmdRecord.cmds)
+		})
+	}
+}
+
+func TestClusterSyncer(t *testing.T) {
+	tests := []struct {
+		description string
+		pods        map[string]*v1.Pod
+		expected    []string
+		shouldErr   bool
+	}{
+		{
+			description: "sync to the kube-context that runs the pods",
+			pods:        map[string]*v1.Pod{"second": pod},
+			expected:    []string{"kubectl --context second exec podname --namespace  -c container_name -- rm -rf -- /test.go"},
+		},
+		{
+			description: "sync to every kube-context that runs the pods",
+			pods:        map[string]*v1.Pod{"first": pod, "second": pod},
+			expected: []string{
+				"kubectl --context first exec podname --namespace  -c container_name -- rm -rf -- /test.go",
+				"kubectl --context second exec podname --namespace  -c container_name -- rm -rf -- /test.go",
+			},
+		},
+		{
+			description: "no kube-context runs the pods",
+			pods:        map[string]*v1.Pod{"first": nonRunningPod},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			cmdRecord := &TestCmdRecorder{}
+			t.Override(&util.DefaultExecCommand, cmdRecord)
+			t.Override(&client.ClientForContext, func(kubeContext string) (kubernetes.Interface, error) {
+				if p, found := test.pods[kubeContext]; found {
+					return fake.NewSimpleClientset(p), nil
+				}
+				return fake.NewSimpleClientset(), nil
+			})
+
+			var syncer clusterSyncer
+			for _, kubeContext := range []string{"first", "second"} {
+				syncer = append(syncer, &podSyncer{
+					kubectl:     &pkgkubectl.CLI{KubeContext: kubeContext},
+					kubeContext: kubeContext,
+					namespaces:  []string{""},
+				})
+			}
+			err := syncer.Sync(context.Background(), &Item{Image: "gcr.io/k8s-skaffold:123", Delete: syncMap{"test.go": {"/test.go"}}})
 
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, cmdRecord.cmds)
 		})
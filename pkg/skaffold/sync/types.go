@@ -36,10 +36,14 @@ type Syncer interface {
 }
 
 type podSyncer struct {
-	kubectl    *pkgkubectl.CLI
-	namespaces []string
+	kubectl     *pkgkubectl.CLI
+	kubeContext string
+	namespaces  []string
 }
 
+// clusterSyncer syncs the files to the pods of several clusters, with one Syncer per kube-context.
+type clusterSyncer []Syncer
+
 type Config interface {
 	kubectl.Config
 
@@ -48,7 +52,22 @@ type Config interface {
 
 func NewSyncer(cfg Config) Syncer {
 	return &podSyncer{
-		kubectl:    pkgkubectl.NewCLI(cfg, ""),
-		namespaces: cfg.GetNamespaces(),
+		kubectl:     pkgkubectl.NewCLI(cfg, ""),
+		kubeContext: cfg.GetKubeContext(),
+		namespaces:  cfg.GetNamespaces(),
+	}
+}
+
+// NewSyncerForClusters returns a Syncer that syncs the files to the pods of the clusters
+// that the given configs deploy to, one config per kube-context.
+func NewSyncerForClusters(cfgs []Config) Syncer {
+	if len(cfgs) == 1 {
+		return NewSyncer(cfgs[0])
+	}
+
+	var syncers clusterSyncer
+	for _, cfg := range cfgs {
+		syncers = append(syncers, NewSyncer(cfg))
 	}
+	return syncers
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package util
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -25,8 +26,13 @@ import (
 )
 
 func Download(url string) ([]byte, error) {
+	return DownloadWithContext(context.Background(), url)
+}
+
+// DownloadWithContext gets the content of a url, and stops when the context is cancelled.
+func DownloadWithContext(ctx context.Context, url string) ([]byte, error) {
 	client := http.Client{}
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("creating http request: %w", err)
 	}
@@ -0,0 +1,38 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"errors"
+	"os"
+	"os/user"
+	"strings"
+)
+
+// CurrentUsername returns the name of the user running Skaffold.
+func CurrentUsername() (string, error) {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		// On Windows, the username is prefixed by the domain
+		return u.Username[strings.LastIndex(u.Username, `\`)+1:], nil
+	}
+	for _, env := range []string{"USER", "USERNAME"} {
+		if name := os.Getenv(env); name != "" {
+			return name, nil
+		}
+	}
+	return "", errors.New("unable to find the current user")
+}
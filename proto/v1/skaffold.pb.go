@@ -980,6 +980,8 @@ type State struct {
 	DebuggingContainers  []*DebuggingContainerEvent `protobuf:"bytes,7,rep,name=debuggingContainers,proto3" json:"debuggingContainers,omitempty"`
 	Metadata             *Metadata                  `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	TestState            *TestState                 `protobuf:"bytes,9,opt,name=testState,proto3" json:"testState,omitempty"`
+	Modules              map[string]*ModuleState    `protobuf:"bytes,10,rep,name=modules,proto3" json:"modules,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Deployers            []string                   `protobuf:"bytes,11,rep,name=deployers,proto3" json:"deployers,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -1066,6 +1068,68 @@ func (m *State) GetTestState() *TestState {
 	return nil
 }
 
+func (m *State) GetModules() map[string]*ModuleState {
+	if m != nil {
+		return m.Modules
+	}
+	return nil
+}
+
+func (m *State) GetDeployers() []string {
+	if m != nil {
+		return m.Deployers
+	}
+	return nil
+}
+
+// `ModuleState` describes a config module of the dev loop
+type ModuleState struct {
+	Watched              bool     `protobuf:"varint,1,opt,name=watched,proto3" json:"watched,omitempty"`
+	Artifacts            []string `protobuf:"bytes,2,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModuleState) Reset()         { *m = ModuleState{} }
+func (m *ModuleState) String() string { return proto.CompactTextString(m) }
+func (*ModuleState) ProtoMessage()    {}
+func (*ModuleState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{4}
+}
+
+func (m *ModuleState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModuleState.Unmarshal(m, b)
+}
+func (m *ModuleState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModuleState.Marshal(b, m, deterministic)
+}
+func (m *ModuleState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleState.Merge(m, src)
+}
+func (m *ModuleState) XXX_Size() int {
+	return xxx_messageInfo_ModuleState.Size(m)
+}
+func (m *ModuleState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleState proto.InternalMessageInfo
+
+func (m *ModuleState) GetWatched() bool {
+	if m != nil {
+		return m.Watched
+	}
+	return false
+}
+
+func (m *ModuleState) GetArtifacts() []string {
+	if m != nil {
+		return m.Artifacts
+	}
+	return nil
+}
+
 type Metadata struct {
 	Build  *BuildMetadata  `protobuf:"bytes,1,opt,name=build,proto3" json:"build,omitempty"`
 	Deploy *DeployMetadata `protobuf:"bytes,2,opt,name=deploy,proto3" json:"deploy,omitempty"`
@@ -1081,7 +1145,7 @@ func (m *Metadata) Reset()         { *m = Metadata{} }
 func (m *Metadata) String() string { return proto.CompactTextString(m) }
 func (*Metadata) ProtoMessage()    {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{4}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{5}
 }
 
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
@@ -1145,7 +1209,7 @@ func (m *BuildMetadata) Reset()         { *m = BuildMetadata{} }
 func (m *BuildMetadata) String() string { return proto.CompactTextString(m) }
 func (*BuildMetadata) ProtoMessage()    {}
 func (*BuildMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{5}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{6}
 }
 
 func (m *BuildMetadata) XXX_Unmarshal(b []byte) error {
@@ -1206,7 +1270,7 @@ func (m *BuildMetadata_ImageBuilder) Reset()         { *m = BuildMetadata_ImageB
 func (m *BuildMetadata_ImageBuilder) String() string { return proto.CompactTextString(m) }
 func (*BuildMetadata_ImageBuilder) ProtoMessage()    {}
 func (*BuildMetadata_ImageBuilder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{5, 0}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{6, 0}
 }
 
 func (m *BuildMetadata_ImageBuilder) XXX_Unmarshal(b []byte) error {
@@ -1253,7 +1317,7 @@ func (m *TestMetadata) Reset()         { *m = TestMetadata{} }
 func (m *TestMetadata) String() string { return proto.CompactTextString(m) }
 func (*TestMetadata) ProtoMessage()    {}
 func (*TestMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{6}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{7}
 }
 
 func (m *TestMetadata) XXX_Unmarshal(b []byte) error {
@@ -1293,7 +1357,7 @@ func (m *TestMetadata_Tester) Reset()         { *m = TestMetadata_Tester{} }
 func (m *TestMetadata_Tester) String() string { return proto.CompactTextString(m) }
 func (*TestMetadata_Tester) ProtoMessage()    {}
 func (*TestMetadata_Tester) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{6, 0}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{7, 0}
 }
 
 func (m *TestMetadata_Tester) XXX_Unmarshal(b []byte) error {
@@ -1340,7 +1404,7 @@ func (m *DeployMetadata) Reset()         { *m = DeployMetadata{} }
 func (m *DeployMetadata) String() string { return proto.CompactTextString(m) }
 func (*DeployMetadata) ProtoMessage()    {}
 func (*DeployMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{7}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{8}
 }
 
 func (m *DeployMetadata) XXX_Unmarshal(b []byte) error {
@@ -1387,7 +1451,7 @@ func (m *DeployMetadata_Deployer) Reset()         { *m = DeployMetadata_Deployer
 func (m *DeployMetadata_Deployer) String() string { return proto.CompactTextString(m) }
 func (*DeployMetadata_Deployer) ProtoMessage()    {}
 func (*DeployMetadata_Deployer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{7, 0}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{8, 0}
 }
 
 func (m *DeployMetadata_Deployer) XXX_Unmarshal(b []byte) error {
@@ -1443,7 +1507,7 @@ func (m *BuildState) Reset()         { *m = BuildState{} }
 func (m *BuildState) String() string { return proto.CompactTextString(m) }
 func (*BuildState) ProtoMessage()    {}
 func (*BuildState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{8}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{9}
 }
 
 func (m *BuildState) XXX_Unmarshal(b []byte) error {
@@ -1500,7 +1564,7 @@ func (m *TestState) Reset()         { *m = TestState{} }
 func (m *TestState) String() string { return proto.CompactTextString(m) }
 func (*TestState) ProtoMessage()    {}
 func (*TestState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{9}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{10}
 }
 
 func (m *TestState) XXX_Unmarshal(b []byte) error {
@@ -1549,7 +1613,7 @@ func (m *DeployState) Reset()         { *m = DeployState{} }
 func (m *DeployState) String() string { return proto.CompactTextString(m) }
 func (*DeployState) ProtoMessage()    {}
 func (*DeployState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{10}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{11}
 }
 
 func (m *DeployState) XXX_Unmarshal(b []byte) error {
@@ -1612,7 +1676,7 @@ func (m *StatusCheckState) Reset()         { *m = StatusCheckState{} }
 func (m *StatusCheckState) String() string { return proto.CompactTextString(m) }
 func (*StatusCheckState) ProtoMessage()    {}
 func (*StatusCheckState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{11}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{12}
 }
 
 func (m *StatusCheckState) XXX_Unmarshal(b []byte) error {
@@ -1667,7 +1731,7 @@ func (m *FileSyncState) Reset()         { *m = FileSyncState{} }
 func (m *FileSyncState) String() string { return proto.CompactTextString(m) }
 func (*FileSyncState) ProtoMessage()    {}
 func (*FileSyncState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{12}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{13}
 }
 
 func (m *FileSyncState) XXX_Unmarshal(b []byte) error {
@@ -1703,7 +1767,7 @@ func (m *FileSyncState) GetAutoTrigger() bool {
 }
 
 // `Event` describes an event in the Skaffold process.
-// It is one of MetaEvent, BuildEvent, TestEvent, DeployEvent, PortEvent, StatusCheckEvent, ResourceStatusCheckEvent, FileSyncEvent, or DebuggingContainerEvent.
+// It is one of MetaEvent, BuildEvent, TestEvent, DeployEvent, PortEvent, StatusCheckEvent, ResourceStatusCheckEvent, FileSyncEvent, DebuggingContainerEvent, or KubernetesEvent.
 type Event struct {
 	// Types that are valid to be assigned to EventType:
 	//	*Event_MetaEvent
@@ -1717,6 +1781,8 @@ type Event struct {
 	//	*Event_DevLoopEvent
 	//	*Event_TerminationEvent
 	//	*Event_TestEvent
+	//	*Event_KubernetesEvent
+	//	*Event_ApplicationLogEvent
 	EventType            isEvent_EventType `protobuf_oneof:"event_type"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
@@ -1727,7 +1793,7 @@ func (m *Event) Reset()         { *m = Event{} }
 func (m *Event) String() string { return proto.CompactTextString(m) }
 func (*Event) ProtoMessage()    {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{13}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{14}
 }
 
 func (m *Event) XXX_Unmarshal(b []byte) error {
@@ -1796,6 +1862,14 @@ type Event_TestEvent struct {
 	TestEvent *TestEvent `protobuf:"bytes,11,opt,name=TestEvent,proto3,oneof"`
 }
 
+type Event_KubernetesEvent struct {
+	KubernetesEvent *KubernetesEvent `protobuf:"bytes,12,opt,name=kubernetesEvent,proto3,oneof"`
+}
+
+type Event_ApplicationLogEvent struct {
+	ApplicationLogEvent *ApplicationLogEvent `protobuf:"bytes,13,opt,name=applicationLogEvent,proto3,oneof"`
+}
+
 func (*Event_MetaEvent) isEvent_EventType() {}
 
 func (*Event_BuildEvent) isEvent_EventType() {}
@@ -1818,6 +1892,10 @@ func (*Event_TerminationEvent) isEvent_EventType() {}
 
 func (*Event_TestEvent) isEvent_EventType() {}
 
+func (*Event_KubernetesEvent) isEvent_EventType() {}
+
+func (*Event_ApplicationLogEvent) isEvent_EventType() {}
+
 func (m *Event) GetEventType() isEvent_EventType {
 	if m != nil {
 		return m.EventType
@@ -1902,6 +1980,20 @@ func (m *Event) GetTestEvent() *TestEvent {
 	return nil
 }
 
+func (m *Event) GetKubernetesEvent() *KubernetesEvent {
+	if x, ok := m.GetEventType().(*Event_KubernetesEvent); ok {
+		return x.KubernetesEvent
+	}
+	return nil
+}
+
+func (m *Event) GetApplicationLogEvent() *ApplicationLogEvent {
+	if x, ok := m.GetEventType().(*Event_ApplicationLogEvent); ok {
+		return x.ApplicationLogEvent
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Event) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1916,6 +2008,8 @@ func (*Event) XXX_OneofWrappers() []interface{} {
 		(*Event_DevLoopEvent)(nil),
 		(*Event_TerminationEvent)(nil),
 		(*Event_TestEvent)(nil),
+		(*Event_KubernetesEvent)(nil),
+		(*Event_ApplicationLogEvent)(nil),
 	}
 }
 
@@ -1932,7 +2026,7 @@ func (m *TerminationEvent) Reset()         { *m = TerminationEvent{} }
 func (m *TerminationEvent) String() string { return proto.CompactTextString(m) }
 func (*TerminationEvent) ProtoMessage()    {}
 func (*TerminationEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{14}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{15}
 }
 
 func (m *TerminationEvent) XXX_Unmarshal(b []byte) error {
@@ -1981,7 +2075,7 @@ func (m *DevLoopEvent) Reset()         { *m = DevLoopEvent{} }
 func (m *DevLoopEvent) String() string { return proto.CompactTextString(m) }
 func (*DevLoopEvent) ProtoMessage()    {}
 func (*DevLoopEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{15}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{16}
 }
 
 func (m *DevLoopEvent) XXX_Unmarshal(b []byte) error {
@@ -2037,7 +2131,7 @@ func (m *ActionableErr) Reset()         { *m = ActionableErr{} }
 func (m *ActionableErr) String() string { return proto.CompactTextString(m) }
 func (*ActionableErr) ProtoMessage()    {}
 func (*ActionableErr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{16}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{17}
 }
 
 func (m *ActionableErr) XXX_Unmarshal(b []byte) error {
@@ -2094,7 +2188,7 @@ func (m *MetaEvent) Reset()         { *m = MetaEvent{} }
 func (m *MetaEvent) String() string { return proto.CompactTextString(m) }
 func (*MetaEvent) ProtoMessage()    {}
 func (*MetaEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{17}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{18}
 }
 
 func (m *MetaEvent) XXX_Unmarshal(b []byte) error {
@@ -2146,7 +2240,7 @@ func (m *BuildEvent) Reset()         { *m = BuildEvent{} }
 func (m *BuildEvent) String() string { return proto.CompactTextString(m) }
 func (*BuildEvent) ProtoMessage()    {}
 func (*BuildEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{18}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{19}
 }
 
 func (m *BuildEvent) XXX_Unmarshal(b []byte) error {
@@ -2216,7 +2310,7 @@ func (m *TestEvent) Reset()         { *m = TestEvent{} }
 func (m *TestEvent) String() string { return proto.CompactTextString(m) }
 func (*TestEvent) ProtoMessage()    {}
 func (*TestEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{19}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{20}
 }
 
 func (m *TestEvent) XXX_Unmarshal(b []byte) error {
@@ -2267,7 +2361,7 @@ func (m *DeployEvent) Reset()         { *m = DeployEvent{} }
 func (m *DeployEvent) String() string { return proto.CompactTextString(m) }
 func (*DeployEvent) ProtoMessage()    {}
 func (*DeployEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{20}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{21}
 }
 
 func (m *DeployEvent) XXX_Unmarshal(b []byte) error {
@@ -2332,7 +2426,7 @@ func (m *StatusCheckEvent) Reset()         { *m = StatusCheckEvent{} }
 func (m *StatusCheckEvent) String() string { return proto.CompactTextString(m) }
 func (*StatusCheckEvent) ProtoMessage()    {}
 func (*StatusCheckEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{21}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{22}
 }
 
 func (m *StatusCheckEvent) XXX_Unmarshal(b []byte) error {
@@ -2400,6 +2494,7 @@ type ResourceStatusCheckEvent struct {
 	Err                  string         `protobuf:"bytes,4,opt,name=err,proto3" json:"err,omitempty"`
 	StatusCode           StatusCode     `protobuf:"varint,5,opt,name=statusCode,proto3,enum=proto.StatusCode" json:"statusCode,omitempty"`
 	ActionableErr        *ActionableErr `protobuf:"bytes,6,opt,name=actionableErr,proto3" json:"actionableErr,omitempty"`
+	KubeContext          string         `protobuf:"bytes,7,opt,name=kubeContext,proto3" json:"kubeContext,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -2409,7 +2504,7 @@ func (m *ResourceStatusCheckEvent) Reset()         { *m = ResourceStatusCheckEve
 func (m *ResourceStatusCheckEvent) String() string { return proto.CompactTextString(m) }
 func (*ResourceStatusCheckEvent) ProtoMessage()    {}
 func (*ResourceStatusCheckEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{22}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{23}
 }
 
 func (m *ResourceStatusCheckEvent) XXX_Unmarshal(b []byte) error {
@@ -2472,6 +2567,13 @@ func (m *ResourceStatusCheckEvent) GetActionableErr() *ActionableErr {
 	return nil
 }
 
+func (m *ResourceStatusCheckEvent) GetKubeContext() string {
+	if m != nil {
+		return m.KubeContext
+	}
+	return ""
+}
+
 // PortEvent Event describes each port forwarding event.
 type PortEvent struct {
 	LocalPort            int32        `protobuf:"varint,1,opt,name=localPort,proto3" json:"localPort,omitempty"`
@@ -2484,6 +2586,7 @@ type PortEvent struct {
 	ResourceName         string       `protobuf:"bytes,8,opt,name=resourceName,proto3" json:"resourceName,omitempty"`
 	Address              string       `protobuf:"bytes,9,opt,name=address,proto3" json:"address,omitempty"`
 	TargetPort           *IntOrString `protobuf:"bytes,10,opt,name=targetPort,proto3" json:"targetPort,omitempty"`
+	KubeContext          string       `protobuf:"bytes,11,opt,name=kubeContext,proto3" json:"kubeContext,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -2493,7 +2596,7 @@ func (m *PortEvent) Reset()         { *m = PortEvent{} }
 func (m *PortEvent) String() string { return proto.CompactTextString(m) }
 func (*PortEvent) ProtoMessage()    {}
 func (*PortEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{23}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{24}
 }
 
 func (m *PortEvent) XXX_Unmarshal(b []byte) error {
@@ -2584,6 +2687,13 @@ func (m *PortEvent) GetTargetPort() *IntOrString {
 	return nil
 }
 
+func (m *PortEvent) GetKubeContext() string {
+	if m != nil {
+		return m.KubeContext
+	}
+	return ""
+}
+
 // FileSyncEvent describes the sync status.
 type FileSyncEvent struct {
 	FileCount            int32          `protobuf:"varint,1,opt,name=fileCount,proto3" json:"fileCount,omitempty"`
@@ -2601,7 +2711,7 @@ func (m *FileSyncEvent) Reset()         { *m = FileSyncEvent{} }
 func (m *FileSyncEvent) String() string { return proto.CompactTextString(m) }
 func (*FileSyncEvent) ProtoMessage()    {}
 func (*FileSyncEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{24}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{25}
 }
 
 func (m *FileSyncEvent) XXX_Unmarshal(b []byte) error {
@@ -2683,7 +2793,7 @@ func (m *DebuggingContainerEvent) Reset()         { *m = DebuggingContainerEvent
 func (m *DebuggingContainerEvent) String() string { return proto.CompactTextString(m) }
 func (*DebuggingContainerEvent) ProtoMessage()    {}
 func (*DebuggingContainerEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{25}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{26}
 }
 
 func (m *DebuggingContainerEvent) XXX_Unmarshal(b []byte) error {
@@ -2760,6 +2870,199 @@ func (m *DebuggingContainerEvent) GetDebugPorts() map[string]uint32 {
 	return nil
 }
 
+// KubernetesEvent describes a warning Kubernetes event, or the abnormal termination of a container,
+// for a resource deployed by Skaffold.
+type KubernetesEvent struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Kind                 string   `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name                 string   `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace            string   `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ContainerName        string   `protobuf:"bytes,7,opt,name=containerName,proto3" json:"containerName,omitempty"`
+	ExitCode             int32    `protobuf:"varint,8,opt,name=exitCode,proto3" json:"exitCode,omitempty"`
+	RestartCount         int32    `protobuf:"varint,9,opt,name=restartCount,proto3" json:"restartCount,omitempty"`
+	Count                int32    `protobuf:"varint,10,opt,name=count,proto3" json:"count,omitempty"`
+	KubeContext          string   `protobuf:"bytes,11,opt,name=kubeContext,proto3" json:"kubeContext,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KubernetesEvent) Reset()         { *m = KubernetesEvent{} }
+func (m *KubernetesEvent) String() string { return proto.CompactTextString(m) }
+func (*KubernetesEvent) ProtoMessage()    {}
+func (*KubernetesEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{27}
+}
+
+func (m *KubernetesEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KubernetesEvent.Unmarshal(m, b)
+}
+func (m *KubernetesEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KubernetesEvent.Marshal(b, m, deterministic)
+}
+func (m *KubernetesEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KubernetesEvent.Merge(m, src)
+}
+func (m *KubernetesEvent) XXX_Size() int {
+	return xxx_messageInfo_KubernetesEvent.Size(m)
+}
+func (m *KubernetesEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_KubernetesEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KubernetesEvent proto.InternalMessageInfo
+
+func (m *KubernetesEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *KubernetesEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *KubernetesEvent) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *KubernetesEvent) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *KubernetesEvent) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *KubernetesEvent) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *KubernetesEvent) GetContainerName() string {
+	if m != nil {
+		return m.ContainerName
+	}
+	return ""
+}
+
+func (m *KubernetesEvent) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *KubernetesEvent) GetRestartCount() int32 {
+	if m != nil {
+		return m.RestartCount
+	}
+	return 0
+}
+
+func (m *KubernetesEvent) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *KubernetesEvent) GetKubeContext() string {
+	if m != nil {
+		return m.KubeContext
+	}
+	return ""
+}
+
+// `ApplicationLogEvent` describes a log line of a container deployed by Skaffold.
+type ApplicationLogEvent struct {
+	ContainerName        string   `protobuf:"bytes,1,opt,name=containerName,proto3" json:"containerName,omitempty"`
+	PodName              string   `protobuf:"bytes,2,opt,name=podName,proto3" json:"podName,omitempty"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Message              string   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	KubeContext          string   `protobuf:"bytes,5,opt,name=kubeContext,proto3" json:"kubeContext,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationLogEvent) Reset()         { *m = ApplicationLogEvent{} }
+func (m *ApplicationLogEvent) String() string { return proto.CompactTextString(m) }
+func (*ApplicationLogEvent) ProtoMessage()    {}
+func (*ApplicationLogEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{28}
+}
+
+func (m *ApplicationLogEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationLogEvent.Unmarshal(m, b)
+}
+func (m *ApplicationLogEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationLogEvent.Marshal(b, m, deterministic)
+}
+func (m *ApplicationLogEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationLogEvent.Merge(m, src)
+}
+func (m *ApplicationLogEvent) XXX_Size() int {
+	return xxx_messageInfo_ApplicationLogEvent.Size(m)
+}
+func (m *ApplicationLogEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationLogEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationLogEvent proto.InternalMessageInfo
+
+func (m *ApplicationLogEvent) GetContainerName() string {
+	if m != nil {
+		return m.ContainerName
+	}
+	return ""
+}
+
+func (m *ApplicationLogEvent) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
+}
+
+func (m *ApplicationLogEvent) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ApplicationLogEvent) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ApplicationLogEvent) GetKubeContext() string {
+	if m != nil {
+		return m.KubeContext
+	}
+	return ""
+}
+
 // LogEntry describes an event and a string description of the event.
 type LogEntry struct {
 	Timestamp            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
@@ -2774,7 +3077,7 @@ func (m *LogEntry) Reset()         { *m = LogEntry{} }
 func (m *LogEntry) String() string { return proto.CompactTextString(m) }
 func (*LogEntry) ProtoMessage()    {}
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{26}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{29}
 }
 
 func (m *LogEntry) XXX_Unmarshal(b []byte) error {
@@ -2827,7 +3130,7 @@ func (m *UserIntentRequest) Reset()         { *m = UserIntentRequest{} }
 func (m *UserIntentRequest) String() string { return proto.CompactTextString(m) }
 func (*UserIntentRequest) ProtoMessage()    {}
 func (*UserIntentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{27}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{30}
 }
 
 func (m *UserIntentRequest) XXX_Unmarshal(b []byte) error {
@@ -2866,7 +3169,7 @@ func (m *TriggerRequest) Reset()         { *m = TriggerRequest{} }
 func (m *TriggerRequest) String() string { return proto.CompactTextString(m) }
 func (*TriggerRequest) ProtoMessage()    {}
 func (*TriggerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{28}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{31}
 }
 
 func (m *TriggerRequest) XXX_Unmarshal(b []byte) error {
@@ -2908,7 +3211,7 @@ func (m *TriggerState) Reset()         { *m = TriggerState{} }
 func (m *TriggerState) String() string { return proto.CompactTextString(m) }
 func (*TriggerState) ProtoMessage()    {}
 func (*TriggerState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{29}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{32}
 }
 
 func (m *TriggerState) XXX_Unmarshal(b []byte) error {
@@ -2974,7 +3277,7 @@ func (m *Intent) Reset()         { *m = Intent{} }
 func (m *Intent) String() string { return proto.CompactTextString(m) }
 func (*Intent) ProtoMessage()    {}
 func (*Intent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{30}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{33}
 }
 
 func (m *Intent) XXX_Unmarshal(b []byte) error {
@@ -3016,6 +3319,131 @@ func (m *Intent) GetDeploy() bool {
 	return false
 }
 
+type ArtifactRequest struct {
+	Artifact             string   `protobuf:"bytes,1,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArtifactRequest) Reset()         { *m = ArtifactRequest{} }
+func (m *ArtifactRequest) String() string { return proto.CompactTextString(m) }
+func (*ArtifactRequest) ProtoMessage()    {}
+func (*ArtifactRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{34}
+}
+
+func (m *ArtifactRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArtifactRequest.Unmarshal(m, b)
+}
+func (m *ArtifactRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArtifactRequest.Marshal(b, m, deterministic)
+}
+func (m *ArtifactRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactRequest.Merge(m, src)
+}
+func (m *ArtifactRequest) XXX_Size() int {
+	return xxx_messageInfo_ArtifactRequest.Size(m)
+}
+func (m *ArtifactRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactRequest proto.InternalMessageInfo
+
+func (m *ArtifactRequest) GetArtifact() string {
+	if m != nil {
+		return m.Artifact
+	}
+	return ""
+}
+
+type ModuleWatchRequest struct {
+	Module               string        `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	State                *TriggerState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ModuleWatchRequest) Reset()         { *m = ModuleWatchRequest{} }
+func (m *ModuleWatchRequest) String() string { return proto.CompactTextString(m) }
+func (*ModuleWatchRequest) ProtoMessage()    {}
+func (*ModuleWatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{35}
+}
+
+func (m *ModuleWatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModuleWatchRequest.Unmarshal(m, b)
+}
+func (m *ModuleWatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModuleWatchRequest.Marshal(b, m, deterministic)
+}
+func (m *ModuleWatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleWatchRequest.Merge(m, src)
+}
+func (m *ModuleWatchRequest) XXX_Size() int {
+	return xxx_messageInfo_ModuleWatchRequest.Size(m)
+}
+func (m *ModuleWatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleWatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleWatchRequest proto.InternalMessageInfo
+
+func (m *ModuleWatchRequest) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *ModuleWatchRequest) GetState() *TriggerState {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+type DeployerRequest struct {
+	Deployer             string   `protobuf:"bytes,1,opt,name=deployer,proto3" json:"deployer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeployerRequest) Reset()         { *m = DeployerRequest{} }
+func (m *DeployerRequest) String() string { return proto.CompactTextString(m) }
+func (*DeployerRequest) ProtoMessage()    {}
+func (*DeployerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{36}
+}
+
+func (m *DeployerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeployerRequest.Unmarshal(m, b)
+}
+func (m *DeployerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeployerRequest.Marshal(b, m, deterministic)
+}
+func (m *DeployerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeployerRequest.Merge(m, src)
+}
+func (m *DeployerRequest) XXX_Size() int {
+	return xxx_messageInfo_DeployerRequest.Size(m)
+}
+func (m *DeployerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeployerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeployerRequest proto.InternalMessageInfo
+
+func (m *DeployerRequest) GetDeployer() string {
+	if m != nil {
+		return m.Deployer
+	}
+	return ""
+}
+
 // Suggestion defines the action a user needs to recover from an error.
 type Suggestion struct {
 	SuggestionCode       SuggestionCode `protobuf:"varint,1,opt,name=suggestionCode,proto3,enum=proto.SuggestionCode" json:"suggestionCode,omitempty"`
@@ -3029,7 +3457,7 @@ func (m *Suggestion) Reset()         { *m = Suggestion{} }
 func (m *Suggestion) String() string { return proto.CompactTextString(m) }
 func (*Suggestion) ProtoMessage()    {}
 func (*Suggestion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{31}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{37}
 }
 
 func (m *Suggestion) XXX_Unmarshal(b []byte) error {
@@ -3078,7 +3506,7 @@ func (m *IntOrString) Reset()         { *m = IntOrString{} }
 func (m *IntOrString) String() string { return proto.CompactTextString(m) }
 func (*IntOrString) ProtoMessage()    {}
 func (*IntOrString) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{32}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{38}
 }
 
 func (m *IntOrString) XXX_Unmarshal(b []byte) error {
@@ -3133,6 +3561,8 @@ func init() {
 	proto.RegisterType((*Request)(nil), "proto.Request")
 	proto.RegisterType((*State)(nil), "proto.State")
 	proto.RegisterMapType((map[int32]*PortEvent)(nil), "proto.State.ForwardedPortsEntry")
+	proto.RegisterMapType((map[string]*ModuleState)(nil), "proto.State.ModulesEntry")
+	proto.RegisterType((*ModuleState)(nil), "proto.ModuleState")
 	proto.RegisterType((*Metadata)(nil), "proto.Metadata")
 	proto.RegisterMapType((map[string]string)(nil), "proto.Metadata.AdditionalEntry")
 	proto.RegisterType((*BuildMetadata)(nil), "proto.BuildMetadata")
@@ -3163,11 +3593,16 @@ func init() {
 	proto.RegisterType((*FileSyncEvent)(nil), "proto.FileSyncEvent")
 	proto.RegisterType((*DebuggingContainerEvent)(nil), "proto.DebuggingContainerEvent")
 	proto.RegisterMapType((map[string]uint32)(nil), "proto.DebuggingContainerEvent.DebugPortsEntry")
+	proto.RegisterType((*KubernetesEvent)(nil), "proto.KubernetesEvent")
+	proto.RegisterType((*ApplicationLogEvent)(nil), "proto.ApplicationLogEvent")
 	proto.RegisterType((*LogEntry)(nil), "proto.LogEntry")
 	proto.RegisterType((*UserIntentRequest)(nil), "proto.UserIntentRequest")
 	proto.RegisterType((*TriggerRequest)(nil), "proto.TriggerRequest")
 	proto.RegisterType((*TriggerState)(nil), "proto.TriggerState")
 	proto.RegisterType((*Intent)(nil), "proto.Intent")
+	proto.RegisterType((*ArtifactRequest)(nil), "proto.ArtifactRequest")
+	proto.RegisterType((*ModuleWatchRequest)(nil), "proto.ModuleWatchRequest")
+	proto.RegisterType((*DeployerRequest)(nil), "proto.DeployerRequest")
 	proto.RegisterType((*Suggestion)(nil), "proto.Suggestion")
 	proto.RegisterType((*IntOrString)(nil), "proto.IntOrString")
 }
@@ -3175,267 +3610,296 @@ func init() {
 func init() { proto.RegisterFile("skaffold.proto", fileDescriptor_4f2d38e344f9dbf5) }
 
 var fileDescriptor_4f2d38e344f9dbf5 = []byte{
-	// 4155 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x7a, 0x69, 0x8c, 0x1c, 0x49,
-	0x56, 0xbf, 0xeb, 0xae, 0x7a, 0x7d, 0x38, 0x3b, 0xec, 0xb6, 0x6b, 0xca, 0x57, 0xbb, 0xc6, 0xf6,
-	0xce, 0xf4, 0xcc, 0xbf, 0xed, 0xb1, 0x57, 0x7f, 0x2d, 0x66, 0x06, 0x94, 0x9d, 0x19, 0x55, 0x95,
-	0xee, 0xac, 0xcc, 0x22, 0x32, 0xab, 0x3d, 0x6d, 0x09, 0xa5, 0xca, 0xdd, 0xe9, 0x9a, 0x5a, 0x77,
-	0x57, 0xf5, 0x54, 0x55, 0x7b, 0xb6, 0x17, 0x84, 0x10, 0xe2, 0x1e, 0x40, 0xc0, 0x2e, 0x37, 0x1f,
-	0x96, 0x4b, 0xfb, 0x85, 0xeb, 0x2b, 0x42, 0xb0, 0x20, 0x84, 0x38, 0x77, 0xf7, 0x03, 0x02, 0xb1,
-	0x08, 0x09, 0x21, 0x2d, 0x1f, 0x96, 0x5b, 0x30, 0x33, 0x7b, 0x2f, 0x28, 0xae, 0xcc, 0xc8, 0x3a,
-	0x7c, 0x2c, 0x42, 0x7c, 0x72, 0xe5, 0x7b, 0xbf, 0x78, 0x57, 0xbc, 0x78, 0xef, 0x45, 0xb8, 0x61,
-	0x79, 0xf4, 0xb0, 0xf3, 0xe0, 0xc1, 0x60, 0x7f, 0x6f, 0xe3, 0x70, 0x38, 0x18, 0x0f, 0x50, 0x8e,
-	0xfd, 0x53, 0x39, 0xdf, 0x1d, 0x0c, 0xba, 0xfb, 0xe1, 0xf5, 0xce, 0x61, 0xef, 0x7a, 0xa7, 0xdf,
-	0x1f, 0x8c, 0x3b, 0xe3, 0xde, 0xa0, 0x3f, 0xe2, 0xa0, 0xca, 0x25, 0xc1, 0x65, 0x5f, 0xf7, 0x8f,
-	0x1e, 0x5c, 0x1f, 0xf7, 0x0e, 0xc2, 0xd1, 0xb8, 0x73, 0x70, 0x28, 0x00, 0xe7, 0x26, 0x01, 0xe1,
-	0xc1, 0xe1, 0xf8, 0x98, 0x33, 0xab, 0xb7, 0x60, 0xc9, 0x1b, 0x77, 0xc6, 0x21, 0x09, 0x47, 0x87,
-	0x83, 0xfe, 0x28, 0x44, 0x55, 0xc8, 0x8d, 0x28, 0xa1, 0x9c, 0x5a, 0x4b, 0xbd, 0xb0, 0x70, 0x73,
-	0x91, 0xe3, 0x36, 0x38, 0x88, 0xb3, 0xaa, 0xe7, 0xa1, 0x18, 0xe1, 0x35, 0xc8, 0x1c, 0x8c, 0xba,
-	0x0c, 0x5d, 0x22, 0xf4, 0x67, 0xf5, 0x02, 0x14, 0x48, 0xf8, 0xe6, 0x51, 0x38, 0x1a, 0x23, 0x04,
-	0xd9, 0x7e, 0xe7, 0x20, 0x14, 0x5c, 0xf6, 0xbb, 0xfa, 0x87, 0x59, 0xc8, 0x31, 0x69, 0xe8, 0x15,
-	0x80, 0xfb, 0x47, 0xbd, 0xfd, 0x3d, 0x4f, 0xd1, 0xb7, 0x22, 0xf4, 0x6d, 0x46, 0x0c, 0xa2, 0x80,
-	0xd0, 0xfb, 0x61, 0x61, 0x2f, 0x3c, 0xdc, 0x1f, 0x1c, 0xf3, 0x35, 0x69, 0xb6, 0x06, 0x89, 0x35,
-	0x66, 0xcc, 0x21, 0x2a, 0x0c, 0x35, 0x60, 0xf9, 0xc1, 0x60, 0xf8, 0x56, 0x67, 0xb8, 0x17, 0xee,
-	0xb5, 0x06, 0xc3, 0xf1, 0xa8, 0x9c, 0x5d, 0xcb, 0xbc, 0xb0, 0x70, 0x73, 0x4d, 0x75, 0x6e, 0xa3,
-	0x96, 0x80, 0xe0, 0xfe, 0x78, 0x78, 0x4c, 0x26, 0xd6, 0x21, 0x03, 0x34, 0x1a, 0x82, 0xa3, 0x91,
-	0xf1, 0x46, 0xb8, 0xfb, 0x90, 0x1b, 0x91, 0x63, 0x46, 0x9c, 0x55, 0x64, 0xa9, 0x6c, 0x32, 0xb5,
-	0x00, 0xdd, 0x86, 0xa5, 0x07, 0xbd, 0xfd, 0xd0, 0x3b, 0xee, 0xef, 0x72, 0x09, 0x79, 0x26, 0xe1,
-	0xb4, 0x90, 0x50, 0x53, 0x79, 0x24, 0x09, 0x45, 0x2d, 0x38, 0xb5, 0x17, 0xde, 0x3f, 0xea, 0x76,
-	0x7b, 0xfd, 0xae, 0x31, 0xe8, 0x8f, 0x3b, 0xbd, 0x7e, 0x38, 0x1c, 0x95, 0x0b, 0xcc, 0x9f, 0x8b,
-	0x51, 0x20, 0x26, 0x11, 0xf8, 0x51, 0xd8, 0x1f, 0x93, 0x59, 0x4b, 0xd1, 0x4b, 0x50, 0x3c, 0x08,
-	0xc7, 0x9d, 0xbd, 0xce, 0xb8, 0x53, 0x2e, 0x32, 0x43, 0x4e, 0x0a, 0x31, 0x4d, 0x41, 0x26, 0x11,
-	0x00, 0x6d, 0x40, 0x69, 0x1c, 0x8e, 0xc6, 0xdc, 0xec, 0x12, 0x43, 0x6b, 0x02, 0xed, 0x4b, 0x3a,
-	0x89, 0x21, 0x15, 0x0f, 0x4e, 0xcd, 0x08, 0x2b, 0x4d, 0x9a, 0x87, 0xe1, 0x31, 0xdb, 0xf2, 0x1c,
-	0xa1, 0x3f, 0xd1, 0x35, 0xc8, 0x3d, 0xea, 0xec, 0x1f, 0xc9, 0x2d, 0x95, 0x42, 0xe9, 0x1a, 0x6e,
-	0x3b, 0x67, 0xdf, 0x4e, 0x7f, 0x20, 0x75, 0x27, 0x5b, 0xcc, 0x68, 0xd9, 0xea, 0x8f, 0xa4, 0xa1,
-	0x28, 0x2d, 0x44, 0xeb, 0x90, 0x63, 0x59, 0x22, 0xb2, 0xe8, 0xb4, 0x9a, 0x45, 0x91, 0x1b, 0x1c,
-	0x82, 0xfe, 0x1f, 0xe4, 0x79, 0x72, 0x08, 0x5d, 0xab, 0x89, 0xf4, 0x89, 0xd0, 0x02, 0x84, 0xde,
-	0x07, 0x59, 0xea, 0x4f, 0x39, 0xc3, 0xc0, 0xa7, 0x14, 0x6f, 0x23, 0x28, 0x03, 0xa0, 0x6f, 0x06,
-	0xe8, 0xec, 0xed, 0xf5, 0xe8, 0xd9, 0xec, 0xec, 0x97, 0x77, 0xd9, 0x8e, 0x5c, 0x9a, 0x08, 0xe5,
-	0x86, 0x1e, 0x21, 0x78, 0x82, 0x29, 0x4b, 0x2a, 0xaf, 0xc1, 0xc9, 0x09, 0xb6, 0x1a, 0xa8, 0x12,
-	0x0f, 0xd4, 0x69, 0x35, 0x50, 0x25, 0x25, 0x2c, 0xd5, 0x77, 0xd3, 0xb0, 0x94, 0x70, 0x18, 0xbd,
-	0x0c, 0x2b, 0xfd, 0xa3, 0x83, 0xfb, 0xe1, 0xd0, 0x7d, 0xa0, 0x0f, 0xc7, 0xbd, 0x07, 0x9d, 0xdd,
-	0xf1, 0x48, 0x04, 0x7d, 0x9a, 0x81, 0x5e, 0x83, 0x22, 0x0b, 0x10, 0xcd, 0xa7, 0x34, 0xb3, 0xfe,
-	0xf2, 0xac, 0x30, 0x6e, 0x58, 0x07, 0x9d, 0x6e, 0xb8, 0xc9, 0x91, 0x24, 0x5a, 0x82, 0xae, 0x40,
-	0x76, 0x7c, 0x7c, 0x18, 0xb2, 0x38, 0x2d, 0x47, 0x1b, 0xc8, 0x70, 0xfe, 0xf1, 0x61, 0x48, 0x18,
-	0x17, 0x99, 0x33, 0x82, 0x74, 0x65, 0xa6, 0x9a, 0xc7, 0x45, 0xca, 0x86, 0x45, 0xd5, 0x0a, 0x74,
-	0x4d, 0xe8, 0x4e, 0x31, 0xdd, 0x48, 0x95, 0x17, 0x0e, 0x15, 0xed, 0xa7, 0x21, 0xb7, 0x3b, 0x38,
-	0xea, 0x8f, 0x59, 0xf0, 0x72, 0x84, 0x7f, 0xfc, 0x4f, 0xe3, 0xfe, 0x76, 0x0a, 0x16, 0xd5, 0x74,
-	0x40, 0xef, 0x87, 0x02, 0xfd, 0xa6, 0x71, 0x4c, 0x31, 0x07, 0x2b, 0x33, 0x92, 0x66, 0x83, 0x43,
-	0x88, 0x84, 0x56, 0x30, 0xe4, 0xf9, 0x4f, 0x74, 0x35, 0xe1, 0xcd, 0x8a, 0xb2, 0xf8, 0xc9, 0xce,
-	0x54, 0xff, 0x28, 0x05, 0xcb, 0xc9, 0x4c, 0x46, 0xaf, 0x42, 0x89, 0xe7, 0x72, 0x6c, 0xd1, 0xc5,
-	0x99, 0x39, 0x2f, 0x3e, 0xc3, 0x21, 0x89, 0x17, 0xa0, 0x97, 0xa1, 0xb0, 0xbb, 0x7f, 0x44, 0x75,
-	0x33, 0x45, 0x71, 0x78, 0x0d, 0x4e, 0x65, 0x16, 0x49, 0x48, 0xc5, 0x82, 0xa2, 0x14, 0xc2, 0x4e,
-	0x4e, 0xec, 0xc7, 0xa9, 0x84, 0xca, 0xa7, 0xf0, 0xe4, 0xef, 0x52, 0x00, 0x71, 0x1b, 0x40, 0xdf,
-	0x04, 0xa5, 0x8e, 0x92, 0xc4, 0x6a, 0xfd, 0x8e, 0x51, 0x1b, 0x51, 0x3a, 0xf3, 0xa4, 0x89, 0x97,
-	0xa0, 0x35, 0x58, 0xe8, 0x1c, 0x8d, 0x07, 0xfe, 0xb0, 0xd7, 0xed, 0x0a, 0x5f, 0x8a, 0x44, 0x25,
-	0xd1, 0x7e, 0x24, 0x6a, 0xf5, 0x60, 0x4f, 0xe6, 0xf1, 0x4a, 0xb2, 0xac, 0x0f, 0xf6, 0x42, 0xa2,
-	0x80, 0x2a, 0xaf, 0xc2, 0x72, 0x52, 0xe3, 0x33, 0x65, 0xce, 0x36, 0x94, 0xa2, 0xaa, 0x89, 0xce,
-	0x40, 0x9e, 0x0b, 0x16, 0x6b, 0xc5, 0xd7, 0x84, 0x55, 0xe9, 0xa7, 0xb0, 0xaa, 0xfa, 0x61, 0x58,
-	0x50, 0x7a, 0xe1, 0x5c, 0xc9, 0xff, 0x1b, 0x11, 0xa9, 0xfe, 0x7d, 0x0a, 0xb4, 0xc9, 0x1e, 0x38,
-	0xd7, 0x02, 0x13, 0x4a, 0xc3, 0x70, 0x34, 0x38, 0x1a, 0xee, 0x86, 0xb2, 0xe6, 0x5c, 0x9b, 0xd3,
-	0x47, 0x37, 0x88, 0x04, 0x8a, 0x9d, 0x8d, 0x16, 0x7e, 0x9d, 0xfb, 0x96, 0x94, 0xf7, 0x4c, 0xfb,
-	0x66, 0xc1, 0x52, 0xa2, 0x49, 0x7f, 0xfd, 0x11, 0xae, 0xfe, 0x4d, 0x0e, 0x72, 0xac, 0xc1, 0xa1,
-	0x1b, 0x50, 0xa2, 0x6d, 0x96, 0x7d, 0x88, 0x36, 0xa6, 0x29, 0xdd, 0x83, 0xd1, 0x1b, 0x27, 0x48,
-	0x0c, 0x42, 0xb7, 0xc4, 0xfc, 0xc4, 0x97, 0xa4, 0xa7, 0xe7, 0x27, 0xb9, 0x46, 0x81, 0xa1, 0xff,
-	0x2f, 0x27, 0x28, 0xbe, 0x2a, 0x33, 0x63, 0x82, 0x92, 0xcb, 0x54, 0x20, 0x35, 0xef, 0x50, 0x36,
-	0xe3, 0x72, 0x76, 0x76, 0x93, 0xa6, 0xe6, 0x45, 0x20, 0x84, 0x13, 0xb3, 0x12, 0x5f, 0x38, 0x77,
-	0x56, 0x92, 0xeb, 0xa7, 0x96, 0xa0, 0x6f, 0x85, 0xb2, 0xdc, 0xea, 0x49, 0xbc, 0x18, 0x9c, 0x64,
-	0x93, 0x25, 0x73, 0x60, 0x8d, 0x13, 0x64, 0xae, 0x08, 0xf4, 0x6a, 0x3c, 0x8c, 0x71, 0x99, 0x85,
-	0x99, 0xc3, 0x98, 0x14, 0x94, 0x04, 0xa3, 0x7b, 0x70, 0x76, 0x6f, 0xf6, 0xb0, 0x25, 0x66, 0xa9,
-	0x27, 0x8c, 0x64, 0x8d, 0x13, 0x64, 0x9e, 0x00, 0xf4, 0x0d, 0xb0, 0xb8, 0x17, 0x3e, 0xb2, 0x07,
-	0x83, 0x43, 0x2e, 0xb0, 0x94, 0x18, 0x40, 0x4c, 0x85, 0xd5, 0x38, 0x41, 0x12, 0x50, 0x1a, 0xfa,
-	0x71, 0x38, 0x3c, 0xe8, 0xf5, 0xd9, 0x4d, 0x81, 0x2f, 0x87, 0x44, 0xe8, 0xfd, 0x09, 0x36, 0x0d,
-	0xfd, 0xe4, 0x12, 0xba, 0xe7, 0xb4, 0x3e, 0xf1, 0xf5, 0x0b, 0x53, 0xd3, 0x5e, 0xb4, 0xe7, 0xd1,
-	0xc7, 0xe6, 0x22, 0x40, 0x48, 0x7f, 0x04, 0xb4, 0xae, 0x57, 0x09, 0x68, 0x93, 0x7a, 0xe6, 0x1e,
-	0x95, 0x6b, 0x90, 0x09, 0x87, 0x43, 0x91, 0xc5, 0x32, 0xfa, 0xfa, 0x2e, 0x6b, 0xca, 0xf7, 0xf7,
-	0x43, 0x3c, 0x1c, 0x12, 0x0a, 0xa8, 0xee, 0xc3, 0xa2, 0xea, 0x3a, 0x3a, 0x0f, 0xa5, 0xde, 0x38,
-	0x1c, 0x32, 0x0d, 0x62, 0xb6, 0x89, 0x09, 0x8a, 0xb6, 0xf4, 0x2c, 0x6d, 0x99, 0x27, 0x69, 0x7b,
-	0x3b, 0x05, 0x4b, 0x09, 0x32, 0x7a, 0x09, 0x0a, 0xe1, 0x70, 0xc8, 0x2a, 0x4d, 0x6a, 0x5e, 0xa5,
-	0x91, 0x08, 0x54, 0x86, 0xc2, 0x41, 0x38, 0x1a, 0x75, 0xba, 0xb2, 0x88, 0xc8, 0x4f, 0x74, 0x0b,
-	0x16, 0x46, 0x47, 0xdd, 0x6e, 0x38, 0x62, 0x57, 0xb9, 0x72, 0x86, 0xd5, 0xbe, 0x48, 0x54, 0xc4,
-	0x21, 0x2a, 0xaa, 0xea, 0x40, 0x29, 0x2a, 0x05, 0xb4, 0x3c, 0x85, 0xb4, 0x72, 0x89, 0x38, 0xf2,
-	0x8f, 0xc4, 0x34, 0x9f, 0x7e, 0xc2, 0x34, 0x5f, 0xfd, 0x6d, 0xd9, 0x61, 0xb9, 0xc4, 0x0a, 0x14,
-	0x65, 0xbb, 0x14, 0x42, 0xa3, 0xef, 0xb9, 0x81, 0xd4, 0xe2, 0x40, 0x96, 0x58, 0xc8, 0xd4, 0x00,
-	0x65, 0x9f, 0x18, 0xa0, 0xdb, 0xb0, 0xd4, 0x51, 0xc3, 0x2b, 0x0a, 0xc4, 0xec, 0x1d, 0x49, 0x42,
-	0xab, 0x81, 0x92, 0x9d, 0x73, 0xd3, 0x6a, 0x4a, 0x41, 0xfa, 0xe9, 0x15, 0x7c, 0x2c, 0x25, 0xfb,
-	0xe8, 0xe3, 0x75, 0x68, 0x71, 0xea, 0x4e, 0xc7, 0x20, 0xf3, 0xec, 0x31, 0xc8, 0x3e, 0xbd, 0x89,
-	0x9f, 0x48, 0x76, 0xdb, 0xc7, 0xdb, 0x39, 0x3f, 0x1b, 0xff, 0x0f, 0x77, 0xf1, 0x73, 0x29, 0x28,
-	0xcf, 0x2b, 0xdc, 0x34, 0x23, 0x65, 0xe1, 0x96, 0x19, 0x29, 0xbf, 0xe7, 0x66, 0xa4, 0xe2, 0x65,
-	0x66, 0xa6, 0x97, 0xd9, 0xd8, 0xcb, 0xe4, 0xe4, 0x90, 0x7b, 0x8a, 0xc9, 0x61, 0xda, 0xd7, 0xfc,
-	0xd3, 0xfb, 0xfa, 0x99, 0x34, 0x94, 0xa2, 0x66, 0x49, 0x2b, 0xd7, 0xfe, 0x60, 0xb7, 0xb3, 0x4f,
-	0x29, 0xb2, 0x72, 0x45, 0x04, 0x74, 0x11, 0x60, 0x18, 0x1e, 0x0c, 0xc6, 0x21, 0x63, 0xf3, 0xc1,
-	0x58, 0xa1, 0x50, 0x37, 0x0f, 0x07, 0x7b, 0x4e, 0xe7, 0x20, 0x72, 0x53, 0x7c, 0xa2, 0x2b, 0xb0,
-	0xb4, 0x2b, 0x3b, 0x09, 0xe3, 0x73, 0x87, 0x93, 0x44, 0xaa, 0xbd, 0xdf, 0x39, 0x08, 0x47, 0x87,
-	0x9d, 0x5d, 0xee, 0x79, 0x89, 0xc4, 0x04, 0x1a, 0x78, 0xda, 0xc8, 0xd9, 0xf2, 0x3c, 0x0f, 0xbc,
-	0xfc, 0x46, 0x55, 0x58, 0x94, 0x9b, 0x40, 0x67, 0x78, 0xd6, 0x30, 0x4b, 0x24, 0x41, 0x53, 0x31,
-	0x4c, 0x46, 0x31, 0x89, 0x61, 0x72, 0xca, 0x50, 0xe8, 0xec, 0xed, 0x0d, 0xc3, 0xd1, 0x88, 0xb5,
-	0xb6, 0x12, 0x91, 0x9f, 0xe8, 0x26, 0xc0, 0xb8, 0x33, 0xec, 0x86, 0x63, 0xe6, 0x3b, 0x24, 0x46,
-	0x14, 0xab, 0x3f, 0x76, 0x87, 0xde, 0x78, 0xd8, 0xeb, 0x77, 0x89, 0x82, 0xaa, 0xfe, 0x55, 0x2a,
-	0x1e, 0xca, 0xa2, 0xf8, 0xd2, 0x66, 0x6d, 0xb0, 0x9b, 0x85, 0x88, 0x6f, 0x44, 0xa0, 0xe5, 0xb3,
-	0x77, 0x10, 0x1f, 0x05, 0xfe, 0xa1, 0x24, 0x55, 0x66, 0xd6, 0x11, 0xcf, 0xce, 0x3c, 0x20, 0xb9,
-	0x67, 0x3f, 0x20, 0xcf, 0x90, 0x34, 0xef, 0xa4, 0xe1, 0xec, 0x9c, 0xe9, 0xe1, 0x71, 0x27, 0x5d,
-	0x26, 0x47, 0xfa, 0x09, 0xc9, 0x91, 0x79, 0x62, 0x72, 0x64, 0x67, 0x24, 0x47, 0xd4, 0x27, 0x72,
-	0x13, 0x7d, 0xa2, 0x0c, 0x85, 0xe1, 0x51, 0x7f, 0xdc, 0x8b, 0xf2, 0x46, 0x7e, 0xd2, 0x84, 0x7e,
-	0x6b, 0x30, 0x7c, 0xd8, 0xeb, 0x77, 0xcd, 0xde, 0x50, 0x24, 0x8d, 0x42, 0x41, 0x0e, 0x00, 0x9b,
-	0x84, 0xf8, 0x03, 0x5d, 0x91, 0x35, 0xc4, 0x8d, 0xc7, 0x4f, 0x4f, 0x9c, 0xae, 0x3c, 0xd7, 0x29,
-	0x12, 0xe8, 0xad, 0x7e, 0x82, 0xfd, 0xa4, 0x19, 0x7f, 0x49, 0x9d, 0xf1, 0xbf, 0x03, 0x8a, 0xf6,
-	0xa0, 0xcb, 0xd7, 0x7d, 0x00, 0x4a, 0xd1, 0xa3, 0xaa, 0x18, 0xcd, 0x2b, 0x1b, 0xfc, 0x55, 0x75,
-	0x43, 0xbe, 0xaa, 0x6e, 0xf8, 0x12, 0x41, 0x62, 0x30, 0xaa, 0x42, 0x2e, 0x54, 0xa6, 0x73, 0xf9,
-	0x9a, 0x2a, 0x9e, 0xb4, 0xc2, 0x64, 0x23, 0xcf, 0x28, 0x8d, 0xbc, 0x7a, 0x1b, 0x56, 0xda, 0xa3,
-	0x70, 0x68, 0xf5, 0xc7, 0x14, 0x2a, 0xde, 0x53, 0xaf, 0x42, 0xbe, 0xc7, 0x08, 0xc2, 0x8a, 0xa5,
-	0xf8, 0x50, 0x50, 0x94, 0x60, 0x56, 0xbf, 0x11, 0x96, 0xc5, 0xfd, 0x42, 0x2e, 0x7c, 0x31, 0xf9,
-	0xaa, 0x1b, 0xbd, 0x62, 0x71, 0x54, 0xe2, 0x71, 0xf7, 0x15, 0x58, 0x54, 0xc9, 0xa8, 0x02, 0x85,
-	0x90, 0x25, 0x23, 0x7f, 0x5c, 0x2b, 0x36, 0x4e, 0x10, 0x49, 0xd8, 0xcc, 0x41, 0xe6, 0x51, 0x67,
-	0xbf, 0x7a, 0x07, 0xf2, 0xdc, 0x02, 0xea, 0x4b, 0xfc, 0x0e, 0x57, 0x94, 0x2f, 0x6e, 0x08, 0xb2,
-	0xa3, 0xe3, 0xfe, 0xae, 0xb8, 0xff, 0xb0, 0xdf, 0x34, 0x75, 0xc5, 0x2b, 0x5c, 0x86, 0x51, 0xc5,
-	0x57, 0x75, 0x17, 0x20, 0x1e, 0x7f, 0xd0, 0x6b, 0xb0, 0x1c, 0x0f, 0x40, 0xca, 0xd0, 0xb5, 0x3a,
-	0x35, 0x29, 0xb1, 0x03, 0x37, 0x01, 0xa6, 0x4a, 0xf8, 0x61, 0x92, 0x3d, 0x82, 0x7f, 0x55, 0xbf,
-	0x05, 0x16, 0x94, 0x3a, 0x42, 0xed, 0x8b, 0x1e, 0x2a, 0x72, 0xe2, 0x4d, 0xe2, 0x0c, 0x0b, 0xf5,
-	0x76, 0x67, 0x5f, 0xd4, 0x5e, 0xf1, 0xc5, 0x8f, 0xdc, 0x90, 0xd2, 0xa3, 0x0a, 0x41, 0xbf, 0xd6,
-	0x07, 0xb0, 0xa0, 0xbc, 0x37, 0xa1, 0x32, 0x9c, 0x6e, 0x3b, 0x5b, 0x8e, 0x7b, 0xd7, 0x09, 0x36,
-	0xdb, 0x96, 0x6d, 0x62, 0x12, 0xf8, 0x3b, 0x2d, 0xac, 0x9d, 0x40, 0x05, 0xc8, 0xdc, 0xb1, 0x36,
-	0xb5, 0x14, 0x2a, 0x41, 0x6e, 0x53, 0xbf, 0x87, 0x6d, 0x2d, 0x8d, 0x96, 0x01, 0x18, 0xaa, 0xa5,
-	0x1b, 0x5b, 0x9e, 0x96, 0x41, 0x00, 0x79, 0xa3, 0xed, 0xf9, 0x6e, 0x53, 0xcb, 0xd2, 0xdf, 0x5b,
-	0xba, 0x63, 0x6d, 0xb9, 0x5a, 0x8e, 0xfe, 0x36, 0x5d, 0x63, 0x0b, 0x13, 0x2d, 0xbf, 0x6e, 0x42,
-	0x29, 0x7a, 0x5c, 0x43, 0x67, 0x00, 0x25, 0xd4, 0x49, 0x65, 0x0b, 0x50, 0x30, 0xec, 0xb6, 0xe7,
-	0x63, 0xa2, 0xa5, 0xa8, 0xe6, 0xba, 0xb1, 0xa9, 0xa5, 0xa9, 0x66, 0xdb, 0x35, 0x74, 0x5b, 0xcb,
-	0xac, 0x6f, 0x01, 0xc4, 0x0f, 0x4b, 0x68, 0x15, 0x56, 0xa4, 0x18, 0x1f, 0x7b, 0xbe, 0x94, 0x52,
-	0x84, 0x6c, 0xdb, 0xb1, 0x7c, 0x2d, 0x85, 0xce, 0x43, 0xd9, 0x70, 0x1d, 0x5f, 0xb7, 0x1c, 0x4c,
-	0x02, 0xcf, 0x27, 0x6d, 0xc3, 0x6f, 0x13, 0xcc, 0xc0, 0x5a, 0x7a, 0xdd, 0xa5, 0xb3, 0x79, 0xfc,
-	0xba, 0x83, 0x9e, 0x83, 0x55, 0x29, 0xce, 0xc4, 0x2d, 0xdb, 0xdd, 0x89, 0xa3, 0x50, 0x84, 0x6c,
-	0x03, 0xdb, 0x4d, 0x2d, 0x85, 0x96, 0xa0, 0xb4, 0xc5, 0x7c, 0xb5, 0xee, 0x61, 0x2d, 0x4d, 0x2d,
-	0xde, 0x6a, 0x6f, 0x62, 0xc3, 0xa7, 0xd6, 0x59, 0xb0, 0xa0, 0xbc, 0x32, 0xa9, 0x41, 0x15, 0x5e,
-	0x49, 0x71, 0x8b, 0x50, 0x6c, 0x5a, 0x8e, 0x45, 0x57, 0x0a, 0x47, 0xb7, 0x30, 0x77, 0xd4, 0xf5,
-	0x1b, 0x98, 0x68, 0x99, 0xf5, 0x8f, 0x9e, 0x03, 0x88, 0x4b, 0x33, 0xca, 0x43, 0xda, 0xdd, 0xd2,
-	0x4e, 0xa0, 0x32, 0x9c, 0xf2, 0x7c, 0xdd, 0x6f, 0x7b, 0x46, 0x03, 0x1b, 0x5b, 0x81, 0xd7, 0x36,
-	0x0c, 0xec, 0x79, 0xda, 0x1f, 0xa7, 0x10, 0x82, 0x25, 0x1e, 0x4a, 0x49, 0xfb, 0x93, 0x14, 0x3a,
-	0x05, 0xcb, 0xdc, 0x91, 0x88, 0xf8, 0xa7, 0x29, 0xb4, 0x02, 0x8b, 0x2c, 0x58, 0x92, 0xf4, 0x67,
-	0x2c, 0x4c, 0x7c, 0x6d, 0xab, 0xed, 0x35, 0x02, 0x9d, 0xd1, 0x03, 0x13, 0x3b, 0x16, 0x36, 0xb5,
-	0x10, 0x9d, 0x83, 0xb3, 0x82, 0x4b, 0xdc, 0x3b, 0xd8, 0xf0, 0x03, 0xc7, 0xf5, 0x83, 0x9a, 0xdb,
-	0x76, 0x4c, 0xed, 0x01, 0x7a, 0x1e, 0x2e, 0x71, 0x26, 0xdf, 0xe8, 0xc0, 0xd4, 0x71, 0xd3, 0x75,
-	0x18, 0x84, 0xb4, 0x1d, 0xc7, 0x72, 0xea, 0x5a, 0x17, 0x9d, 0x06, 0x8d, 0x83, 0xda, 0x1e, 0x26,
-	0x01, 0x26, 0xc4, 0x25, 0xda, 0x1b, 0xb1, 0x56, 0xb1, 0xb4, 0xed, 0xe8, 0xdb, 0xba, 0x65, 0xeb,
-	0x9b, 0x36, 0xd6, 0x7a, 0xe8, 0x02, 0x3c, 0x37, 0xc9, 0x6d, 0xfb, 0x0d, 0x97, 0x58, 0xf7, 0xb0,
-	0xa9, 0x7d, 0x30, 0x36, 0x4a, 0xb0, 0xbd, 0x1d, 0xcf, 0xc7, 0x4d, 0x2a, 0x5b, 0x7b, 0x88, 0x2e,
-	0xc3, 0x85, 0x04, 0x93, 0x5a, 0xd3, 0x74, 0x4d, 0xab, 0x66, 0x61, 0x93, 0x41, 0xf6, 0xd1, 0x15,
-	0x58, 0x9b, 0x82, 0x58, 0xcd, 0x96, 0x8d, 0x9b, 0xd8, 0xf1, 0x05, 0xea, 0x00, 0x5d, 0x84, 0xca,
-	0x84, 0x77, 0xbe, 0x1e, 0xd8, 0xae, 0xe7, 0x31, 0x7e, 0x7f, 0x8a, 0x5f, 0x73, 0xc9, 0xa6, 0x65,
-	0x9a, 0xd8, 0x61, 0xfc, 0xc1, 0x94, 0x13, 0x86, 0xeb, 0xd4, 0x6c, 0xcb, 0xf0, 0x19, 0xfb, 0x10,
-	0xad, 0xc1, 0xf9, 0x04, 0x9b, 0x45, 0x46, 0x09, 0xef, 0x9b, 0xa8, 0x0a, 0x17, 0x13, 0x08, 0xcb,
-	0xd9, 0xd6, 0x6d, 0xcb, 0x0c, 0x5a, 0x3a, 0xd1, 0xb9, 0xb7, 0xc3, 0x49, 0x23, 0x6a, 0x96, 0x8d,
-	0x15, 0x19, 0xa3, 0x29, 0x57, 0x0d, 0xdd, 0x68, 0xe0, 0xa0, 0x46, 0xdc, 0x66, 0xd0, 0x6a, 0xdb,
-	0x36, 0x93, 0x32, 0x46, 0x97, 0xe0, 0x5c, 0x02, 0x55, 0xc7, 0x7e, 0x60, 0x5a, 0x75, 0x9a, 0x29,
-	0x14, 0x70, 0x14, 0x07, 0x95, 0xe0, 0xba, 0xe5, 0xf9, 0x64, 0x67, 0x12, 0xf2, 0x28, 0x86, 0xc8,
-	0xb4, 0xbf, 0x63, 0x6d, 0x06, 0x2d, 0xbb, 0x5d, 0xb7, 0x1c, 0x9e, 0xf9, 0x6f, 0xc5, 0x9b, 0x4e,
-	0x59, 0x75, 0xa2, 0x9b, 0x36, 0xa6, 0x87, 0x8d, 0x09, 0xf8, 0x50, 0xbc, 0xab, 0x94, 0xdb, 0xd4,
-	0xb7, 0xb1, 0x13, 0x31, 0x8f, 0xd1, 0x3a, 0x5c, 0xb3, 0x1c, 0xcb, 0x8f, 0x76, 0x0c, 0xfb, 0x77,
-	0x5d, 0xb2, 0x15, 0xd8, 0x96, 0xe7, 0x5b, 0x4e, 0x3d, 0x88, 0x0e, 0xba, 0xa7, 0x7d, 0x18, 0x6d,
-	0xc0, 0xfa, 0x2c, 0xac, 0x0c, 0x5f, 0x5c, 0x14, 0x1c, 0xbd, 0x89, 0xb5, 0x6f, 0x43, 0x37, 0xe0,
-	0xe5, 0x59, 0xf8, 0x18, 0x67, 0xba, 0xd8, 0x63, 0x51, 0xc5, 0xaf, 0x5b, 0x9e, 0xaf, 0x7d, 0x3b,
-	0xba, 0x04, 0x15, 0xf5, 0x24, 0x5a, 0x4d, 0xbd, 0x8e, 0xe3, 0x78, 0xfe, 0x6a, 0x1a, 0x3d, 0x0f,
-	0x17, 0x55, 0x40, 0x2c, 0xca, 0x20, 0x58, 0xa7, 0x16, 0x6b, 0xbf, 0x96, 0x46, 0x55, 0xb8, 0xa0,
-	0x82, 0x48, 0xdb, 0x51, 0x80, 0x54, 0xd0, 0xaf, 0xa7, 0xd1, 0x55, 0x58, 0x9b, 0x2d, 0xc8, 0xc7,
-	0xa4, 0x69, 0x39, 0xba, 0x8f, 0x4d, 0xed, 0x37, 0xd2, 0xe8, 0x25, 0xb8, 0xa6, 0xc2, 0xf8, 0xc1,
-	0xa7, 0xd9, 0x1c, 0x10, 0xd7, 0xb6, 0xdd, 0xb6, 0x1f, 0xb4, 0xb0, 0x63, 0x52, 0xbd, 0xbf, 0xf9,
-	0x18, 0x99, 0x04, 0x7b, 0xbe, 0x4e, 0x98, 0x79, 0x9f, 0x4d, 0xa3, 0x0a, 0xac, 0xaa, 0xb0, 0xb6,
-	0xd3, 0xc0, 0xba, 0xed, 0x37, 0x76, 0xb4, 0x7f, 0x98, 0x12, 0xe1, 0xb8, 0x26, 0x0e, 0x9a, 0xb8,
-	0xe9, 0x92, 0x9d, 0xa0, 0x45, 0xb0, 0xe7, 0xb5, 0x09, 0xd6, 0x7e, 0x34, 0x33, 0x19, 0x06, 0x06,
-	0x33, 0x2d, 0x6f, 0x2b, 0x06, 0xfd, 0x58, 0x06, 0xbd, 0x08, 0x57, 0xa6, 0x40, 0x72, 0x0f, 0xd4,
-	0xb2, 0xf0, 0xe3, 0x99, 0xc9, 0x88, 0x31, 0x68, 0x8b, 0x9e, 0x08, 0x29, 0xee, 0x23, 0xb3, 0x75,
-	0xb6, 0x1d, 0xfa, 0x65, 0xb6, 0xb9, 0xa0, 0x8f, 0x66, 0xd0, 0x65, 0x38, 0x3f, 0x03, 0x44, 0xb0,
-	0x6e, 0x34, 0x18, 0xe4, 0x27, 0x32, 0x93, 0x7b, 0xcc, 0xcd, 0xa2, 0x95, 0x0d, 0xeb, 0xe6, 0x8e,
-	0xf6, 0x93, 0x53, 0xc6, 0xd4, 0x74, 0xcb, 0xc6, 0x66, 0x20, 0x14, 0xd1, 0x18, 0xfe, 0x54, 0x06,
-	0xbd, 0x0f, 0xaa, 0x2a, 0x46, 0x74, 0x0b, 0x1a, 0x72, 0x07, 0x1b, 0xbe, 0xe5, 0xf2, 0x5a, 0xf1,
-	0x33, 0x53, 0x56, 0x4b, 0x20, 0x75, 0x6e, 0xcb, 0xb2, 0x6d, 0x6c, 0x6a, 0x3f, 0x3b, 0x15, 0xa9,
-	0x48, 0x9a, 0x6d, 0xd1, 0x9d, 0xae, 0x61, 0xdf, 0x68, 0x30, 0x79, 0x3f, 0x97, 0x99, 0xdc, 0x20,
-	0x25, 0x21, 0x62, 0xd8, 0xcf, 0x4f, 0xc5, 0xa1, 0xe5, 0x9a, 0x01, 0x3d, 0x0a, 0x96, 0x6e, 0x5b,
-	0xf7, 0xa8, 0x0b, 0x7f, 0x90, 0xa1, 0xbd, 0x45, 0x9e, 0x68, 0x5e, 0xbc, 0xdf, 0xc9, 0x4c, 0x76,
-	0x22, 0xc1, 0xd7, 0xde, 0xcd, 0xa0, 0x6b, 0x70, 0x79, 0x06, 0x67, 0x62, 0x03, 0xde, 0xcb, 0xa0,
-	0x75, 0xb8, 0x3a, 0x3b, 0x07, 0xef, 0xea, 0x16, 0x3b, 0xd1, 0x52, 0xe6, 0xe7, 0x33, 0xe8, 0x22,
-	0x3c, 0x37, 0x4b, 0x26, 0xde, 0xc6, 0x8e, 0xaf, 0x7d, 0x35, 0xa3, 0x74, 0x3a, 0xb9, 0xe8, 0x0b,
-	0x19, 0xda, 0xe9, 0xbc, 0x1d, 0xc7, 0x88, 0x48, 0x5f, 0xcc, 0xc4, 0x5d, 0x52, 0xd2, 0xbe, 0x94,
-	0x41, 0xa7, 0xe1, 0xa4, 0x89, 0xb7, 0xd9, 0xf1, 0x97, 0xd4, 0x2f, 0x33, 0xaa, 0x61, 0x63, 0xdd,
-	0x69, 0xb7, 0x22, 0xea, 0x57, 0x98, 0xc8, 0x04, 0xf0, 0x6b, 0x19, 0xf4, 0x1c, 0x9c, 0x9e, 0x68,
-	0x54, 0x9c, 0xf5, 0x5f, 0x99, 0xa8, 0xd5, 0x4a, 0xd2, 0x77, 0x66, 0xa9, 0x58, 0x66, 0x13, 0x93,
-	0xc2, 0x83, 0xf9, 0x99, 0x2c, 0x5a, 0x83, 0x73, 0xd2, 0x04, 0x5e, 0x5d, 0x31, 0x11, 0x83, 0x91,
-	0x89, 0x5b, 0x9e, 0xf6, 0x3b, 0x39, 0x9a, 0x8a, 0x53, 0x08, 0x26, 0x9b, 0x01, 0x7e, 0x37, 0x47,
-	0xb7, 0x71, 0x0a, 0x20, 0x42, 0xc2, 0x20, 0x9f, 0xc8, 0xcd, 0xd4, 0x42, 0x3b, 0x92, 0x55, 0xa7,
-	0x10, 0xed, 0xf7, 0x72, 0xe8, 0x0a, 0x5c, 0x8a, 0x43, 0xe1, 0xb5, 0x5b, 0x2d, 0x97, 0xd0, 0x66,
-	0xb8, 0xfd, 0x4a, 0xd0, 0xd4, 0x1d, 0xab, 0x46, 0xc7, 0xa6, 0xdf, 0xcf, 0x4d, 0x1e, 0x0b, 0xd6,
-	0xd4, 0x0d, 0xdd, 0x31, 0x30, 0x4b, 0xd2, 0x8f, 0xe5, 0x27, 0x8f, 0x85, 0x89, 0x75, 0xd3, 0xb6,
-	0x1c, 0x1c, 0xe0, 0xd7, 0x0d, 0x8c, 0x4d, 0x6c, 0x6a, 0xbf, 0x90, 0xa7, 0x81, 0xe0, 0x1e, 0xc6,
-	0x2b, 0x7f, 0x31, 0x8f, 0x56, 0x41, 0x13, 0x46, 0xc7, 0xe4, 0x5f, 0xca, 0xa3, 0x73, 0x70, 0x66,
-	0xa2, 0x85, 0x49, 0xe6, 0x2f, 0xe7, 0x69, 0x91, 0x4a, 0x36, 0x69, 0xa1, 0x4e, 0xfb, 0x95, 0x3c,
-	0xba, 0x00, 0x65, 0xe6, 0x0d, 0xab, 0xb9, 0x38, 0xf0, 0xf5, 0x7a, 0x3d, 0x9a, 0x40, 0xbe, 0xa7,
-	0x40, 0x3d, 0x61, 0x6c, 0x39, 0x8c, 0x05, 0x2d, 0xbd, 0xed, 0xf1, 0xee, 0xef, 0x12, 0xed, 0x7b,
-	0x0b, 0x34, 0x20, 0x49, 0x80, 0x32, 0xd8, 0x08, 0xd4, 0xf7, 0x15, 0x68, 0x76, 0xaa, 0x5a, 0xe4,
-	0x04, 0xcd, 0xf9, 0xdf, 0x1f, 0xab, 0x11, 0xfc, 0x68, 0xb8, 0xe4, 0x80, 0x1f, 0x98, 0x02, 0xc8,
-	0x8d, 0x15, 0x80, 0x1f, 0x2c, 0xd0, 0xb8, 0x70, 0x00, 0xeb, 0xdd, 0x9c, 0xfc, 0x76, 0x6c, 0x9e,
-	0x58, 0x77, 0x57, 0xa7, 0xe7, 0xda, 0x27, 0x96, 0xe2, 0xe5, 0x0f, 0x15, 0x68, 0x61, 0x51, 0x51,
-	0xb4, 0xbc, 0xd7, 0x74, 0x43, 0xd5, 0xf0, 0xc3, 0x05, 0xba, 0x67, 0x32, 0xf2, 0x62, 0x56, 0x9d,
-	0xa8, 0x50, 0x9f, 0x2b, 0xd0, 0x8a, 0x12, 0xa5, 0xd4, 0x66, 0xbb, 0x1e, 0x34, 0xb0, 0xdd, 0x62,
-	0x3d, 0xc3, 0x27, 0x16, 0xde, 0x66, 0x76, 0x69, 0xff, 0x58, 0x40, 0x67, 0x01, 0x45, 0xa2, 0xf8,
-	0x09, 0xa2, 0x8c, 0x7f, 0x2a, 0xd0, 0xdd, 0x10, 0x0c, 0x3a, 0x4c, 0x07, 0x7a, 0xab, 0x65, 0xef,
-	0x04, 0xb6, 0xbe, 0x89, 0x6d, 0x4f, 0xfb, 0xe7, 0x02, 0x3d, 0x49, 0x2a, 0x5b, 0x0e, 0x8b, 0xda,
-	0xbf, 0xa8, 0x2b, 0x1d, 0x37, 0x68, 0x52, 0x37, 0xe9, 0x06, 0xb0, 0x40, 0x6b, 0xff, 0x5a, 0x40,
-	0xe7, 0xe1, 0xac, 0xba, 0x72, 0x1b, 0x13, 0x4f, 0x9a, 0xfd, 0x6f, 0x05, 0x9e, 0xf7, 0x31, 0xb7,
-	0x69, 0x39, 0x09, 0xc4, 0xbf, 0x17, 0xf8, 0xe9, 0x62, 0x08, 0x59, 0x50, 0x55, 0xc0, 0x5f, 0x16,
-	0xf9, 0xc1, 0x48, 0x00, 0xdc, 0x5a, 0x8d, 0xe5, 0x74, 0x93, 0x36, 0x05, 0x8a, 0xfa, 0x8f, 0x82,
-	0x82, 0xc2, 0x24, 0x2e, 0x63, 0x35, 0x97, 0xe6, 0xa4, 0x8d, 0x69, 0x24, 0xb5, 0xff, 0x54, 0x7d,
-	0xa1, 0x7d, 0x24, 0x3a, 0x59, 0x4c, 0xc8, 0x3b, 0xaa, 0x10, 0xc6, 0x26, 0xb8, 0xe9, 0xfa, 0x38,
-	0x89, 0x7a, 0x57, 0x15, 0x42, 0xe7, 0x9f, 0x24, 0xfb, 0x3d, 0x35, 0x20, 0xd2, 0xde, 0x28, 0x9a,
-	0x9f, 0x67, 0xf9, 0x1a, 0x71, 0xc5, 0x55, 0x26, 0xe6, 0x7f, 0x21, 0x69, 0x61, 0xcb, 0xd6, 0x0d,
-	0x2c, 0xc6, 0x1b, 0xca, 0xfe, 0xa2, 0x9a, 0x2a, 0x3e, 0xd1, 0x1d, 0xaf, 0xe6, 0x92, 0x66, 0xd2,
-	0x80, 0x2f, 0xa9, 0x7b, 0xe9, 0x61, 0x9f, 0xef, 0x31, 0x63, 0x7d, 0x59, 0xd5, 0x1e, 0x2d, 0xba,
-	0x4b, 0x2c, 0x9f, 0x8b, 0xff, 0x8a, 0x9a, 0x65, 0x2d, 0x9d, 0x78, 0x8a, 0xeb, 0xcc, 0x08, 0x3e,
-	0x7a, 0x7f, 0xb5, 0x80, 0x5e, 0x80, 0xe7, 0xd5, 0x5d, 0x15, 0xc9, 0xed, 0xf0, 0x29, 0x2d, 0x1e,
-	0x19, 0xbe, 0xc6, 0x6c, 0xe1, 0x65, 0xd8, 0x8b, 0x0b, 0x1e, 0x15, 0xf2, 0xc9, 0x22, 0x3a, 0x03,
-	0x2b, 0x8c, 0x65, 0x48, 0x36, 0xa5, 0x7f, 0x2a, 0xa6, 0x5b, 0xcd, 0x7a, 0x3c, 0xd4, 0x7d, 0xba,
-	0xb8, 0xfe, 0xf1, 0x12, 0x2c, 0x27, 0x2f, 0xf1, 0xf4, 0xf6, 0xe6, 0x58, 0xb6, 0x76, 0x82, 0xde,
-	0x72, 0x74, 0x93, 0x56, 0xec, 0x9a, 0xde, 0xb6, 0x69, 0x89, 0x6d, 0xb9, 0xda, 0x1e, 0xbd, 0xea,
-	0xca, 0x2a, 0xa8, 0xd0, 0x43, 0x3a, 0x95, 0x4f, 0xd3, 0x83, 0xba, 0xed, 0x6e, 0xea, 0xb6, 0x30,
-	0x52, 0x7b, 0x40, 0x6f, 0x08, 0x75, 0xc3, 0x76, 0xdb, 0x51, 0x71, 0xa3, 0x97, 0x20, 0xc1, 0xa6,
-	0xc3, 0x4e, 0x97, 0x5e, 0x5a, 0x67, 0xb3, 0xde, 0xa0, 0xf7, 0x4f, 0xae, 0x42, 0x88, 0x10, 0xf7,
-	0x37, 0xad, 0x17, 0x73, 0xc4, 0x52, 0x79, 0x55, 0xfb, 0x20, 0x35, 0xb7, 0x66, 0xbd, 0xce, 0x63,
-	0xc1, 0xab, 0x2a, 0xbf, 0x52, 0x9d, 0x01, 0x24, 0xb0, 0xf2, 0x12, 0xe0, 0x93, 0x1d, 0x6d, 0x9f,
-	0x5e, 0x50, 0x28, 0x5e, 0xb9, 0x53, 0x44, 0xe5, 0x45, 0x38, 0x71, 0x20, 0x31, 0xde, 0x96, 0x5e,
-	0xab, 0xb9, 0xb6, 0x19, 0xf5, 0x9c, 0xe8, 0xba, 0xa2, 0xf5, 0xa9, 0xa3, 0x14, 0xa3, 0x5c, 0x18,
-	0xa4, 0x27, 0x3a, 0x3b, 0x37, 0x03, 0x74, 0x15, 0x2e, 0x53, 0xc4, 0xdc, 0x09, 0x9d, 0x4d, 0xf2,
-	0x87, 0xf4, 0x96, 0x90, 0x70, 0x6d, 0x1a, 0x28, 0x9d, 0x7d, 0x93, 0xe6, 0xb9, 0x98, 0x3d, 0xa6,
-	0x4a, 0x1e, 0xbd, 0x3e, 0x57, 0x60, 0x95, 0xb3, 0xa3, 0xea, 0xcf, 0xbb, 0x9a, 0xb8, 0x45, 0x5b,
-	0x8e, 0xe7, 0xeb, 0xb6, 0xcd, 0xd2, 0x8f, 0xde, 0xa2, 0x57, 0x60, 0xb1, 0xdd, 0xa2, 0x57, 0x1a,
-	0xcc, 0x49, 0x7f, 0x9e, 0x42, 0x37, 0xe0, 0xa5, 0x59, 0x9e, 0xf3, 0xea, 0x27, 0xe3, 0xe4, 0x6e,
-	0x63, 0x42, 0x2c, 0x13, 0x7b, 0xda, 0x27, 0xd9, 0x95, 0x5d, 0x15, 0x72, 0xeb, 0xa6, 0xf6, 0xa9,
-	0x14, 0xda, 0x80, 0x17, 0xe7, 0x8a, 0x91, 0x79, 0xaf, 0x37, 0xb1, 0xd7, 0xd2, 0x0d, 0xac, 0x7d,
-	0x3a, 0x45, 0x7b, 0xab, 0x34, 0x4e, 0x3e, 0x4e, 0xfc, 0x6d, 0x8a, 0x1e, 0x83, 0xc9, 0x49, 0xcb,
-	0x76, 0xeb, 0x1e, 0xbd, 0x86, 0x44, 0x9e, 0xd2, 0x92, 0x63, 0x39, 0xf4, 0xfa, 0xdf, 0x22, 0xee,
-	0x26, 0xa6, 0xd7, 0x8f, 0x88, 0x17, 0x2f, 0x63, 0x07, 0x91, 0xde, 0x39, 0x2e, 0xc3, 0x79, 0xdd,
-	0x34, 0xe9, 0xe4, 0x3d, 0x77, 0xfe, 0xbf, 0x04, 0x95, 0x04, 0x64, 0x6a, 0xf6, 0xbf, 0x0a, 0x6b,
-	0x09, 0xc0, 0x9c, 0xb9, 0xff, 0x22, 0x3c, 0x97, 0x80, 0x4d, 0xce, 0xfc, 0x93, 0x7a, 0xa6, 0xe6,
-	0xfd, 0x0b, 0x50, 0x9e, 0x00, 0x24, 0x66, 0xfd, 0x73, 0x70, 0x26, 0x69, 0x86, 0x3a, 0xe7, 0x2b,
-	0xca, 0x67, 0xce, 0xf8, 0x51, 0x8c, 0x1a, 0xae, 0xe7, 0xab, 0x59, 0xf4, 0xd3, 0x6c, 0x34, 0x65,
-	0x57, 0xaa, 0x28, 0x8b, 0xe8, 0x8c, 0xbc, 0x0a, 0x5a, 0xdb, 0x61, 0xc3, 0x46, 0x4c, 0x7e, 0x8f,
-	0x0d, 0x9d, 0xf4, 0x0a, 0x28, 0x52, 0x97, 0x16, 0x1e, 0xed, 0xe3, 0x59, 0x36, 0x4e, 0x61, 0x6a,
-	0x8d, 0x43, 0xa7, 0x8a, 0x9a, 0xad, 0xd7, 0xa3, 0xee, 0x53, 0xd3, 0x6d, 0x0f, 0x6b, 0x7f, 0x9d,
-	0x45, 0x27, 0x01, 0xdc, 0x16, 0x76, 0x02, 0xcb, 0xf3, 0xda, 0x58, 0xfb, 0xee, 0xc2, 0xcd, 0xdf,
-	0xca, 0xc1, 0x49, 0x4f, 0xfc, 0x7d, 0xae, 0x17, 0x0e, 0x1f, 0xf5, 0x76, 0x43, 0x64, 0x40, 0xb1,
-	0x1e, 0xca, 0xbf, 0xdf, 0x99, 0x7a, 0x11, 0xc6, 0x07, 0x87, 0xe3, 0xe3, 0x4a, 0xe2, 0x2f, 0x68,
-	0xab, 0x2b, 0xdf, 0xf5, 0x17, 0x9f, 0xfd, 0x48, 0x7a, 0x01, 0x95, 0xae, 0x3f, 0x7a, 0xe5, 0x3a,
-	0x7b, 0x70, 0x45, 0x75, 0x28, 0xb2, 0xf7, 0x60, 0x7b, 0xd0, 0x45, 0xf2, 0x3f, 0x6b, 0xe5, 0xd3,
-	0x73, 0x65, 0x92, 0x50, 0x5d, 0x65, 0x02, 0x4e, 0xa2, 0x25, 0x2a, 0x80, 0xff, 0x5f, 0xfb, 0xfe,
-	0xa0, 0xfb, 0x42, 0xea, 0x46, 0x0a, 0xd5, 0x21, 0xcf, 0x04, 0x8d, 0xe6, 0xda, 0x32, 0x25, 0x0d,
-	0x31, 0x69, 0x8b, 0x08, 0x22, 0x69, 0xa3, 0x1b, 0x29, 0xf4, 0x3a, 0x14, 0xf0, 0x87, 0xc2, 0xdd,
-	0xa3, 0x71, 0x88, 0xca, 0x62, 0xc5, 0xd4, 0x5b, 0x74, 0x65, 0x8e, 0x8e, 0xea, 0x39, 0x26, 0x72,
-	0xb5, 0xba, 0xc0, 0x44, 0x72, 0x31, 0xb7, 0xc5, 0xcb, 0x34, 0xea, 0x40, 0x49, 0x3f, 0x1a, 0x0f,
-	0xd8, 0xc3, 0x25, 0x5a, 0x4d, 0xbe, 0x42, 0x3f, 0x49, 0xf0, 0x55, 0x26, 0xf8, 0x52, 0xe5, 0x0c,
-	0x15, 0xcc, 0x1e, 0x96, 0xaf, 0x77, 0x8e, 0xc6, 0x83, 0x40, 0xea, 0xe0, 0xef, 0xd7, 0x28, 0x80,
-	0x22, 0x55, 0xe1, 0x1d, 0xf7, 0x77, 0x9f, 0x55, 0xc3, 0x15, 0xa6, 0xe1, 0x62, 0x65, 0x95, 0x6d,
-	0xce, 0x71, 0x7f, 0x77, 0xa6, 0x82, 0x5d, 0x00, 0xaa, 0x80, 0xbf, 0x74, 0x3e, 0xab, 0x8a, 0x6b,
-	0x4c, 0xc5, 0x5a, 0xe5, 0x2c, 0x55, 0xc1, 0x9f, 0xbc, 0x67, 0x2a, 0xb1, 0x21, 0xdf, 0xe8, 0xf4,
-	0xf7, 0xf6, 0x43, 0x94, 0xf8, 0x3f, 0x83, 0xb9, 0x72, 0xcf, 0x33, 0xb9, 0x67, 0xaa, 0x2b, 0xf1,
-	0x46, 0x5e, 0x7f, 0x83, 0x09, 0xb8, 0x9d, 0x5a, 0xbf, 0x9f, 0x67, 0xe8, 0x5b, 0xff, 0x1d, 0x00,
-	0x00, 0xff, 0xff, 0x17, 0xc6, 0x5c, 0x6c, 0x61, 0x2e, 0x00, 0x00,
+	// 4618 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5b, 0x6b, 0x8c, 0x1b, 0x59,
+	0x56, 0x8e, 0xdf, 0xf6, 0xe9, 0xee, 0xa4, 0xfa, 0x26, 0xdd, 0x71, 0x9c, 0x57, 0xc7, 0x93, 0x64,
+	0x33, 0x3d, 0x33, 0x9d, 0x4c, 0xb2, 0x42, 0x4b, 0x98, 0x01, 0x55, 0xbb, 0xae, 0xed, 0x9a, 0x2e,
+	0x57, 0x99, 0x5b, 0xe5, 0xce, 0x24, 0x12, 0x58, 0x4e, 0xbb, 0xe2, 0xf1, 0xc6, 0x6d, 0xf7, 0xd8,
+	0xee, 0xcc, 0x64, 0x17, 0x10, 0x42, 0xbc, 0x07, 0x10, 0xb0, 0xcb, 0x9b, 0x1f, 0xcb, 0x4b, 0xfb,
+	0x07, 0x76, 0xf9, 0xbf, 0x82, 0x05, 0xf1, 0x03, 0x58, 0xd8, 0xdd, 0x1f, 0x08, 0x04, 0x12, 0x12,
+	0x42, 0x5a, 0x24, 0x9e, 0x0b, 0x82, 0x99, 0xd9, 0xc7, 0xec, 0x2e, 0xe8, 0xbe, 0xaa, 0x6e, 0x95,
+	0xed, 0x3c, 0x16, 0x21, 0x7e, 0xa5, 0xef, 0x3d, 0xdf, 0x79, 0xdc, 0x73, 0xcf, 0x3d, 0xe7, 0xdc,
+	0x5b, 0x0e, 0x1c, 0x9d, 0xdc, 0xef, 0xdc, 0xbb, 0x37, 0x1a, 0x74, 0xb7, 0x0e, 0xc6, 0xa3, 0xe9,
+	0x08, 0x65, 0xd8, 0x3f, 0xa5, 0x33, 0xbd, 0xd1, 0xa8, 0x37, 0xf0, 0xaf, 0x76, 0x0e, 0xfa, 0x57,
+	0x3b, 0xc3, 0xe1, 0x68, 0xda, 0x99, 0xf6, 0x47, 0xc3, 0x09, 0x07, 0x95, 0xce, 0x0b, 0x2a, 0x1b,
+	0xdd, 0x3d, 0xbc, 0x77, 0x75, 0xda, 0xdf, 0xf7, 0x27, 0xd3, 0xce, 0xfe, 0x81, 0x00, 0x9c, 0x8e,
+	0x03, 0xfc, 0xfd, 0x83, 0xe9, 0x43, 0x4e, 0x2c, 0xdf, 0x80, 0x15, 0x77, 0xda, 0x99, 0xfa, 0xc4,
+	0x9f, 0x1c, 0x8c, 0x86, 0x13, 0x1f, 0x95, 0x21, 0x33, 0xa1, 0x13, 0xc5, 0xc4, 0x46, 0xe2, 0xca,
+	0xd2, 0xf5, 0x65, 0x8e, 0xdb, 0xe2, 0x20, 0x4e, 0x2a, 0x9f, 0x81, 0x7c, 0x80, 0xd7, 0x20, 0xb5,
+	0x3f, 0xe9, 0x31, 0x74, 0x81, 0xd0, 0x3f, 0xcb, 0x67, 0x21, 0x47, 0xfc, 0xd7, 0x0f, 0xfd, 0xc9,
+	0x14, 0x21, 0x48, 0x0f, 0x3b, 0xfb, 0xbe, 0xa0, 0xb2, 0xbf, 0xcb, 0xff, 0x98, 0x81, 0x0c, 0x93,
+	0x86, 0x5e, 0x04, 0xb8, 0x7b, 0xd8, 0x1f, 0x74, 0x5d, 0x45, 0xdf, 0xaa, 0xd0, 0xb7, 0x1d, 0x10,
+	0x88, 0x02, 0x42, 0xef, 0x87, 0xa5, 0xae, 0x7f, 0x30, 0x18, 0x3d, 0xe4, 0x3c, 0x49, 0xc6, 0x83,
+	0x04, 0x8f, 0x11, 0x52, 0x88, 0x0a, 0x43, 0x75, 0x38, 0x7a, 0x6f, 0x34, 0x7e, 0xa3, 0x33, 0xee,
+	0xfa, 0xdd, 0xe6, 0x68, 0x3c, 0x9d, 0x14, 0xd3, 0x1b, 0xa9, 0x2b, 0x4b, 0xd7, 0x37, 0xd4, 0xc5,
+	0x6d, 0x55, 0x23, 0x10, 0x3c, 0x9c, 0x8e, 0x1f, 0x92, 0x18, 0x1f, 0xaa, 0x80, 0x46, 0x5d, 0x70,
+	0x38, 0xa9, 0xbc, 0xe6, 0xef, 0xdd, 0xe7, 0x46, 0x64, 0x98, 0x11, 0x27, 0x15, 0x59, 0x2a, 0x99,
+	0xcc, 0x30, 0xa0, 0x9b, 0xb0, 0x72, 0xaf, 0x3f, 0xf0, 0xdd, 0x87, 0xc3, 0x3d, 0x2e, 0x21, 0xcb,
+	0x24, 0x9c, 0x10, 0x12, 0xaa, 0x2a, 0x8d, 0x44, 0xa1, 0xa8, 0x09, 0xc7, 0xbb, 0xfe, 0xdd, 0xc3,
+	0x5e, 0xaf, 0x3f, 0xec, 0x55, 0x46, 0xc3, 0x69, 0xa7, 0x3f, 0xf4, 0xc7, 0x93, 0x62, 0x8e, 0xad,
+	0xe7, 0x5c, 0xe0, 0x88, 0x38, 0x02, 0x3f, 0xf0, 0x87, 0x53, 0x32, 0x8f, 0x15, 0x3d, 0x07, 0xf9,
+	0x7d, 0x7f, 0xda, 0xe9, 0x76, 0xa6, 0x9d, 0x62, 0x9e, 0x19, 0x72, 0x4c, 0x88, 0x69, 0x88, 0x69,
+	0x12, 0x00, 0xd0, 0x16, 0x14, 0xa6, 0xfe, 0x64, 0xca, 0xcd, 0x2e, 0x30, 0xb4, 0x26, 0xd0, 0x9e,
+	0x9c, 0x27, 0x21, 0x04, 0xdd, 0x80, 0xdc, 0xfe, 0xa8, 0x7b, 0x38, 0xf0, 0x27, 0x45, 0x60, 0x26,
+	0x9e, 0x8a, 0xb8, 0xbc, 0xc1, 0x69, 0xdc, 0xd7, 0x12, 0x89, 0xce, 0x40, 0x81, 0xef, 0x1e, 0x5d,
+	0xd9, 0xd2, 0x46, 0xea, 0x4a, 0x81, 0x84, 0x13, 0x25, 0x17, 0x8e, 0xcf, 0xd9, 0x29, 0x1a, 0x87,
+	0xf7, 0xfd, 0x87, 0x2c, 0x8a, 0x32, 0x84, 0xfe, 0x89, 0x2e, 0x43, 0xe6, 0x41, 0x67, 0x70, 0x28,
+	0xa3, 0x44, 0xda, 0x49, 0x79, 0xb8, 0x3b, 0x38, 0xf9, 0x66, 0xf2, 0x03, 0x89, 0x92, 0x0d, 0xcb,
+	0xaa, 0x2d, 0xaa, 0xb4, 0x02, 0x97, 0x76, 0x25, 0x2a, 0x4d, 0xc6, 0x1c, 0xe7, 0x12, 0xa7, 0x23,
+	0x90, 0xf7, 0x4a, 0x3a, 0x9f, 0xd2, 0xd2, 0x65, 0x0c, 0x4b, 0x0a, 0x1d, 0x15, 0x21, 0xf7, 0x46,
+	0x67, 0xba, 0xf7, 0x9a, 0xdf, 0x65, 0x82, 0xf3, 0x44, 0x0e, 0xe9, 0x8a, 0x3b, 0xe3, 0x69, 0xff,
+	0x5e, 0x67, 0x6f, 0x3a, 0x29, 0x26, 0xf9, 0x8a, 0x83, 0x89, 0xf2, 0x4f, 0x25, 0x21, 0x2f, 0xf7,
+	0x02, 0x6d, 0x42, 0x86, 0x9d, 0x87, 0x62, 0x22, 0x12, 0x34, 0xec, 0xbc, 0x04, 0x1b, 0xc6, 0x21,
+	0xe8, 0x05, 0xc8, 0x72, 0xbf, 0x09, 0xa3, 0xd7, 0x22, 0x07, 0x25, 0x40, 0x0b, 0x10, 0x7a, 0x1f,
+	0xa4, 0xe9, 0xce, 0x15, 0x53, 0x0c, 0x7c, 0x5c, 0xd9, 0xd7, 0x00, 0xca, 0x00, 0xe8, 0x3b, 0x00,
+	0x3a, 0xdd, 0x6e, 0x9f, 0x66, 0xa1, 0xce, 0xa0, 0xb8, 0xc7, 0x36, 0xf6, 0x7c, 0x2c, 0x68, 0xb6,
+	0xf4, 0x00, 0xc1, 0xb7, 0x57, 0x61, 0x29, 0xbd, 0x0c, 0xc7, 0x62, 0xe4, 0x39, 0x1e, 0x3f, 0xa1,
+	0x7a, 0xbc, 0xa0, 0x78, 0xb7, 0xfc, 0x4e, 0x12, 0x56, 0x22, 0x0b, 0x46, 0xcf, 0xc3, 0xea, 0xf0,
+	0x70, 0xff, 0xae, 0x3f, 0x76, 0xee, 0xe9, 0x81, 0x23, 0x79, 0x2c, 0xcc, 0x12, 0xd0, 0xcb, 0x90,
+	0x67, 0x0e, 0xf2, 0xc7, 0xdc, 0xdb, 0x4b, 0xd7, 0x2f, 0xcc, 0x73, 0xe3, 0x96, 0xb9, 0xdf, 0xe9,
+	0xf9, 0xdb, 0x1c, 0x49, 0x02, 0x16, 0x74, 0x11, 0xd2, 0xd3, 0x87, 0x07, 0x3e, 0xf3, 0xd3, 0xd1,
+	0x20, 0xae, 0x18, 0xce, 0x7b, 0x78, 0xe0, 0x13, 0x46, 0x45, 0xc6, 0x1c, 0x27, 0x5d, 0x9c, 0xab,
+	0xe6, 0x51, 0x9e, 0xb2, 0x60, 0x59, 0xb5, 0x02, 0x5d, 0x16, 0xba, 0x13, 0x4c, 0x37, 0x52, 0xe5,
+	0xf9, 0x63, 0x45, 0xfb, 0x09, 0xc8, 0xec, 0x8d, 0x0e, 0x87, 0x53, 0xe6, 0xbc, 0x0c, 0xe1, 0x83,
+	0xff, 0xad, 0xdf, 0xdf, 0x4a, 0xc0, 0xb2, 0x1a, 0x0e, 0xe8, 0xfd, 0x90, 0xa3, 0x63, 0xea, 0xc7,
+	0x04, 0x5b, 0x60, 0x69, 0x4e, 0xd0, 0x6c, 0x71, 0x08, 0x91, 0xd0, 0x12, 0x86, 0x2c, 0xff, 0x13,
+	0x5d, 0x8a, 0xac, 0x66, 0x55, 0x61, 0x7e, 0xfc, 0x62, 0xca, 0x7f, 0x9c, 0x80, 0xa3, 0xd1, 0x48,
+	0x46, 0x2f, 0xa9, 0x99, 0x23, 0x11, 0xcb, 0x89, 0x2a, 0x52, 0x0c, 0xfd, 0xb1, 0x92, 0x59, 0xd0,
+	0xf3, 0x90, 0xdb, 0x1b, 0x1c, 0x52, 0xdd, 0xc5, 0x64, 0xc4, 0xbd, 0x95, 0xc1, 0x61, 0x60, 0x91,
+	0x84, 0x94, 0x4c, 0xc8, 0x4b, 0x21, 0xe8, 0x7d, 0x91, 0x75, 0x1c, 0x8f, 0xa8, 0x7c, 0x82, 0x95,
+	0xfc, 0x5d, 0x02, 0x20, 0x2c, 0x78, 0xe8, 0xdb, 0xd5, 0x6c, 0x90, 0x88, 0x54, 0xaa, 0x10, 0xb5,
+	0x15, 0x84, 0x33, 0x0f, 0x9a, 0x90, 0x05, 0x6d, 0xc0, 0x52, 0xe7, 0x70, 0x3a, 0xf2, 0xc6, 0xfd,
+	0x5e, 0x4f, 0xac, 0x25, 0x4f, 0xd4, 0x29, 0x5a, 0x79, 0x45, 0x55, 0x1a, 0x75, 0x65, 0x1c, 0xaf,
+	0x46, 0x0b, 0xd8, 0xa8, 0xeb, 0x13, 0x05, 0x54, 0x7a, 0x09, 0x8e, 0x46, 0x35, 0x3e, 0x55, 0xe4,
+	0xec, 0x42, 0x21, 0xa8, 0x0f, 0x68, 0x1d, 0xb2, 0x5c, 0xb0, 0xe0, 0x15, 0xa3, 0x98, 0x55, 0xc9,
+	0x27, 0xb0, 0xaa, 0xfc, 0x21, 0x58, 0x52, 0xaa, 0xfe, 0x42, 0xc9, 0xff, 0x17, 0x1e, 0x29, 0xff,
+	0x7d, 0x02, 0xb4, 0x78, 0xb5, 0x5f, 0x68, 0x81, 0x01, 0x85, 0xb1, 0x3f, 0x19, 0x1d, 0x8e, 0xf7,
+	0x7c, 0x99, 0x73, 0x2e, 0x2f, 0xe8, 0x18, 0xb6, 0x88, 0x04, 0x8a, 0x9d, 0x0d, 0x18, 0xbf, 0xc9,
+	0x7d, 0x8b, 0xca, 0x7b, 0xaa, 0x7d, 0x33, 0x61, 0x25, 0xd2, 0x8e, 0x7c, 0xf3, 0x1e, 0x2e, 0x7f,
+	0x31, 0x0b, 0x19, 0x56, 0x77, 0xd1, 0x35, 0x28, 0xd0, 0x86, 0x82, 0x0d, 0x44, 0x19, 0xd3, 0x94,
+	0xea, 0xc1, 0xe6, 0xeb, 0x47, 0x48, 0x08, 0x42, 0x37, 0x44, 0xa7, 0xc8, 0x59, 0x92, 0xb3, 0x9d,
+	0xa2, 0xe4, 0x51, 0x60, 0xe8, 0x5b, 0x64, 0xaf, 0xc8, 0xb9, 0x52, 0x73, 0x7a, 0x45, 0xc9, 0xa6,
+	0x02, 0xa9, 0x79, 0x07, 0xb2, 0x47, 0x28, 0xa6, 0xe7, 0xf7, 0x0e, 0xd4, 0xbc, 0x00, 0x84, 0x70,
+	0xa4, 0x2b, 0xe4, 0x8c, 0x0b, 0xbb, 0x42, 0xc9, 0x3f, 0xc3, 0x82, 0xbe, 0x0b, 0x8a, 0x72, 0xab,
+	0xe3, 0x78, 0xd1, 0x22, 0xca, 0x22, 0x4b, 0x16, 0xc0, 0xea, 0x47, 0xc8, 0x42, 0x11, 0xe8, 0xa5,
+	0xb0, 0xed, 0xe4, 0x32, 0x73, 0x73, 0xdb, 0x4e, 0x29, 0x28, 0x0a, 0x46, 0x77, 0xe0, 0x64, 0x77,
+	0x7e, 0x5b, 0x29, 0xba, 0xc6, 0xc7, 0x34, 0x9f, 0xf5, 0x23, 0x64, 0x91, 0x00, 0xf4, 0xad, 0xb0,
+	0xdc, 0xf5, 0x1f, 0x58, 0xa3, 0xd1, 0x01, 0x17, 0x58, 0x88, 0x34, 0x20, 0x86, 0x42, 0xaa, 0x1f,
+	0x21, 0x11, 0x28, 0x75, 0xfd, 0xd4, 0x1f, 0xef, 0xf7, 0x87, 0xec, 0x4e, 0xc4, 0xd9, 0x21, 0xe2,
+	0x7a, 0x2f, 0x46, 0xa6, 0xae, 0x8f, 0xb3, 0xa0, 0x6b, 0x3c, 0x3f, 0x71, 0xfe, 0xa5, 0x99, 0xbe,
+	0x36, 0xd8, 0xf3, 0x60, 0x80, 0xb6, 0xe1, 0xd8, 0xfd, 0xc3, 0xbb, 0xfe, 0x78, 0xe8, 0x4f, 0xfd,
+	0x09, 0xe7, 0x5b, 0x66, 0x7c, 0xeb, 0x82, 0x6f, 0x27, 0x4a, 0xad, 0x1f, 0x21, 0x71, 0x06, 0x64,
+	0xc3, 0xf1, 0xce, 0xc1, 0xc1, 0xa0, 0xbf, 0xc7, 0x2c, 0xb1, 0x46, 0x3d, 0x2e, 0x67, 0x65, 0x23,
+	0xa1, 0x94, 0x52, 0x7d, 0x16, 0x51, 0x3f, 0x42, 0xe6, 0x31, 0x6e, 0x2f, 0x03, 0xf8, 0xf4, 0x8f,
+	0x36, 0xad, 0x35, 0x65, 0x02, 0x5a, 0x7c, 0xed, 0x0b, 0x8f, 0xef, 0x65, 0x48, 0xf9, 0xe3, 0x71,
+	0x31, 0x19, 0x89, 0x08, 0x7d, 0x8f, 0x32, 0x76, 0xee, 0x0e, 0x7c, 0x3c, 0x1e, 0x13, 0x0a, 0x28,
+	0x0f, 0x60, 0x59, 0xdd, 0x0e, 0xda, 0xb8, 0xf6, 0xa7, 0xfe, 0x98, 0x69, 0x10, 0xfd, 0x56, 0x38,
+	0xa1, 0x68, 0x4b, 0xce, 0xd3, 0x96, 0x7a, 0x9c, 0xb6, 0xb7, 0x12, 0xb0, 0x12, 0x99, 0x46, 0xcf,
+	0x41, 0xce, 0x1f, 0x8f, 0x59, 0xf6, 0x4b, 0x2c, 0xca, 0x7e, 0x12, 0x41, 0xfb, 0xed, 0x7d, 0x7f,
+	0x32, 0xe9, 0xf4, 0x64, 0x62, 0x93, 0x43, 0x74, 0x03, 0x96, 0x26, 0x87, 0xbd, 0x9e, 0x3f, 0x61,
+	0x17, 0xe9, 0x62, 0x8a, 0xe5, 0xe3, 0x40, 0x54, 0x40, 0x21, 0x2a, 0xaa, 0x6c, 0x43, 0x21, 0x48,
+	0x4f, 0x34, 0x65, 0xfa, 0x34, 0x9b, 0x0a, 0x3f, 0xf2, 0x41, 0xe4, 0x2e, 0x95, 0x7c, 0xcc, 0x5d,
+	0xaa, 0xfc, 0x29, 0x59, 0xf5, 0xb9, 0xc4, 0x12, 0xe4, 0x65, 0x09, 0x17, 0x42, 0x83, 0xf1, 0x42,
+	0x47, 0x6a, 0xa1, 0x23, 0x0b, 0xcc, 0x65, 0xaa, 0x83, 0xd2, 0x8f, 0x75, 0xd0, 0x4d, 0x58, 0xe9,
+	0xa8, 0xee, 0x2d, 0x66, 0x1e, 0xb1, 0x23, 0x51, 0x68, 0xb9, 0xad, 0x9c, 0x98, 0x85, 0x61, 0x35,
+	0xa3, 0x20, 0xf9, 0xe4, 0x0a, 0x3e, 0x96, 0x90, 0xb5, 0xfd, 0xd1, 0x3a, 0xb4, 0x30, 0x74, 0x67,
+	0x7d, 0x90, 0x7a, 0x7a, 0x1f, 0xa4, 0x9f, 0xdc, 0xc4, 0x4f, 0x47, 0x3b, 0x80, 0x47, 0xdb, 0xb9,
+	0x38, 0x1a, 0xff, 0x1f, 0x77, 0xf1, 0xad, 0x24, 0x14, 0x17, 0x15, 0x13, 0x1a, 0x91, 0xb2, 0x98,
+	0xc8, 0x88, 0x94, 0xe3, 0x85, 0x11, 0xa9, 0xac, 0x32, 0x35, 0x77, 0x95, 0xe9, 0x70, 0x95, 0xd1,
+	0x6e, 0x26, 0xf3, 0x04, 0xdd, 0xcc, 0xec, 0x5a, 0xb3, 0x4f, 0xbc, 0x56, 0xda, 0xa2, 0xd0, 0x04,
+	0x4c, 0x6b, 0x8f, 0xff, 0x26, 0xaf, 0x7e, 0x05, 0xa2, 0x4e, 0x95, 0xdf, 0x4b, 0x42, 0x21, 0x28,
+	0xf1, 0x34, 0xb7, 0x0d, 0x46, 0x7b, 0x9d, 0x01, 0x9d, 0x91, 0xb9, 0x2d, 0x98, 0x40, 0xe7, 0x00,
+	0xc6, 0xfe, 0xfe, 0x68, 0xea, 0x33, 0x32, 0x6f, 0xe7, 0x95, 0x19, 0xea, 0x88, 0x83, 0x51, 0xd7,
+	0xee, 0xec, 0x07, 0x8e, 0x10, 0x43, 0x74, 0x11, 0x56, 0xf6, 0x64, 0xfd, 0x63, 0x74, 0xee, 0x92,
+	0xe8, 0x24, 0xd5, 0x4e, 0x9f, 0xcb, 0x26, 0x07, 0x9d, 0x3d, 0xee, 0x9b, 0x02, 0x09, 0x27, 0xe8,
+	0xd6, 0xd0, 0xf6, 0x83, 0xb1, 0x67, 0xf9, 0xd6, 0xc8, 0x31, 0x2a, 0xc3, 0xb2, 0xdc, 0x26, 0x7a,
+	0xf3, 0x10, 0x0b, 0x8d, 0xcc, 0xa9, 0x18, 0x26, 0x23, 0x1f, 0xc5, 0x30, 0x39, 0x45, 0xc8, 0x75,
+	0xba, 0xdd, 0xb1, 0x3f, 0x99, 0xb0, 0x82, 0x5c, 0x20, 0x72, 0x88, 0xae, 0x03, 0x4c, 0x3b, 0xe3,
+	0x9e, 0x3f, 0x65, 0x6b, 0x87, 0x48, 0x63, 0x65, 0x0e, 0xa7, 0xce, 0xd8, 0x9d, 0x8e, 0xfb, 0xc3,
+	0x1e, 0x51, 0x50, 0x71, 0xef, 0x2f, 0xcd, 0x7a, 0xff, 0xaf, 0x12, 0x61, 0xb3, 0x19, 0xec, 0x00,
+	0x6d, 0x42, 0x2a, 0xec, 0xc6, 0x24, 0x76, 0x20, 0x98, 0xa0, 0x29, 0xb8, 0xbf, 0x1f, 0x1e, 0x27,
+	0x3e, 0x50, 0x02, 0x33, 0x35, 0x2f, 0x4d, 0xa4, 0xe7, 0x1e, 0xb2, 0xcc, 0xd3, 0x1f, 0xb2, 0x27,
+	0x0f, 0xbc, 0xf2, 0xdb, 0x49, 0x38, 0xb9, 0xa0, 0x2b, 0x7a, 0x54, 0xb6, 0x90, 0xe1, 0x93, 0x7c,
+	0x4c, 0xf8, 0xa4, 0x1e, 0x1b, 0x3e, 0xe9, 0x39, 0xe1, 0x13, 0xd4, 0x9a, 0x4c, 0xac, 0xd6, 0x14,
+	0x21, 0x37, 0x3e, 0x1c, 0xd2, 0x47, 0x64, 0x11, 0x59, 0x72, 0x48, 0x43, 0xfe, 0x8d, 0xd1, 0xf8,
+	0x7e, 0x7f, 0xd8, 0x33, 0xfa, 0x63, 0x11, 0x56, 0xca, 0x0c, 0xb2, 0x01, 0x58, 0x87, 0xc7, 0x9f,
+	0x58, 0xf3, 0xac, 0xa8, 0x6e, 0x3d, 0xba, 0x2b, 0xdc, 0x32, 0x02, 0x06, 0xf1, 0xf6, 0x11, 0x4a,
+	0xa0, 0xaf, 0x15, 0x31, 0xf2, 0xe3, 0xee, 0x2e, 0x2b, 0xea, 0xdd, 0xe5, 0x53, 0x49, 0x38, 0x16,
+	0x6b, 0xc2, 0xe8, 0x83, 0x74, 0x70, 0x51, 0x2f, 0x88, 0x3b, 0xf9, 0x3a, 0x64, 0xc7, 0x7e, 0x67,
+	0x32, 0x1a, 0xca, 0x54, 0xc6, 0x47, 0x8f, 0x48, 0x65, 0x08, 0xd2, 0xf7, 0xfb, 0xc3, 0xae, 0xf0,
+	0x2b, 0xfb, 0x3b, 0x78, 0xea, 0xce, 0x84, 0x4f, 0xdd, 0xd1, 0x4d, 0xc8, 0xc6, 0x37, 0x61, 0x66,
+	0x23, 0x73, 0xf3, 0x36, 0xb2, 0x04, 0x79, 0xff, 0xcd, 0xfe, 0x94, 0x85, 0x69, 0x9e, 0x1d, 0x81,
+	0x60, 0x2c, 0x4e, 0xf1, 0xb4, 0x33, 0x9e, 0xf2, 0x23, 0x52, 0x60, 0xf4, 0xc8, 0x5c, 0xf8, 0xe2,
+	0x00, 0xca, 0x8b, 0xc3, 0x13, 0x9c, 0xc6, 0x4f, 0x26, 0xe0, 0xf8, 0x9c, 0xd6, 0x73, 0xd6, 0xea,
+	0xc4, 0x3c, 0xab, 0x17, 0x87, 0x6f, 0xc4, 0x27, 0xa9, 0xb8, 0x4f, 0x14, 0x9f, 0xa7, 0xa3, 0x3e,
+	0x8f, 0x59, 0x9c, 0x99, 0xb5, 0xf8, 0xfb, 0x20, 0x4f, 0xad, 0x64, 0x71, 0xf2, 0x01, 0x28, 0x04,
+	0x9f, 0x41, 0xc4, 0x15, 0xb3, 0xb4, 0xc5, 0xbf, 0x83, 0x6c, 0xc9, 0xef, 0x20, 0x5b, 0x9e, 0x44,
+	0x90, 0x10, 0x4c, 0xbf, 0x7f, 0xf8, 0xca, 0x2d, 0x53, 0x7e, 0xff, 0x10, 0x2f, 0xc6, 0x7e, 0xb4,
+	0xf9, 0x4b, 0x29, 0xcd, 0x5f, 0xf9, 0x26, 0xac, 0xb6, 0x26, 0xfe, 0xd8, 0x1c, 0x4e, 0x29, 0x54,
+	0x7c, 0x01, 0xb9, 0x04, 0xd9, 0x3e, 0x9b, 0x10, 0x56, 0xac, 0x84, 0x69, 0x92, 0xa2, 0x04, 0xb1,
+	0xfc, 0x6d, 0x70, 0x54, 0xdc, 0x93, 0x25, 0xe3, 0xb3, 0xd1, 0xef, 0x30, 0xc1, 0x6b, 0x2c, 0x47,
+	0x45, 0x3e, 0xc7, 0xbc, 0x08, 0xcb, 0xea, 0x34, 0x2a, 0x41, 0xce, 0x67, 0xc9, 0x47, 0xbc, 0x33,
+	0xd7, 0x8f, 0x10, 0x39, 0xb1, 0x9d, 0x81, 0xd4, 0x83, 0xce, 0xa0, 0xfc, 0x0a, 0x64, 0xb9, 0x05,
+	0x74, 0x2d, 0xe1, 0x7b, 0x72, 0x5e, 0xbe, 0x1c, 0x23, 0x48, 0x4f, 0x1e, 0x0e, 0xf7, 0xc4, 0x3d,
+	0x9e, 0xfd, 0x4d, 0xcf, 0x89, 0x78, 0x4d, 0x4e, 0xb1, 0x59, 0x31, 0x2a, 0xbf, 0x00, 0xc7, 0xe4,
+	0xcb, 0x90, 0x34, 0xfe, 0x11, 0xbd, 0x6c, 0xf9, 0x16, 0x20, 0xfe, 0x28, 0x7e, 0x8b, 0x3e, 0x7e,
+	0x4b, 0x8e, 0x75, 0xc8, 0xf2, 0xe7, 0x7f, 0x99, 0x07, 0xf9, 0x28, 0x74, 0x43, 0xf2, 0xb1, 0x6e,
+	0x78, 0x81, 0xa6, 0x0b, 0xf1, 0xaa, 0x17, 0xda, 0x21, 0x9f, 0xf7, 0xa4, 0x1d, 0x72, 0x5c, 0xde,
+	0x03, 0x08, 0x3b, 0x7d, 0xf4, 0x32, 0x1c, 0x0d, 0x7b, 0x7d, 0xe5, 0x7e, 0xb1, 0x36, 0x73, 0x29,
+	0xa0, 0x44, 0x12, 0x03, 0x53, 0xf3, 0x79, 0xce, 0x97, 0x39, 0x84, 0x8f, 0xca, 0xdf, 0x09, 0x4b,
+	0x4a, 0x41, 0x8c, 0xa4, 0x9f, 0x4c, 0x98, 0x7e, 0xfa, 0xc3, 0xe9, 0x6e, 0x67, 0x20, 0x9a, 0x08,
+	0x31, 0xe2, 0x95, 0x61, 0x4c, 0xe7, 0x83, 0x42, 0x46, 0x47, 0x9b, 0x23, 0x58, 0x52, 0x9e, 0x7b,
+	0x51, 0x11, 0x4e, 0xb4, 0xec, 0x1d, 0xdb, 0xb9, 0x65, 0xb7, 0xb7, 0x5b, 0xa6, 0x65, 0x60, 0xd2,
+	0xf6, 0x6e, 0x37, 0xb1, 0x76, 0x04, 0xe5, 0x20, 0xf5, 0x8a, 0xb9, 0xad, 0x25, 0x50, 0x01, 0x32,
+	0xdb, 0xfa, 0x1d, 0x6c, 0x69, 0x49, 0x74, 0x14, 0x80, 0xa1, 0x9a, 0x7a, 0x65, 0xc7, 0xd5, 0x52,
+	0x08, 0x20, 0x5b, 0x69, 0xb9, 0x9e, 0xd3, 0xd0, 0xd2, 0xf4, 0xef, 0x1d, 0xdd, 0x36, 0x77, 0x1c,
+	0x2d, 0x43, 0xff, 0x36, 0x9c, 0xca, 0x0e, 0x26, 0x5a, 0x76, 0xd3, 0x80, 0x42, 0xf0, 0xb6, 0x8d,
+	0xd6, 0x01, 0x45, 0xd4, 0x49, 0x65, 0x4b, 0x90, 0xab, 0x58, 0x2d, 0xd7, 0xc3, 0x44, 0x4b, 0x50,
+	0xcd, 0xb5, 0xca, 0xb6, 0x96, 0xa4, 0x9a, 0x2d, 0xa7, 0xa2, 0x5b, 0x5a, 0x6a, 0x73, 0x07, 0x20,
+	0x7c, 0xd7, 0x45, 0x6b, 0xb0, 0x2a, 0xc5, 0x78, 0xd8, 0xf5, 0xa4, 0x94, 0x3c, 0xa4, 0x5b, 0xb6,
+	0xe9, 0x69, 0x09, 0x74, 0x06, 0x8a, 0x15, 0xc7, 0xf6, 0x74, 0xd3, 0xc6, 0xa4, 0xed, 0x7a, 0xa4,
+	0x55, 0xf1, 0x5a, 0x04, 0x33, 0xb0, 0x96, 0xdc, 0x74, 0xe8, 0x35, 0x34, 0x7c, 0x5c, 0x45, 0xa7,
+	0x60, 0x4d, 0x8a, 0x33, 0x70, 0xd3, 0x72, 0x6e, 0x87, 0x5e, 0xc8, 0x43, 0xba, 0x8e, 0xad, 0x86,
+	0x96, 0x40, 0x2b, 0x50, 0xd8, 0x61, 0x6b, 0x35, 0xef, 0x60, 0x2d, 0x49, 0x2d, 0xde, 0x69, 0x6d,
+	0xe3, 0x8a, 0x47, 0xad, 0x33, 0x61, 0x49, 0x79, 0xe4, 0x55, 0x9d, 0x2a, 0x56, 0x25, 0xc5, 0x2d,
+	0x43, 0xbe, 0x61, 0xda, 0x26, 0xe5, 0x14, 0x0b, 0xdd, 0xc1, 0x7c, 0xa1, 0x8e, 0x57, 0xc7, 0x44,
+	0x4b, 0x6d, 0x7e, 0xf4, 0x34, 0x40, 0xd8, 0x41, 0xa0, 0x2c, 0x24, 0x9d, 0x1d, 0xed, 0x08, 0x2a,
+	0xc2, 0x71, 0xd7, 0xd3, 0xbd, 0x96, 0x5b, 0xa9, 0xe3, 0xca, 0x4e, 0xdb, 0x6d, 0x55, 0x2a, 0xd8,
+	0x75, 0xb5, 0x3f, 0x49, 0x20, 0x04, 0x2b, 0xdc, 0x95, 0x72, 0xee, 0x4f, 0x13, 0xe8, 0x38, 0x1c,
+	0xe5, 0x0b, 0x09, 0x26, 0x3f, 0x93, 0x40, 0xab, 0xb0, 0xcc, 0x9c, 0x25, 0xa7, 0xfe, 0x8c, 0xb9,
+	0x89, 0xf3, 0x36, 0x5b, 0x6e, 0xbd, 0xad, 0xb3, 0xf9, 0xb6, 0x81, 0x6d, 0x13, 0x1b, 0x9a, 0x8f,
+	0x4e, 0xc3, 0x49, 0x41, 0x25, 0xce, 0x2b, 0xb8, 0xe2, 0xb5, 0x6d, 0xc7, 0x6b, 0x57, 0x9d, 0x96,
+	0x6d, 0x68, 0xf7, 0xd0, 0x33, 0x70, 0x9e, 0x13, 0xf9, 0x46, 0xb7, 0x0d, 0x1d, 0x37, 0x1c, 0x9b,
+	0x41, 0x48, 0xcb, 0xb6, 0x4d, 0xbb, 0xa6, 0xf5, 0xd0, 0x09, 0xd0, 0x38, 0xa8, 0xe5, 0x62, 0xd2,
+	0xc6, 0x84, 0x38, 0x44, 0x7b, 0x2d, 0xd4, 0x2a, 0x58, 0x5b, 0xb6, 0xbe, 0xab, 0x9b, 0x96, 0xbe,
+	0x6d, 0x61, 0xad, 0x8f, 0xce, 0xc2, 0xa9, 0x38, 0xb5, 0xe5, 0xd5, 0x1d, 0x62, 0xde, 0xc1, 0x86,
+	0xf6, 0xc1, 0xd0, 0x28, 0x41, 0x76, 0x6f, 0xbb, 0x1e, 0x6e, 0x50, 0xd9, 0xda, 0x7d, 0x74, 0x01,
+	0xce, 0x46, 0x88, 0xd4, 0x9a, 0x86, 0x63, 0x98, 0x55, 0x13, 0x1b, 0x0c, 0x32, 0x40, 0x17, 0x61,
+	0x63, 0x06, 0x62, 0x36, 0x9a, 0x16, 0x6e, 0x60, 0xdb, 0x13, 0xa8, 0x7d, 0x74, 0x0e, 0x4a, 0xb1,
+	0xd5, 0x79, 0x7a, 0xdb, 0x72, 0x5c, 0x97, 0xd1, 0x87, 0x33, 0xf4, 0xaa, 0x43, 0xb6, 0x4d, 0xc3,
+	0xc0, 0x36, 0xa3, 0x8f, 0x66, 0x16, 0x51, 0x71, 0xec, 0xaa, 0x65, 0x56, 0x3c, 0x46, 0x3e, 0x40,
+	0x1b, 0x70, 0x26, 0x42, 0x66, 0x9e, 0x51, 0xdc, 0xfb, 0x3a, 0x2a, 0xc3, 0xb9, 0x08, 0xc2, 0xb4,
+	0x77, 0x75, 0xcb, 0x34, 0xda, 0x4d, 0x9d, 0xe8, 0x7c, 0xb5, 0xe3, 0xb8, 0x11, 0x55, 0xd3, 0xc2,
+	0x8a, 0x8c, 0xc9, 0xcc, 0x52, 0x2b, 0x7a, 0xa5, 0x8e, 0xdb, 0x55, 0xe2, 0x34, 0xda, 0xcd, 0x96,
+	0x65, 0x31, 0x29, 0x53, 0x74, 0x1e, 0x4e, 0x47, 0x50, 0x35, 0xec, 0xb5, 0x0d, 0xb3, 0x46, 0x23,
+	0x85, 0x02, 0x0e, 0x43, 0xa7, 0x12, 0x5c, 0x33, 0x5d, 0x8f, 0xdc, 0x8e, 0x43, 0x1e, 0x84, 0x10,
+	0x19, 0xf6, 0xaf, 0x98, 0xdb, 0xed, 0xa6, 0xd5, 0xaa, 0x99, 0x36, 0x8f, 0xfc, 0x37, 0xc2, 0x4d,
+	0xa7, 0xa4, 0x1a, 0xd1, 0x0d, 0x0b, 0xd3, 0xc3, 0xc6, 0x04, 0xbc, 0x19, 0xee, 0x2a, 0xa5, 0x36,
+	0xf4, 0x5d, 0x6c, 0x07, 0xc4, 0x87, 0x68, 0x13, 0x2e, 0x9b, 0xb6, 0xe9, 0x05, 0x3b, 0x86, 0xbd,
+	0x5b, 0x0e, 0xd9, 0x69, 0x5b, 0xa6, 0xeb, 0x99, 0x76, 0xad, 0x1d, 0x1c, 0x74, 0x57, 0xfb, 0x10,
+	0xda, 0x82, 0xcd, 0x79, 0x58, 0xe9, 0xbe, 0x00, 0xdb, 0xb6, 0xf5, 0x06, 0xd6, 0x3e, 0x8c, 0xae,
+	0xc1, 0xf3, 0xf3, 0xf0, 0x21, 0xce, 0x70, 0xb0, 0xcb, 0xbc, 0x8a, 0x5f, 0x35, 0x5d, 0x4f, 0xfb,
+	0x1e, 0x74, 0x1e, 0x4a, 0xea, 0x49, 0x34, 0x1b, 0x7a, 0x0d, 0x87, 0xfe, 0xfc, 0xed, 0x24, 0x7a,
+	0x06, 0xce, 0xa9, 0x80, 0x50, 0x54, 0x85, 0x60, 0x9d, 0x5a, 0xac, 0xfd, 0x4e, 0x12, 0x95, 0xe1,
+	0xac, 0x0a, 0x22, 0x2d, 0x5b, 0x01, 0x52, 0x41, 0x9f, 0x48, 0xa2, 0x4b, 0xb0, 0x31, 0x5f, 0x90,
+	0x87, 0x49, 0xc3, 0xb4, 0x75, 0x0f, 0x1b, 0xda, 0x27, 0x93, 0xe8, 0x39, 0xb8, 0xac, 0xc2, 0xf8,
+	0xc1, 0xa7, 0xd1, 0xdc, 0x26, 0x8e, 0x65, 0x39, 0x2d, 0xaf, 0xdd, 0xc4, 0xb6, 0x41, 0xf5, 0xfe,
+	0xee, 0x23, 0x64, 0x12, 0xec, 0x7a, 0x3a, 0x61, 0xe6, 0x7d, 0x21, 0x89, 0x4a, 0xb0, 0xa6, 0xc2,
+	0x5a, 0x76, 0x1d, 0xeb, 0x96, 0x57, 0xbf, 0xad, 0xfd, 0xc3, 0x8c, 0x08, 0xdb, 0x31, 0x70, 0xbb,
+	0x81, 0x1b, 0x0e, 0xb9, 0xdd, 0x6e, 0x12, 0xec, 0xba, 0x2d, 0x82, 0xb5, 0x9f, 0x4e, 0xc5, 0xdd,
+	0xc0, 0x60, 0x86, 0xe9, 0xee, 0x84, 0xa0, 0x9f, 0x49, 0xa1, 0x67, 0xe1, 0xe2, 0x0c, 0x48, 0xee,
+	0x81, 0x9a, 0x16, 0x7e, 0x36, 0x15, 0xf7, 0x18, 0x83, 0x36, 0x4d, 0x23, 0x14, 0xf7, 0x91, 0xf9,
+	0x3a, 0x5b, 0x36, 0x1d, 0x19, 0x2d, 0x2e, 0xe8, 0xa3, 0x29, 0x74, 0x01, 0xce, 0xcc, 0x01, 0x11,
+	0xac, 0x57, 0xea, 0x0c, 0xf2, 0x73, 0xa9, 0xf8, 0x1e, 0x73, 0xb3, 0x68, 0x66, 0xc3, 0xba, 0x71,
+	0x5b, 0xfb, 0xf9, 0x19, 0x63, 0xaa, 0xba, 0x69, 0x61, 0xa3, 0x2d, 0x14, 0x51, 0x1f, 0xfe, 0x42,
+	0x0a, 0xbd, 0x0f, 0xca, 0x2a, 0x46, 0x54, 0x0b, 0xea, 0x72, 0x1b, 0x57, 0x3c, 0xd3, 0xe1, 0xb9,
+	0xe2, 0x97, 0x66, 0xac, 0x96, 0x40, 0xba, 0xb8, 0x1d, 0xd3, 0xb2, 0xb0, 0xa1, 0xfd, 0xf2, 0x8c,
+	0xa7, 0x02, 0x69, 0x96, 0x49, 0x77, 0xba, 0x8a, 0xbd, 0x4a, 0x9d, 0xc9, 0xfb, 0x95, 0x54, 0x7c,
+	0x83, 0x94, 0x80, 0x08, 0x61, 0xbf, 0x3a, 0xe3, 0x87, 0xa6, 0x63, 0xb4, 0xe9, 0x51, 0x30, 0x75,
+	0xcb, 0xbc, 0x43, 0x97, 0xf0, 0x47, 0x29, 0x5a, 0x5b, 0xe4, 0x89, 0xe6, 0xc9, 0xfb, 0xed, 0x54,
+	0xbc, 0x12, 0x09, 0xba, 0xf6, 0x4e, 0x0a, 0x5d, 0x86, 0x0b, 0x73, 0x28, 0xb1, 0x0d, 0x78, 0x37,
+	0x85, 0x36, 0xe1, 0xd2, 0xfc, 0x18, 0xbc, 0xa5, 0x9b, 0xec, 0x44, 0x4b, 0x99, 0x5f, 0x4a, 0xa1,
+	0x73, 0x70, 0x6a, 0x9e, 0x4c, 0xbc, 0x8b, 0x6d, 0x4f, 0xfb, 0x7a, 0x4a, 0xa9, 0x74, 0x92, 0xe9,
+	0xcb, 0x29, 0x5a, 0xe9, 0xdc, 0xdb, 0x76, 0x25, 0x98, 0xfa, 0x4a, 0x2a, 0xac, 0x92, 0x72, 0xee,
+	0xab, 0x29, 0x74, 0x02, 0x8e, 0x19, 0x78, 0x97, 0x1d, 0x7f, 0x39, 0xfb, 0x1e, 0x9b, 0xad, 0x58,
+	0x58, 0xb7, 0x5b, 0xcd, 0x60, 0xf6, 0x6b, 0x4c, 0x64, 0x04, 0xf8, 0x8d, 0x14, 0x3a, 0x05, 0x27,
+	0x62, 0x85, 0x8a, 0x93, 0xfe, 0x3b, 0x15, 0x94, 0x5a, 0x39, 0xf5, 0xfd, 0x69, 0x2a, 0x96, 0xd9,
+	0xc4, 0xa4, 0x70, 0x67, 0xfe, 0x4d, 0x1a, 0x6d, 0xc0, 0x69, 0x69, 0x02, 0xcf, 0xae, 0x98, 0x88,
+	0xc6, 0xc8, 0xc0, 0x4d, 0x57, 0xfb, 0xbd, 0x0c, 0x0d, 0xc5, 0x19, 0x04, 0x93, 0xcd, 0x00, 0xbf,
+	0x9f, 0xa1, 0xdb, 0x38, 0x03, 0x10, 0x2e, 0x61, 0x90, 0x4f, 0x67, 0xe6, 0x6a, 0xa1, 0x15, 0xc9,
+	0xac, 0x51, 0x88, 0xf6, 0x07, 0x19, 0x74, 0x11, 0xce, 0x87, 0xae, 0x70, 0x5b, 0xcd, 0xa6, 0x43,
+	0x68, 0x31, 0xdc, 0x7d, 0xb1, 0xdd, 0xd0, 0x6d, 0xb3, 0x4a, 0xdb, 0xa6, 0x3f, 0xcc, 0xc4, 0x8f,
+	0x05, 0x2b, 0xea, 0x15, 0xdd, 0xae, 0x60, 0x16, 0xa4, 0x1f, 0xcb, 0xc6, 0x8f, 0x85, 0x81, 0x75,
+	0xc3, 0x32, 0x6d, 0xdc, 0xc6, 0xaf, 0x56, 0x30, 0x36, 0xb0, 0xa1, 0xfd, 0x5a, 0x96, 0x3a, 0x82,
+	0xaf, 0x30, 0xe4, 0xfc, 0xf5, 0x2c, 0x5a, 0x03, 0x4d, 0x18, 0x1d, 0x4e, 0xff, 0x46, 0x16, 0x9d,
+	0x86, 0xf5, 0x58, 0x09, 0x93, 0xc4, 0xdf, 0xcc, 0xd2, 0x24, 0x15, 0x21, 0x4a, 0x75, 0xda, 0x6f,
+	0x65, 0xd1, 0x59, 0x28, 0xb2, 0xd5, 0xb0, 0x9c, 0x8b, 0xdb, 0x9e, 0x5e, 0xab, 0x05, 0x1d, 0xc8,
+	0x0f, 0xe5, 0xe8, 0x4a, 0x18, 0x59, 0x36, 0x63, 0xed, 0xa6, 0xde, 0x72, 0x79, 0xf5, 0x77, 0x88,
+	0xf6, 0xc3, 0x39, 0xea, 0x90, 0x28, 0x40, 0x69, 0x6c, 0x04, 0xea, 0x47, 0x72, 0x34, 0x3a, 0x55,
+	0x2d, 0xb2, 0x83, 0xe6, 0xf4, 0x1f, 0x0d, 0xd5, 0x08, 0x7a, 0xd0, 0x5c, 0x72, 0xc0, 0x8f, 0xcd,
+	0x00, 0xe4, 0xc6, 0x0a, 0xc0, 0x8f, 0xe7, 0xa8, 0x5f, 0x38, 0x80, 0xd5, 0x6e, 0x3e, 0xfd, 0x56,
+	0x68, 0x9e, 0xe0, 0xbb, 0xa5, 0xd3, 0x73, 0xed, 0x11, 0x53, 0x59, 0xe5, 0x4f, 0xe4, 0x68, 0x62,
+	0x51, 0x51, 0x34, 0xbd, 0x57, 0xf5, 0x8a, 0xaa, 0xe1, 0x27, 0x73, 0x74, 0xcf, 0xa4, 0xe7, 0x45,
+	0xaf, 0x1a, 0xcb, 0x50, 0xff, 0x94, 0xa3, 0x19, 0x25, 0x08, 0xa9, 0xed, 0x56, 0xad, 0x5d, 0xc7,
+	0x56, 0x93, 0xd5, 0x0c, 0x8f, 0x98, 0x78, 0x97, 0xd9, 0xa5, 0xfd, 0x73, 0x0e, 0x9d, 0x04, 0x14,
+	0x88, 0xe2, 0x27, 0x88, 0x12, 0xfe, 0x25, 0x47, 0x77, 0x43, 0x10, 0x68, 0x33, 0xdd, 0xd6, 0x9b,
+	0x4d, 0xeb, 0x76, 0xdb, 0xd2, 0xb7, 0xb1, 0xe5, 0x6a, 0xff, 0x9a, 0xa3, 0x27, 0x49, 0x25, 0xcb,
+	0x66, 0x51, 0xfb, 0x37, 0x95, 0xd3, 0x76, 0xda, 0x0d, 0xba, 0x4c, 0xba, 0x01, 0xcc, 0xd1, 0xda,
+	0x17, 0x73, 0xe8, 0x0c, 0x9c, 0x54, 0x39, 0x77, 0x31, 0x71, 0xa5, 0xd9, 0xff, 0x9e, 0xe3, 0x71,
+	0x1f, 0x52, 0x1b, 0xa6, 0x1d, 0x41, 0xfc, 0x47, 0x8e, 0x9f, 0x2e, 0x86, 0x90, 0x09, 0x55, 0x05,
+	0xfc, 0x65, 0x9e, 0x1f, 0x8c, 0x08, 0xc0, 0xa9, 0x56, 0x59, 0x4c, 0x37, 0x68, 0x51, 0xa0, 0xa8,
+	0xff, 0xcc, 0x29, 0x28, 0x4c, 0xc2, 0x34, 0x56, 0x75, 0x68, 0x4c, 0x5a, 0x98, 0x7a, 0x52, 0xfb,
+	0x2f, 0x75, 0x2d, 0xb4, 0x8e, 0x04, 0x27, 0x8b, 0x09, 0x79, 0x5b, 0x15, 0xc2, 0xc8, 0x04, 0x37,
+	0x1c, 0x0f, 0x47, 0x51, 0xef, 0xa8, 0x42, 0x68, 0xff, 0x13, 0x25, 0xbf, 0xab, 0x3a, 0x44, 0xda,
+	0x1b, 0x78, 0xf3, 0x4b, 0x2c, 0x5e, 0x03, 0xaa, 0xb8, 0xca, 0x84, 0xf4, 0x2f, 0x47, 0x2d, 0x6c,
+	0x5a, 0x7a, 0x05, 0x8b, 0xf6, 0x86, 0x92, 0xbf, 0xa2, 0x86, 0x8a, 0x47, 0x74, 0xdb, 0xad, 0x3a,
+	0xa4, 0x11, 0x35, 0xe0, 0xab, 0xea, 0x5e, 0xba, 0xd8, 0xe3, 0x7b, 0xcc, 0x48, 0xef, 0xa9, 0xda,
+	0x03, 0xa6, 0x5b, 0xc4, 0xf4, 0xb8, 0xf8, 0xaf, 0xa9, 0x51, 0xd6, 0xd4, 0x89, 0xab, 0x2c, 0x9d,
+	0x19, 0xc1, 0x5b, 0xef, 0xaf, 0xe7, 0xd0, 0x15, 0x78, 0x46, 0xdd, 0x55, 0x11, 0xdc, 0x36, 0xef,
+	0xd2, 0xc2, 0x96, 0xe1, 0x1b, 0xcc, 0x16, 0x9e, 0x86, 0xdd, 0x30, 0xe1, 0x51, 0x21, 0x9f, 0xcd,
+	0xa3, 0x75, 0x58, 0x65, 0xa4, 0x8a, 0x24, 0xd3, 0xf9, 0xcf, 0x85, 0xf3, 0x66, 0xa3, 0x16, 0x36,
+	0x75, 0x9f, 0xcf, 0x6f, 0x7e, 0xbc, 0x00, 0x47, 0xa3, 0x97, 0x78, 0x7a, 0x7b, 0xb3, 0x4d, 0x4b,
+	0x3b, 0x42, 0x6f, 0x39, 0xba, 0x41, 0x33, 0x76, 0x55, 0x6f, 0x59, 0x34, 0xc5, 0x36, 0x1d, 0xad,
+	0x4b, 0xaf, 0xba, 0x32, 0x0b, 0x2a, 0xf3, 0xf4, 0xdd, 0x6e, 0x63, 0x76, 0xbe, 0x5d, 0xb3, 0x9c,
+	0x6d, 0xdd, 0x12, 0x46, 0x6a, 0xf7, 0xe8, 0x0d, 0xa1, 0x56, 0xb1, 0x9c, 0x56, 0x90, 0xdc, 0xe8,
+	0x25, 0x48, 0x90, 0x69, 0xb3, 0xd3, 0xa3, 0x97, 0xd6, 0xf9, 0xa4, 0xd7, 0xe8, 0xfd, 0x93, 0xab,
+	0x10, 0x22, 0xc4, 0xfd, 0x4d, 0xeb, 0x87, 0x14, 0xc1, 0x2a, 0xaf, 0x6a, 0x1f, 0xa4, 0xe6, 0x56,
+	0xcd, 0x57, 0xb9, 0x2f, 0x78, 0x56, 0xe5, 0x57, 0xaa, 0x75, 0x40, 0x02, 0x2b, 0x2f, 0x01, 0x1e,
+	0xb9, 0xad, 0x0d, 0xe8, 0x05, 0x85, 0xe2, 0x95, 0x3b, 0x45, 0x90, 0x5e, 0xc4, 0x22, 0xf6, 0x25,
+	0xc6, 0xdd, 0xd1, 0xab, 0x55, 0xc7, 0x32, 0x82, 0x9a, 0x13, 0x5c, 0x57, 0xb4, 0x21, 0x5d, 0x28,
+	0xc5, 0x28, 0x17, 0x06, 0xb9, 0x12, 0x9d, 0x9d, 0x9b, 0x11, 0xba, 0x04, 0x17, 0x28, 0x62, 0x61,
+	0x87, 0xce, 0x3a, 0xf9, 0x03, 0x7a, 0x4b, 0x88, 0x2c, 0x6d, 0x16, 0x28, 0x17, 0xfb, 0x3a, 0x8d,
+	0x73, 0x8e, 0x9d, 0x4d, 0x79, 0xf4, 0xfa, 0x5c, 0x82, 0x35, 0x4e, 0x0e, 0xb2, 0x3f, 0xaf, 0x6a,
+	0xe2, 0x16, 0x6d, 0xda, 0xae, 0xa7, 0x5b, 0x16, 0x0b, 0x3f, 0x7a, 0x8b, 0x5e, 0x85, 0xe5, 0x56,
+	0x93, 0x5e, 0x69, 0x30, 0x9f, 0xfa, 0xf3, 0x04, 0xba, 0x06, 0xcf, 0xcd, 0x5b, 0x39, 0xcf, 0x7e,
+	0xd2, 0x4f, 0xce, 0x2e, 0x26, 0xc4, 0x34, 0xb0, 0xab, 0x7d, 0x96, 0x5d, 0xd9, 0x55, 0x21, 0x37,
+	0xae, 0x6b, 0x9f, 0x4b, 0xa0, 0x2d, 0x78, 0x76, 0xa1, 0x18, 0x19, 0xf7, 0x7a, 0x03, 0xbb, 0x4d,
+	0xbd, 0x82, 0xb5, 0xcf, 0x27, 0x68, 0x6d, 0x95, 0xc6, 0xc9, 0xc7, 0x89, 0xbf, 0x4d, 0xd0, 0x63,
+	0x10, 0xef, 0xb4, 0x2c, 0xa7, 0xe6, 0xd2, 0x6b, 0x48, 0xb0, 0x52, 0x9a, 0x72, 0x4c, 0x9b, 0x5e,
+	0xff, 0x9b, 0xc4, 0xd9, 0xc6, 0xda, 0x27, 0x14, 0x5a, 0xc8, 0xc6, 0x0e, 0x22, 0xbd, 0x73, 0x5c,
+	0x80, 0x33, 0xba, 0x61, 0xd0, 0xce, 0x7b, 0x61, 0xff, 0x7f, 0x1e, 0x4a, 0x11, 0xc8, 0x4c, 0xef,
+	0x7f, 0x09, 0x36, 0x22, 0x80, 0x05, 0x7d, 0xff, 0x39, 0x38, 0x15, 0x81, 0xc5, 0x7b, 0xfe, 0xb8,
+	0x9e, 0x99, 0x7e, 0xff, 0x2c, 0x14, 0x63, 0x80, 0x48, 0xaf, 0x7f, 0x1a, 0xd6, 0xa3, 0x66, 0xa8,
+	0x7d, 0xbe, 0xa2, 0x7c, 0x6e, 0x8f, 0x1f, 0xf8, 0xa8, 0xee, 0xb8, 0x9e, 0x1a, 0x45, 0xbf, 0xc8,
+	0x5a, 0x53, 0x76, 0xa5, 0x0a, 0xa2, 0x88, 0xf6, 0xc8, 0x6b, 0xa0, 0xb5, 0x6c, 0xd6, 0x6c, 0x84,
+	0xd3, 0xef, 0xb2, 0xa6, 0x93, 0x5e, 0x01, 0x45, 0xe8, 0xd2, 0xc4, 0xa3, 0x7d, 0x3c, 0xcd, 0xda,
+	0x29, 0x4c, 0xad, 0xb1, 0x69, 0x57, 0x51, 0xb5, 0xf4, 0x5a, 0x50, 0x7d, 0xaa, 0xba, 0xe5, 0x62,
+	0xed, 0xaf, 0xd3, 0xe8, 0x18, 0x80, 0xd3, 0xc4, 0x76, 0xdb, 0x74, 0xdd, 0x16, 0xd6, 0x7e, 0x30,
+	0x77, 0xfd, 0x33, 0x79, 0x38, 0xe6, 0x8a, 0xff, 0x08, 0xe0, 0xfa, 0xe3, 0x07, 0xfd, 0x3d, 0x1f,
+	0x55, 0x20, 0x5f, 0xf3, 0xe5, 0xcf, 0xe7, 0x66, 0x1e, 0xb2, 0x31, 0xfd, 0x41, 0x7f, 0x29, 0xf2,
+	0x53, 0xfd, 0xf2, 0xea, 0x0f, 0xfc, 0xc5, 0x17, 0x3e, 0x92, 0x5c, 0x42, 0x85, 0xab, 0x0f, 0x5e,
+	0xbc, 0xca, 0x1e, 0x48, 0x51, 0x0d, 0xf2, 0xec, 0x19, 0xdb, 0x1a, 0xf5, 0x90, 0xfc, 0x5d, 0x82,
+	0x7c, 0x31, 0x2f, 0xc5, 0x27, 0xca, 0x6b, 0x4c, 0xc0, 0x31, 0xb4, 0x42, 0x05, 0xf0, 0x9f, 0x95,
+	0x0c, 0x46, 0xbd, 0x2b, 0x89, 0x6b, 0x09, 0x54, 0x83, 0x2c, 0x13, 0x34, 0x59, 0x68, 0xcb, 0x8c,
+	0x34, 0xc4, 0xa4, 0x2d, 0x23, 0x08, 0xa4, 0x4d, 0xae, 0x25, 0xd0, 0xab, 0x90, 0xc3, 0x6f, 0xfa,
+	0x7b, 0x87, 0x53, 0x1f, 0x15, 0x05, 0xc7, 0xcc, 0x13, 0x7a, 0x69, 0x81, 0x8e, 0xf2, 0x69, 0x26,
+	0x72, 0xad, 0xbc, 0xc4, 0x44, 0x72, 0x31, 0x37, 0xc5, 0x83, 0x3a, 0xea, 0x40, 0x41, 0x3f, 0x9c,
+	0x8e, 0xd8, 0xc3, 0x25, 0x5a, 0x8b, 0xbe, 0x1a, 0x3f, 0x4e, 0xf0, 0x25, 0x26, 0xf8, 0x7c, 0x69,
+	0x9d, 0x0a, 0x66, 0xef, 0xe1, 0x57, 0xe9, 0x0f, 0xd9, 0xda, 0x52, 0x07, 0x7f, 0x6f, 0x46, 0x6d,
+	0xc8, 0x53, 0x15, 0xf4, 0x73, 0xe5, 0xd3, 0x6a, 0xb8, 0xc8, 0x34, 0x9c, 0x2b, 0xad, 0xb1, 0xcd,
+	0x79, 0x38, 0xdc, 0x9b, 0xab, 0x60, 0x0f, 0x80, 0x2a, 0xe0, 0x2f, 0x9d, 0x4f, 0xab, 0xe2, 0x32,
+	0x53, 0xb1, 0x51, 0x3a, 0x49, 0x55, 0xf0, 0x47, 0xef, 0xb9, 0x4a, 0xbe, 0x5b, 0xfc, 0x94, 0x5a,
+	0x0f, 0x7e, 0x6b, 0x22, 0xf4, 0xc4, 0xde, 0xf4, 0x17, 0x2a, 0x3a, 0xcb, 0x14, 0x9d, 0x2c, 0x23,
+	0xc5, 0x5b, 0x82, 0xf5, 0x66, 0x62, 0x13, 0xdd, 0x87, 0x25, 0xf6, 0xd0, 0xcf, 0xdf, 0xfc, 0xd1,
+	0xa9, 0xc8, 0xef, 0xe6, 0xd5, 0x4f, 0x00, 0x0b, 0x15, 0x3c, 0xcb, 0x14, 0x3c, 0x53, 0x2a, 0x51,
+	0x05, 0xe2, 0xff, 0x08, 0x5c, 0xfd, 0x30, 0xff, 0xe3, 0x7b, 0xaf, 0xb2, 0x9f, 0xd0, 0xcb, 0xc5,
+	0xdc, 0x03, 0x44, 0xf8, 0xc7, 0x2f, 0xfa, 0xcd, 0x50, 0xfc, 0x37, 0x81, 0x85, 0x41, 0xba, 0x48,
+	0xe1, 0x06, 0x53, 0x58, 0x2a, 0x17, 0xa9, 0x42, 0xfa, 0x59, 0xbd, 0x2d, 0xfe, 0x17, 0xc8, 0x55,
+	0xf1, 0x65, 0x0d, 0xdd, 0xa1, 0xff, 0x01, 0x86, 0x3b, 0x35, 0xf0, 0x57, 0xec, 0xdb, 0xc3, 0x42,
+	0xe9, 0xe7, 0x98, 0xf4, 0x62, 0xf9, 0xb8, 0xb2, 0x31, 0x5d, 0xc1, 0x4b, 0x1d, 0x66, 0x41, 0xb6,
+	0xde, 0x19, 0x76, 0x07, 0x3e, 0x8a, 0x7c, 0x7b, 0x5a, 0x28, 0xef, 0x0c, 0x93, 0xb7, 0x5e, 0x5e,
+	0x0d, 0x4f, 0xd6, 0xd5, 0xd7, 0x98, 0x80, 0x9b, 0x89, 0xcd, 0xbb, 0x59, 0x86, 0xbe, 0xf1, 0x3f,
+	0x03, 0x00, 0xf0, 0xd7, 0x77, 0x75, 0x5b, 0x34, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3465,6 +3929,14 @@ type SkaffoldServiceClient interface {
 	AutoSync(ctx context.Context, in *TriggerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Allows for enabling or disabling automatic deploy trigger
 	AutoDeploy(ctx context.Context, in *TriggerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Rebuilds a single artifact, along with the artifacts that depend on it, even if autoBuild is disabled
+	BuildArtifact(ctx context.Context, in *ArtifactRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Allows for pausing or resuming the watching of the artifacts of a config module
+	WatchModule(ctx context.Context, in *ModuleWatchRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Restarts the port forwarding
+	RestartPortForward(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Runs a single deployer again, even if autoDeploy is disabled
+	Redeploy(ctx context.Context, in *DeployerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// EXPERIMENTAL. It allows for custom events to be implemented in custom builders for example.
 	Handle(ctx context.Context, in *Event, opts ...grpc.CallOption) (*empty.Empty, error)
 }
@@ -3585,6 +4057,42 @@ func (c *skaffoldServiceClient) AutoDeploy(ctx context.Context, in *TriggerReque
 	return out, nil
 }
 
+func (c *skaffoldServiceClient) BuildArtifact(ctx context.Context, in *ArtifactRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/proto.SkaffoldService/BuildArtifact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skaffoldServiceClient) WatchModule(ctx context.Context, in *ModuleWatchRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/proto.SkaffoldService/WatchModule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skaffoldServiceClient) RestartPortForward(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/proto.SkaffoldService/RestartPortForward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skaffoldServiceClient) Redeploy(ctx context.Context, in *DeployerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/proto.SkaffoldService/Redeploy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *skaffoldServiceClient) Handle(ctx context.Context, in *Event, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/proto.SkaffoldService/Handle", in, out, opts...)
@@ -3611,6 +4119,14 @@ type SkaffoldServiceServer interface {
 	AutoSync(context.Context, *TriggerRequest) (*empty.Empty, error)
 	// Allows for enabling or disabling automatic deploy trigger
 	AutoDeploy(context.Context, *TriggerRequest) (*empty.Empty, error)
+	// Rebuilds a single artifact, along with the artifacts that depend on it, even if autoBuild is disabled
+	BuildArtifact(context.Context, *ArtifactRequest) (*empty.Empty, error)
+	// Allows for pausing or resuming the watching of the artifacts of a config module
+	WatchModule(context.Context, *ModuleWatchRequest) (*empty.Empty, error)
+	// Restarts the port forwarding
+	RestartPortForward(context.Context, *empty.Empty) (*empty.Empty, error)
+	// Runs a single deployer again, even if autoDeploy is disabled
+	Redeploy(context.Context, *DeployerRequest) (*empty.Empty, error)
 	// EXPERIMENTAL. It allows for custom events to be implemented in custom builders for example.
 	Handle(context.Context, *Event) (*empty.Empty, error)
 }
@@ -3640,6 +4156,18 @@ func (*UnimplementedSkaffoldServiceServer) AutoSync(ctx context.Context, req *Tr
 func (*UnimplementedSkaffoldServiceServer) AutoDeploy(ctx context.Context, req *TriggerRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AutoDeploy not implemented")
 }
+func (*UnimplementedSkaffoldServiceServer) BuildArtifact(ctx context.Context, req *ArtifactRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildArtifact not implemented")
+}
+func (*UnimplementedSkaffoldServiceServer) WatchModule(ctx context.Context, req *ModuleWatchRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WatchModule not implemented")
+}
+func (*UnimplementedSkaffoldServiceServer) RestartPortForward(ctx context.Context, req *empty.Empty) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartPortForward not implemented")
+}
+func (*UnimplementedSkaffoldServiceServer) Redeploy(ctx context.Context, req *DeployerRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redeploy not implemented")
+}
 func (*UnimplementedSkaffoldServiceServer) Handle(ctx context.Context, req *Event) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Handle not implemented")
 }
@@ -3785,6 +4313,78 @@ func _SkaffoldService_AutoDeploy_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SkaffoldService_BuildArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArtifactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkaffoldServiceServer).BuildArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SkaffoldService/BuildArtifact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkaffoldServiceServer).BuildArtifact(ctx, req.(*ArtifactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkaffoldService_WatchModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModuleWatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkaffoldServiceServer).WatchModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SkaffoldService/WatchModule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkaffoldServiceServer).WatchModule(ctx, req.(*ModuleWatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkaffoldService_RestartPortForward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkaffoldServiceServer).RestartPortForward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SkaffoldService/RestartPortForward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkaffoldServiceServer).RestartPortForward(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkaffoldService_Redeploy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeployerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkaffoldServiceServer).Redeploy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SkaffoldService/Redeploy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkaffoldServiceServer).Redeploy(ctx, req.(*DeployerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SkaffoldService_Handle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Event)
 	if err := dec(in); err != nil {
@@ -3827,6 +4427,22 @@ var _SkaffoldService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AutoDeploy",
 			Handler:    _SkaffoldService_AutoDeploy_Handler,
 		},
+		{
+			MethodName: "BuildArtifact",
+			Handler:    _SkaffoldService_BuildArtifact_Handler,
+		},
+		{
+			MethodName: "WatchModule",
+			Handler:    _SkaffoldService_WatchModule_Handler,
+		},
+		{
+			MethodName: "RestartPortForward",
+			Handler:    _SkaffoldService_RestartPortForward_Handler,
+		},
+		{
+			MethodName: "Redeploy",
+			Handler:    _SkaffoldService_Redeploy_Handler,
+		},
 		{
 			MethodName: "Handle",
 			Handler:    _SkaffoldService_Handle_Handler,
@@ -0,0 +1,405 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: v2/skaffold.proto
+
+package proto
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Enum indicating the type of a task
+type TaskType int32
+
+const (
+	// Could not determine the task type
+	TaskType_UNKNOWN_TASK_TYPE TaskType = 0
+	// A dev loop iteration
+	TaskType_DEV_LOOP TaskType = 1
+	// The build of an artifact
+	TaskType_BUILD TaskType = 2
+	// The tests of the built artifacts
+	TaskType_TEST TaskType = 3
+	// The deployment of the built artifacts
+	TaskType_DEPLOY TaskType = 4
+	// The status check of the deployed resources
+	TaskType_STATUS_CHECK TaskType = 5
+	// The status check of a single deployed resource
+	TaskType_RESOURCE_STATUS_CHECK TaskType = 6
+	// The file sync of an artifact
+	TaskType_FILE_SYNC TaskType = 7
+)
+
+var TaskType_name = map[int32]string{
+	0: "UNKNOWN_TASK_TYPE",
+	1: "DEV_LOOP",
+	2: "BUILD",
+	3: "TEST",
+	4: "DEPLOY",
+	5: "STATUS_CHECK",
+	6: "RESOURCE_STATUS_CHECK",
+	7: "FILE_SYNC",
+}
+
+var TaskType_value = map[string]int32{
+	"UNKNOWN_TASK_TYPE":     0,
+	"DEV_LOOP":              1,
+	"BUILD":                 2,
+	"TEST":                  3,
+	"DEPLOY":                4,
+	"STATUS_CHECK":          5,
+	"RESOURCE_STATUS_CHECK": 6,
+	"FILE_SYNC":             7,
+}
+
+func (x TaskType) String() string {
+	return proto.EnumName(TaskType_name, int32(x))
+}
+
+func (TaskType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_39088757fd9c8e40, []int{0}
+}
+
+// Enum indicating the step in the lifecycle of a task
+type TaskStatus int32
+
+const (
+	// Could not determine the task status
+	TaskStatus_UNKNOWN_TASK_STATUS TaskStatus = 0
+	// The task started
+	TaskStatus_STARTED TaskStatus = 1
+	// The task made progress
+	TaskStatus_IN_PROGRESS TaskStatus = 2
+	// The task succeeded
+	TaskStatus_SUCCEEDED TaskStatus = 3
+	// The task failed
+	TaskStatus_FAILED TaskStatus = 4
+	// The task was canceled
+	TaskStatus_CANCELED TaskStatus = 5
+)
+
+var TaskStatus_name = map[int32]string{
+	0: "UNKNOWN_TASK_STATUS",
+	1: "STARTED",
+	2: "IN_PROGRESS",
+	3: "SUCCEEDED",
+	4: "FAILED",
+	5: "CANCELED",
+}
+
+var TaskStatus_value = map[string]int32{
+	"UNKNOWN_TASK_STATUS": 0,
+	"STARTED":             1,
+	"IN_PROGRESS":         2,
+	"SUCCEEDED":           3,
+	"FAILED":              4,
+	"CANCELED":            5,
+}
+
+func (x TaskStatus) String() string {
+	return proto.EnumName(TaskStatus_name, int32(x))
+}
+
+func (TaskStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_39088757fd9c8e40, []int{1}
+}
+
+// `Event` describes a step in the lifecycle of a task of the current Skaffold execution.
+// Every task belongs to a dev loop iteration and is started, then either succeeds or fails.
+// Tasks form a tree: the tasks of an iteration are children of its `DevLoop` task.
+type Event struct {
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Iteration            int32                `protobuf:"varint,2,opt,name=iteration,proto3" json:"iteration,omitempty"`
+	TaskId               string               `protobuf:"bytes,3,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ParentTaskId         string               `protobuf:"bytes,4,opt,name=parentTaskId,proto3" json:"parentTaskId,omitempty"`
+	TaskType             TaskType             `protobuf:"varint,5,opt,name=taskType,proto3,enum=proto.v2.TaskType" json:"taskType,omitempty"`
+	Name                 string               `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Status               TaskStatus           `protobuf:"varint,7,opt,name=status,proto3,enum=proto.v2.TaskStatus" json:"status,omitempty"`
+	Duration             *duration.Duration   `protobuf:"bytes,8,opt,name=duration,proto3" json:"duration,omitempty"`
+	Message              string               `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+	Error                string               `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode            string               `protobuf:"bytes,11,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39088757fd9c8e40, []int{0}
+}
+
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event.Unmarshal(m, b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return xxx_messageInfo_Event.Size(m)
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *Event) GetIteration() int32 {
+	if m != nil {
+		return m.Iteration
+	}
+	return 0
+}
+
+func (m *Event) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *Event) GetParentTaskId() string {
+	if m != nil {
+		return m.ParentTaskId
+	}
+	return ""
+}
+
+func (m *Event) GetTaskType() TaskType {
+	if m != nil {
+		return m.TaskType
+	}
+	return TaskType_UNKNOWN_TASK_TYPE
+}
+
+func (m *Event) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Event) GetStatus() TaskStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TaskStatus_UNKNOWN_TASK_STATUS
+}
+
+func (m *Event) GetDuration() *duration.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+func (m *Event) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *Event) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *Event) GetErrorCode() string {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("proto.v2.TaskType", TaskType_name, TaskType_value)
+	proto.RegisterEnum("proto.v2.TaskStatus", TaskStatus_name, TaskStatus_value)
+	proto.RegisterType((*Event)(nil), "proto.v2.Event")
+}
+
+func init() { proto.RegisterFile("v2/skaffold.proto", fileDescriptor_39088757fd9c8e40) }
+
+var fileDescriptor_39088757fd9c8e40 = []byte{
+	// 592 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x53, 0xd1, 0x6e, 0xda, 0x4a,
+	0x10, 0x8d, 0x09, 0x36, 0x66, 0xe0, 0xde, 0x38, 0x73, 0x93, 0x5c, 0x87, 0x1b, 0xdd, 0xa2, 0x3c,
+	0xa1, 0xa8, 0xb2, 0x2b, 0x57, 0x55, 0x5b, 0xf5, 0x89, 0xd8, 0x9b, 0x14, 0x05, 0x01, 0xb2, 0x97,
+	0x54, 0xa9, 0x2a, 0x59, 0x4e, 0xd8, 0x50, 0x2b, 0xe0, 0x45, 0xf6, 0x82, 0x94, 0xd7, 0x3e, 0xf7,
+	0xa9, 0xfd, 0xb4, 0xfe, 0x42, 0x3f, 0xa4, 0x62, 0x6d, 0x40, 0x24, 0x4f, 0xbb, 0x33, 0xe7, 0xcc,
+	0x99, 0xdd, 0xb3, 0x3b, 0xb0, 0xbf, 0x70, 0xec, 0xec, 0x21, 0xba, 0xbf, 0xe7, 0x93, 0x91, 0x35,
+	0x4b, 0xb9, 0xe0, 0xa8, 0xcb, 0xc5, 0x5a, 0x38, 0x8d, 0x93, 0x31, 0xe7, 0xe3, 0x09, 0xb3, 0xa3,
+	0x59, 0x6c, 0x47, 0x49, 0xc2, 0x45, 0x24, 0x62, 0x9e, 0x64, 0x39, 0xaf, 0xf1, 0xa2, 0x40, 0x65,
+	0x74, 0x3b, 0xbf, 0xb7, 0x45, 0x3c, 0x65, 0x99, 0x88, 0xa6, 0xb3, 0x82, 0xf0, 0xff, 0x53, 0xc2,
+	0x68, 0x9e, 0x4a, 0x85, 0x02, 0xff, 0xef, 0x29, 0xce, 0xa6, 0x33, 0xf1, 0x98, 0x83, 0xa7, 0x3f,
+	0x76, 0x41, 0x25, 0x0b, 0x96, 0x08, 0x7c, 0x07, 0xd5, 0xb5, 0xb2, 0xa9, 0x34, 0x95, 0x56, 0xcd,
+	0x69, 0x58, 0x79, 0xa9, 0xb5, 0x2a, 0xb5, 0xe8, 0x8a, 0xe1, 0x6f, 0xc8, 0x78, 0x02, 0xd5, 0x58,
+	0xb0, 0xbc, 0xa7, 0x59, 0x6a, 0x2a, 0x2d, 0xd5, 0xdf, 0x24, 0xf0, 0x08, 0x34, 0x11, 0x65, 0x0f,
+	0x9d, 0x91, 0xb9, 0xdb, 0x54, 0x5a, 0x55, 0xbf, 0x88, 0xf0, 0x14, 0xea, 0xb3, 0x28, 0x65, 0x89,
+	0xa0, 0x39, 0x5a, 0x96, 0xe8, 0x56, 0x0e, 0x2d, 0xd0, 0x97, 0x6c, 0xfa, 0x38, 0x63, 0xa6, 0xda,
+	0x54, 0x5a, 0x7f, 0x3b, 0x68, 0xad, 0x6c, 0xb3, 0x68, 0x81, 0xf8, 0x6b, 0x0e, 0x22, 0x94, 0x93,
+	0x68, 0xca, 0x4c, 0x4d, 0x6a, 0xc9, 0x3d, 0xbe, 0x04, 0x2d, 0x13, 0x91, 0x98, 0x67, 0x66, 0x45,
+	0x2a, 0x1c, 0x6c, 0x2b, 0x04, 0x12, 0xf3, 0x0b, 0x0e, 0xbe, 0x01, 0x7d, 0x65, 0x9f, 0xa9, 0x4b,
+	0x13, 0x8e, 0x9f, 0x99, 0xe0, 0x15, 0x04, 0x7f, 0x4d, 0x45, 0x13, 0x2a, 0x53, 0x96, 0x65, 0xd1,
+	0x98, 0x99, 0x55, 0xd9, 0x7b, 0x15, 0xe2, 0x01, 0xa8, 0x2c, 0x4d, 0x79, 0x6a, 0x82, 0xcc, 0xe7,
+	0xc1, 0xd2, 0x32, 0xb9, 0x71, 0xf9, 0x88, 0x99, 0x35, 0x89, 0x6c, 0x12, 0x67, 0xdf, 0x15, 0xd0,
+	0x57, 0xb7, 0xc3, 0x43, 0xd8, 0x1f, 0xf6, 0xae, 0x7a, 0xfd, 0x4f, 0xbd, 0x90, 0xb6, 0x83, 0xab,
+	0x90, 0xde, 0x0c, 0x88, 0xb1, 0x83, 0x75, 0xd0, 0x3d, 0x72, 0x1d, 0x76, 0xfb, 0xfd, 0x81, 0xa1,
+	0x60, 0x15, 0xd4, 0xf3, 0x61, 0xa7, 0xeb, 0x19, 0x25, 0xd4, 0xa1, 0x4c, 0x49, 0x40, 0x8d, 0x5d,
+	0x04, 0xd0, 0x3c, 0x32, 0xe8, 0xf6, 0x6f, 0x8c, 0x32, 0x1a, 0x50, 0x0f, 0x68, 0x9b, 0x0e, 0x83,
+	0xd0, 0xfd, 0x48, 0xdc, 0x2b, 0x43, 0xc5, 0x63, 0x38, 0xf4, 0x49, 0xd0, 0x1f, 0xfa, 0x2e, 0x09,
+	0xb7, 0x20, 0x0d, 0xff, 0x82, 0xea, 0x45, 0xa7, 0x4b, 0xc2, 0xe0, 0xa6, 0xe7, 0x1a, 0x95, 0xb3,
+	0x09, 0xc0, 0xc6, 0x29, 0xfc, 0x17, 0xfe, 0xd9, 0x3a, 0x4f, 0x5e, 0x6b, 0xec, 0x60, 0x0d, 0x2a,
+	0x01, 0x6d, 0xfb, 0x94, 0x78, 0x86, 0x82, 0x7b, 0x50, 0xeb, 0xf4, 0xc2, 0x81, 0xdf, 0xbf, 0xf4,
+	0x49, 0x10, 0x18, 0xa5, 0xa5, 0x66, 0x30, 0x74, 0x5d, 0x42, 0x3c, 0xe2, 0xe5, 0x67, 0xbb, 0x68,
+	0x77, 0xba, 0xc4, 0x33, 0xca, 0xcb, 0xab, 0xb8, 0xed, 0x9e, 0x4b, 0x96, 0x91, 0xea, 0x7c, 0x81,
+	0xfd, 0xa0, 0x98, 0x94, 0x6b, 0x27, 0x60, 0xe9, 0x22, 0xbe, 0x63, 0x78, 0x09, 0x9a, 0xfc, 0xa5,
+	0x19, 0x1e, 0x3d, 0x7b, 0x0e, 0xb2, 0xfc, 0xce, 0x8d, 0xbd, 0xcd, 0xb3, 0x4a, 0xe6, 0x29, 0x7e,
+	0xfb, 0xf5, 0xfb, 0x67, 0xa9, 0x8e, 0x60, 0x2f, 0x1c, 0x9b, 0xc9, 0xe2, 0x57, 0xca, 0xf9, 0xfb,
+	0xcf, 0x6f, 0xc7, 0xb1, 0xf8, 0x3a, 0xbf, 0xb5, 0xee, 0xf8, 0xd4, 0xbe, 0x94, 0x52, 0x2e, 0x4f,
+	0x44, 0x14, 0x27, 0x2c, 0xa5, 0x9c, 0x4f, 0xb2, 0xf5, 0x9c, 0xe6, 0x03, 0x63, 0x2f, 0x9c, 0x0f,
+	0x79, 0x2b, 0x4d, 0x2e, 0xaf, 0xff, 0x0c, 0x00, 0xba, 0xa4, 0x2c, 0x48, 0xcc, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SkaffoldV2ServiceClient is the client API for SkaffoldV2Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SkaffoldV2ServiceClient interface {
+	// Returns all the events of the current Skaffold execution from the start
+	Events(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (SkaffoldV2Service_EventsClient, error)
+}
+
+type skaffoldV2ServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSkaffoldV2ServiceClient(cc *grpc.ClientConn) SkaffoldV2ServiceClient {
+	return &skaffoldV2ServiceClient{cc}
+}
+
+func (c *skaffoldV2ServiceClient) Events(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (SkaffoldV2Service_EventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SkaffoldV2Service_serviceDesc.Streams[0], "/proto.v2.SkaffoldV2Service/Events", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &skaffoldV2ServiceEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SkaffoldV2Service_EventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type skaffoldV2ServiceEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *skaffoldV2ServiceEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SkaffoldV2ServiceServer is the server API for SkaffoldV2Service service.
+type SkaffoldV2ServiceServer interface {
+	// Returns all the events of the current Skaffold execution from the start
+	Events(*empty.Empty, SkaffoldV2Service_EventsServer) error
+}
+
+// UnimplementedSkaffoldV2ServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedSkaffoldV2ServiceServer struct {
+}
+
+func (*UnimplementedSkaffoldV2ServiceServer) Events(req *empty.Empty, srv SkaffoldV2Service_EventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+}
+
+func RegisterSkaffoldV2ServiceServer(s *grpc.Server, srv SkaffoldV2ServiceServer) {
+	s.RegisterService(&_SkaffoldV2Service_serviceDesc, srv)
+}
+
+func _SkaffoldV2Service_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SkaffoldV2ServiceServer).Events(m, &skaffoldV2ServiceEventsServer{stream})
+}
+
+type SkaffoldV2Service_EventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type skaffoldV2ServiceEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *skaffoldV2ServiceEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _SkaffoldV2Service_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.v2.SkaffoldV2Service",
+	HandlerType: (*SkaffoldV2ServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Events",
+			Handler:       _SkaffoldV2Service_Events_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "v2/skaffold.proto",
+}